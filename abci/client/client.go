@@ -121,7 +121,18 @@ func (r *ReqRes) InvokeCallback() {
 	r.callbackInvoked = true
 }
 
-// Error returns the error returned by the callback, if any.
+// Fail resolves the request without a response, with err as its error, e.g.
+// because it could not be sent to the application. The callback is never
+// invoked.
+func (r *ReqRes) Fail(err error) {
+	r.mtx.Lock()
+	r.cbErr = err
+	r.mtx.Unlock()
+	r.Done()
+}
+
+// Error returns the error returned by the callback, or the error with which
+// the request failed, if any.
 func (r *ReqRes) Error() error {
 	return r.cbErr
 }
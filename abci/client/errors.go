@@ -1,6 +1,7 @@
 package abcicli
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/cometbft/cometbft/v2/abci/types"
@@ -15,6 +16,10 @@ func (e ErrUnknownAbciTransport) Error() string {
 	return "unknown abci transport: " + e.Transport
 }
 
+// ErrRequestNotSent is the error of a request which could not be sent to the
+// application (see ReqRes.Fail).
+var ErrRequestNotSent = errors.New("request not sent to the application")
+
 type ErrUnexpectedResponse struct {
 	Response types.Response
 	Reason   string
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/cometbft/cometbft/v2/abci/types"
@@ -90,6 +91,9 @@ RETRY_LOOP:
 		conn, err := grpc.NewClient(cli.addr,
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
 			grpc.WithContextDialer(dialerFunc),
+			// Never let the connection go idle, so that it only leaves the
+			// ready state when it is lost.
+			grpc.WithIdleTimeout(0),
 		)
 		if err != nil {
 			if cli.mustConnect {
@@ -115,10 +119,33 @@ RETRY_LOOP:
 		}
 
 		cli.client = client
+		go cli.watchConnection(conn)
 		return nil
 	}
 }
 
+// watchConnection stops the client with an error once the connection to the
+// server is lost. The connection would otherwise be reestablished silently,
+// possibly with a restarted server which lost the state the client relies on.
+func (cli *grpcClient) watchConnection(conn *grpc.ClientConn) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-cli.Quit():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if !conn.WaitForStateChange(ctx, connectivity.Ready) {
+		return // the client was stopped
+	}
+	if state := conn.GetState(); state != connectivity.Shutdown {
+		cli.StopForError(fmt.Errorf("lost the connection to the server (%v)", state))
+	}
+}
+
 func (cli *grpcClient) OnStop() {
 	cli.BaseService.OnStop()
 
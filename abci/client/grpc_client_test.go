@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	abcicli "github.com/cometbft/cometbft/v2/abci/client"
 	abciserver "github.com/cometbft/cometbft/v2/abci/server"
 	"github.com/cometbft/cometbft/v2/abci/types"
 	cmtnet "github.com/cometbft/cometbft/v2/internal/net"
@@ -76,6 +77,35 @@ func TestGRPC(t *testing.T) {
 	}
 }
 
+func TestGRPCClientStopsOnConnectionLoss(t *testing.T) {
+	socketFile := fmt.Sprintf("/tmp/test-%08x.sock", rand.Int31n(1<<30))
+	defer os.Remove(socketFile)
+	socket := fmt.Sprintf("unix://%v", socketFile)
+
+	server := abciserver.NewGRPCServer(socket, types.NewBaseApplication())
+	server.SetLogger(log.TestingLogger().With("module", "abci-server"))
+	require.NoError(t, server.Start())
+
+	client := abcicli.NewGRPCClient(socket, true)
+	client.SetLogger(log.TestingLogger().With("module", "abci-client"))
+	require.NoError(t, client.Start())
+	t.Cleanup(func() { _ = client.Stop() })
+
+	_, err := client.Echo(context.Background(), "hello")
+	require.NoError(t, err)
+	require.NoError(t, client.Error())
+
+	// The server going away stops the client, rather than letting it
+	// reconnect silently to a restarted server.
+	require.NoError(t, server.Stop())
+	select {
+	case <-client.Quit():
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "client not stopped after the connection was lost")
+	}
+	require.Error(t, client.Error())
+}
+
 func dialerFunc(_ context.Context, addr string) (net.Conn, error) {
 	return cmtnet.Connect(addr)
 }
@@ -195,6 +195,8 @@ func (cli *socketClient) trackRequest(reqres *ReqRes) {
 	// N.B. We must NOT hold the client state lock while checking this, or we
 	// may deadlock with shutdown.
 	if !cli.IsRunning() {
+		// Not sent: release the waiters, which get cli.Error().
+		reqres.Done()
 		return
 	}
 
@@ -422,6 +424,13 @@ func (cli *socketClient) queueRequest(ctx context.Context, req *types.Request) (
 		return nil, ctx.Err()
 	}
 
+	// A stopped client doesn't send the queued requests anymore: release the
+	// waiters, which get cli.Error(), instead of leaving them hanging.
+	if !cli.IsRunning() {
+		cli.flushQueue()
+		return reqres, nil
+	}
+
 	// Maybe auto-flush, or unset auto-flush
 	switch req.Value.(type) {
 	case *types.Request_Flush:
@@ -444,6 +453,7 @@ func (cli *socketClient) flushQueue() {
 		reqres := req.Value.(*ReqRes)
 		reqres.Done()
 	}
+	cli.reqSent.Init()
 
 	// mark all queued messages as resolved
 LOOP:
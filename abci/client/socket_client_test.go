@@ -75,6 +75,28 @@ func TestHangingAsyncCalls(t *testing.T) {
 	}
 }
 
+func TestCallsAfterServerStopped(t *testing.T) {
+	s, c := setupClientServer(t, types.BaseApplication{})
+
+	require.NoError(t, s.Stop())
+	require.Eventually(t, func() bool { return !c.IsRunning() }, time.Second, 10*time.Millisecond)
+
+	// Calls on the stopped client fail with its error, rather than hanging.
+	resp := make(chan error, 1)
+	go func() {
+		_, err := c.Info(context.Background(), &types.InfoRequest{})
+		resp <- err
+	}()
+
+	select {
+	case <-time.After(time.Second):
+		require.Fail(t, "No response arrived")
+	case err := <-resp:
+		require.Error(t, err)
+		require.Equal(t, c.Error(), err)
+	}
+}
+
 func TestBulk(t *testing.T) {
 	const numTxs = 700000
 	// use a socket instead of a port
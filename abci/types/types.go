@@ -99,6 +99,7 @@ type (
 	Event              = v2.Event
 	EventAttribute     = v2.EventAttribute
 	Misbehavior        = v2.Misbehavior
+	RecordedCall       = v2.RecordedCall
 	Snapshot           = v2.Snapshot
 	TxResult           = v2.TxResult
 	Validator          = v2.Validator
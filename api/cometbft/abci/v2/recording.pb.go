@@ -0,0 +1,559 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/abci/v2/recording.proto
+
+package v2
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RecordedCall is a call to the ABCI application, as written to a recording of
+// the ABCI traffic of a node.
+type RecordedCall struct {
+	// Time at which the request was sent.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// Time the application took to respond.
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	// Connection the request was sent on: consensus, mempool, query or snapshot.
+	Connection string   `protobuf:"bytes,3,opt,name=connection,proto3" json:"connection,omitempty"`
+	Request    *Request `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	// Response of the application. If the call failed, its error is recorded
+	// as an exception.
+	Response *Response `protobuf:"bytes,5,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (m *RecordedCall) Reset()         { *m = RecordedCall{} }
+func (m *RecordedCall) String() string { return proto.CompactTextString(m) }
+func (*RecordedCall) ProtoMessage()    {}
+func (*RecordedCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b01bcbd85ca6f1f, []int{0}
+}
+func (m *RecordedCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordedCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordedCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordedCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordedCall.Merge(m, src)
+}
+func (m *RecordedCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordedCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordedCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordedCall proto.InternalMessageInfo
+
+func (m *RecordedCall) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *RecordedCall) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *RecordedCall) GetConnection() string {
+	if m != nil {
+		return m.Connection
+	}
+	return ""
+}
+
+func (m *RecordedCall) GetRequest() *Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *RecordedCall) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RecordedCall)(nil), "cometbft.abci.v2.RecordedCall")
+}
+
+func init() { proto.RegisterFile("cometbft/abci/v2/recording.proto", fileDescriptor_0b01bcbd85ca6f1f) }
+
+var fileDescriptor_0b01bcbd85ca6f1f = []byte{
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xe3, 0xde, 0x5e, 0x28, 0x86, 0x01, 0x59, 0x0c, 0xa1, 0x42, 0x6e, 0xc5, 0xd4, 0xc9,
+	0x16, 0xad, 0x84, 0xd8, 0x90, 0x0a, 0x1b, 0x9b, 0xc5, 0xc4, 0x96, 0xa4, 0xae, 0xb1, 0xd4, 0xc4,
+	0xc1, 0x71, 0x2a, 0xf1, 0x16, 0x9d, 0x10, 0x8f, 0xd4, 0xb1, 0x23, 0x13, 0xa0, 0xe4, 0x45, 0x50,
+	0xec, 0x24, 0x42, 0x29, 0xdb, 0xc9, 0xc9, 0xf7, 0xfd, 0xb6, 0xcf, 0x81, 0xe3, 0x48, 0xc5, 0xdc,
+	0x84, 0x4b, 0x43, 0x83, 0x30, 0x92, 0x74, 0x3d, 0xa5, 0x9a, 0x47, 0x4a, 0x2f, 0x64, 0x22, 0x48,
+	0xaa, 0x95, 0x51, 0xe8, 0xb4, 0x21, 0x48, 0x45, 0x90, 0xf5, 0x74, 0x78, 0xb1, 0xe7, 0x98, 0xd7,
+	0x94, 0x67, 0x8e, 0x1f, 0x9e, 0x09, 0x25, 0x94, 0x2d, 0x69, 0x55, 0xd5, 0xdd, 0x91, 0x50, 0x4a,
+	0xac, 0x38, 0xb5, 0x5f, 0x61, 0xbe, 0xa4, 0x46, 0xc6, 0x3c, 0x33, 0x41, 0x9c, 0xd6, 0x00, 0xee,
+	0x02, 0x8b, 0x5c, 0x07, 0x46, 0xaa, 0xc4, 0xfd, 0xbf, 0x7c, 0xeb, 0xc1, 0x13, 0x66, 0xaf, 0xc6,
+	0x17, 0x77, 0xc1, 0x6a, 0x85, 0x6e, 0x60, 0xbf, 0xca, 0xf0, 0xc1, 0x18, 0x4c, 0x8e, 0xa7, 0x43,
+	0xe2, 0x7c, 0xd2, 0xf8, 0xe4, 0xb1, 0x39, 0x60, 0x3e, 0xd8, 0x7e, 0x8e, 0xbc, 0xcd, 0xd7, 0x08,
+	0x30, 0x6b, 0xa0, 0x5b, 0x38, 0x68, 0xc2, 0xfd, 0x9e, 0xb5, 0xcf, 0xf7, 0xec, 0xfb, 0x1a, 0x70,
+	0xf2, 0x7b, 0x25, 0xb7, 0x12, 0xc2, 0x10, 0x46, 0x2a, 0x49, 0x78, 0x64, 0x23, 0xfe, 0x8d, 0xc1,
+	0xe4, 0x88, 0xfd, 0xea, 0xa0, 0x19, 0x3c, 0xd4, 0xfc, 0x25, 0xe7, 0x99, 0xf1, 0xfb, 0x75, 0x7e,
+	0x77, 0x88, 0x84, 0x39, 0x80, 0x35, 0x24, 0xba, 0x86, 0x03, 0xcd, 0xb3, 0x54, 0x25, 0x19, 0xf7,
+	0xff, 0xd7, 0x6f, 0xfa, 0xc3, 0x72, 0x04, 0x6b, 0xd9, 0xf9, 0xc3, 0xb6, 0xc0, 0x60, 0x57, 0x60,
+	0xf0, 0x5d, 0x60, 0xb0, 0x29, 0xb1, 0xb7, 0x2b, 0xb1, 0xf7, 0x51, 0x62, 0xef, 0xe9, 0x4a, 0x48,
+	0xf3, 0x9c, 0x87, 0x55, 0x0a, 0x6d, 0x57, 0xd6, 0x16, 0x41, 0x2a, 0x69, 0x77, 0x91, 0xe1, 0x81,
+	0x1d, 0xc0, 0xec, 0x67, 0x00, 0x53, 0x40, 0x0b, 0x4b, 0x17, 0x02, 0x00, 0x00,
+}
+
+func (m *RecordedCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordedCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordedCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecording(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecording(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Connection) > 0 {
+		i -= len(m.Connection)
+		copy(dAtA[i:], m.Connection)
+		i = encodeVarintRecording(dAtA, i, uint64(len(m.Connection)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintRecording(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintRecording(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRecording(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRecording(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecordedCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovRecording(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovRecording(uint64(l))
+	l = len(m.Connection)
+	if l > 0 {
+		n += 1 + l + sovRecording(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRecording(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovRecording(uint64(l))
+	}
+	return n
+}
+
+func sovRecording(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRecording(x uint64) (n int) {
+	return sovRecording(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RecordedCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecording
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordedCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordedCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecording
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecording
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecording
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecording
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecording
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecording
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecording
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRecording
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecording
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecording
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecording
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecording
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &Request{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecording
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecording
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecording
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &Response{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecording(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecording
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRecording(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRecording
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecording
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRecording
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRecording
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRecording
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRecording
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRecording        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRecording          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRecording = fmt.Errorf("proto: unexpected end of group")
+)
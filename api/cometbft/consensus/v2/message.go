@@ -66,6 +66,18 @@ func (m *HasProposalBlockPart) Wrap() proto.Message {
 	return cm
 }
 
+func (m *CompactBlock) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_CompactBlock{CompactBlock: m}
+	return cm
+}
+
+func (m *MissingBlockParts) Wrap() proto.Message {
+	cm := &Message{}
+	cm.Sum = &Message_MissingBlockParts{MissingBlockParts: m}
+	return cm
+}
+
 // Unwrap implements the p2p Wrapper interface and unwraps a wrapped consensus
 // proto message.
 func (m *Message) Unwrap() (proto.Message, error) {
@@ -100,6 +112,12 @@ func (m *Message) Unwrap() (proto.Message, error) {
 	case *Message_VoteSetBits:
 		return m.GetVoteSetBits(), nil
 
+	case *Message_CompactBlock:
+		return m.GetCompactBlock(), nil
+
+	case *Message_MissingBlockParts:
+		return m.GetMissingBlockParts(), nil
+
 	default:
 		return nil, fmt.Errorf("unknown message: %T", msg)
 	}
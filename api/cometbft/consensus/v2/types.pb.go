@@ -670,6 +670,228 @@ func (m *HasProposalBlockPart) GetIndex() int32 {
 	return 0
 }
 
+// CompactBlock is sent instead of the parts of the proposed block to the peers
+// which gossip compact blocks. The transactions of the block are identified by
+// their hashes, for the peer to take them from its mempool, except for the
+// ones the peer is unlikely to have, which are included.
+type CompactBlock struct {
+	Height             int64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round              int32            `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	BlockPartSetHeader v2.PartSetHeader `protobuf:"bytes,3,opt,name=block_part_set_header,json=blockPartSetHeader,proto3" json:"block_part_set_header"`
+	// The block, without its transactions.
+	Block    *v2.Block        `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	TxHashes [][]byte         `protobuf:"bytes,5,rep,name=tx_hashes,json=txHashes,proto3" json:"tx_hashes,omitempty"`
+	TxSizes  []uint32         `protobuf:"varint,6,rep,packed,name=tx_sizes,json=txSizes,proto3" json:"tx_sizes,omitempty"`
+	Txs      []CompactBlockTx `protobuf:"bytes,7,rep,name=txs,proto3" json:"txs"`
+	// The hashes of the parts of the block, from which the proofs of the parts
+	// reconstructed by the peer are computed.
+	PartHashes [][]byte `protobuf:"bytes,8,rep,name=part_hashes,json=partHashes,proto3" json:"part_hashes,omitempty"`
+}
+
+func (m *CompactBlock) Reset()         { *m = CompactBlock{} }
+func (m *CompactBlock) String() string { return proto.CompactTextString(m) }
+func (*CompactBlock) ProtoMessage()    {}
+func (*CompactBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbfa7f975842dd1, []int{10}
+}
+func (m *CompactBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlock.Merge(m, src)
+}
+func (m *CompactBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlock proto.InternalMessageInfo
+
+func (m *CompactBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CompactBlock) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *CompactBlock) GetBlockPartSetHeader() v2.PartSetHeader {
+	if m != nil {
+		return m.BlockPartSetHeader
+	}
+	return v2.PartSetHeader{}
+}
+
+func (m *CompactBlock) GetBlock() *v2.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetTxSizes() []uint32 {
+	if m != nil {
+		return m.TxSizes
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetTxs() []CompactBlockTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetPartHashes() [][]byte {
+	if m != nil {
+		return m.PartHashes
+	}
+	return nil
+}
+
+// CompactBlockTx is a transaction included in a CompactBlock.
+type CompactBlockTx struct {
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Tx    []byte `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *CompactBlockTx) Reset()         { *m = CompactBlockTx{} }
+func (m *CompactBlockTx) String() string { return proto.CompactTextString(m) }
+func (*CompactBlockTx) ProtoMessage()    {}
+func (*CompactBlockTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbfa7f975842dd1, []int{11}
+}
+func (m *CompactBlockTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompactBlockTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompactBlockTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompactBlockTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactBlockTx.Merge(m, src)
+}
+func (m *CompactBlockTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompactBlockTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactBlockTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactBlockTx proto.InternalMessageInfo
+
+func (m *CompactBlockTx) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *CompactBlockTx) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// MissingBlockParts is sent in response to a CompactBlock, to request the parts
+// of the block which could not be reconstructed.
+type MissingBlockParts struct {
+	Height int64       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round  int32       `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Parts  v1.BitArray `protobuf:"bytes,3,opt,name=parts,proto3" json:"parts"`
+}
+
+func (m *MissingBlockParts) Reset()         { *m = MissingBlockParts{} }
+func (m *MissingBlockParts) String() string { return proto.CompactTextString(m) }
+func (*MissingBlockParts) ProtoMessage()    {}
+func (*MissingBlockParts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbfa7f975842dd1, []int{12}
+}
+func (m *MissingBlockParts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissingBlockParts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissingBlockParts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissingBlockParts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissingBlockParts.Merge(m, src)
+}
+func (m *MissingBlockParts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissingBlockParts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissingBlockParts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissingBlockParts proto.InternalMessageInfo
+
+func (m *MissingBlockParts) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MissingBlockParts) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *MissingBlockParts) GetParts() v1.BitArray {
+	if m != nil {
+		return m.Parts
+	}
+	return v1.BitArray{}
+}
+
 // Message is an abstract consensus message.
 type Message struct {
 	// Sum of all possible messages.
@@ -685,6 +907,8 @@ type Message struct {
 	//	*Message_VoteSetMaj23
 	//	*Message_VoteSetBits
 	//	*Message_HasProposalBlockPart
+	//	*Message_CompactBlock
+	//	*Message_MissingBlockParts
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -692,7 +916,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbfa7f975842dd1, []int{10}
+	return fileDescriptor_1fbfa7f975842dd1, []int{13}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,6 +981,12 @@ type Message_VoteSetBits struct {
 type Message_HasProposalBlockPart struct {
 	HasProposalBlockPart *HasProposalBlockPart `protobuf:"bytes,10,opt,name=has_proposal_block_part,json=hasProposalBlockPart,proto3,oneof" json:"has_proposal_block_part,omitempty"`
 }
+type Message_CompactBlock struct {
+	CompactBlock *CompactBlock `protobuf:"bytes,11,opt,name=compact_block,json=compactBlock,proto3,oneof" json:"compact_block,omitempty"`
+}
+type Message_MissingBlockParts struct {
+	MissingBlockParts *MissingBlockParts `protobuf:"bytes,12,opt,name=missing_block_parts,json=missingBlockParts,proto3,oneof" json:"missing_block_parts,omitempty"`
+}
 
 func (*Message_NewRoundStep) isMessage_Sum()         {}
 func (*Message_NewValidBlock) isMessage_Sum()        {}
@@ -768,6 +998,8 @@ func (*Message_HasVote) isMessage_Sum()              {}
 func (*Message_VoteSetMaj23) isMessage_Sum()         {}
 func (*Message_VoteSetBits) isMessage_Sum()          {}
 func (*Message_HasProposalBlockPart) isMessage_Sum() {}
+func (*Message_CompactBlock) isMessage_Sum()         {}
+func (*Message_MissingBlockParts) isMessage_Sum()    {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -846,6 +1078,20 @@ func (m *Message) GetHasProposalBlockPart() *HasProposalBlockPart {
 	return nil
 }
 
+func (m *Message) GetCompactBlock() *CompactBlock {
+	if x, ok := m.GetSum().(*Message_CompactBlock); ok {
+		return x.CompactBlock
+	}
+	return nil
+}
+
+func (m *Message) GetMissingBlockParts() *MissingBlockParts {
+	if x, ok := m.GetSum().(*Message_MissingBlockParts); ok {
+		return x.MissingBlockParts
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -859,6 +1105,8 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_VoteSetMaj23)(nil),
 		(*Message_VoteSetBits)(nil),
 		(*Message_HasProposalBlockPart)(nil),
+		(*Message_CompactBlock)(nil),
+		(*Message_MissingBlockParts)(nil),
 	}
 }
 
@@ -873,70 +1121,85 @@ func init() {
 	proto.RegisterType((*VoteSetMaj23)(nil), "cometbft.consensus.v2.VoteSetMaj23")
 	proto.RegisterType((*VoteSetBits)(nil), "cometbft.consensus.v2.VoteSetBits")
 	proto.RegisterType((*HasProposalBlockPart)(nil), "cometbft.consensus.v2.HasProposalBlockPart")
+	proto.RegisterType((*CompactBlock)(nil), "cometbft.consensus.v2.CompactBlock")
+	proto.RegisterType((*CompactBlockTx)(nil), "cometbft.consensus.v2.CompactBlockTx")
+	proto.RegisterType((*MissingBlockParts)(nil), "cometbft.consensus.v2.MissingBlockParts")
 	proto.RegisterType((*Message)(nil), "cometbft.consensus.v2.Message")
 }
 
 func init() { proto.RegisterFile("cometbft/consensus/v2/types.proto", fileDescriptor_1fbfa7f975842dd1) }
 
 var fileDescriptor_1fbfa7f975842dd1 = []byte{
-	// 908 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0x9e, 0x21, 0x76, 0xec, 0x94, 0xf3, 0x03, 0xad, 0x84, 0xb5, 0xb2, 0xc2, 0x31, 0x03, 0x87,
-	0x88, 0x45, 0x63, 0xc5, 0x41, 0x70, 0x58, 0x21, 0xb1, 0x06, 0xc1, 0x44, 0xbb, 0xc9, 0x5a, 0xed,
-	0xd5, 0x4a, 0xec, 0x65, 0x34, 0xf6, 0x34, 0x76, 0x83, 0x3d, 0x3d, 0x9a, 0xee, 0x4c, 0xc8, 0x99,
-	0x17, 0xe0, 0x05, 0x78, 0x0c, 0x2e, 0x3c, 0xc1, 0x1e, 0xf7, 0xc8, 0x69, 0x85, 0x1c, 0xf1, 0x0a,
-	0x70, 0x45, 0x5d, 0xd3, 0x1e, 0x8f, 0xb3, 0xf6, 0x86, 0x70, 0x40, 0xda, 0x5b, 0x4f, 0x57, 0xd5,
-	0xd7, 0xd5, 0x5f, 0x55, 0x7d, 0x3d, 0xf0, 0xfe, 0x40, 0x4c, 0x98, 0xea, 0x7f, 0xa7, 0x5a, 0x03,
-	0x11, 0x49, 0x16, 0xc9, 0x73, 0xd9, 0x4a, 0xdb, 0x2d, 0x75, 0x19, 0x33, 0xe9, 0xc6, 0x89, 0x50,
-	0x82, 0xec, 0xcd, 0x5c, 0xdc, 0xdc, 0xc5, 0x4d, 0xdb, 0xfb, 0xbb, 0x43, 0x31, 0x14, 0xe8, 0xd1,
-	0xd2, 0xab, 0xcc, 0x79, 0x7f, 0x8e, 0x37, 0xe6, 0x7d, 0xd9, 0xea, 0x73, 0x25, 0x5b, 0xe9, 0x51,
-	0x11, 0x6f, 0xff, 0xbd, 0xdc, 0x05, 0x77, 0xaf, 0x1d, 0xe7, 0xfc, 0x6a, 0xc3, 0xe6, 0x19, 0xbb,
-	0xa0, 0xe2, 0x3c, 0x0a, 0x7b, 0x8a, 0xc5, 0xe4, 0x5d, 0x58, 0x1f, 0x31, 0x3e, 0x1c, 0xa9, 0xba,
-	0xdd, 0xb4, 0x0f, 0xd7, 0xa8, 0xf9, 0x22, 0xbb, 0x50, 0x4e, 0xb4, 0x53, 0xfd, 0xad, 0xa6, 0x7d,
-	0x58, 0xa6, 0xd9, 0x07, 0x21, 0x50, 0x92, 0x8a, 0xc5, 0xf5, 0xb5, 0xa6, 0x7d, 0xb8, 0x45, 0x71,
-	0x4d, 0x3e, 0x83, 0xba, 0x64, 0x03, 0x11, 0x85, 0xd2, 0x97, 0x3c, 0x1a, 0x30, 0x5f, 0xaa, 0x20,
-	0x51, 0xbe, 0xe2, 0x13, 0x56, 0x2f, 0x21, 0xe6, 0x9e, 0xb1, 0xf7, 0xb4, 0xb9, 0xa7, 0xad, 0x4f,
-	0xf8, 0x84, 0x91, 0x8f, 0xe0, 0x9d, 0x71, 0x20, 0x95, 0x3f, 0x10, 0x93, 0x09, 0x57, 0x7e, 0x76,
-	0x5c, 0x19, 0x8f, 0xdb, 0xd1, 0x86, 0x2f, 0x71, 0x1f, 0x53, 0x75, 0xfe, 0xb6, 0x61, 0xeb, 0x8c,
-	0x5d, 0x3c, 0x0d, 0xc6, 0x3c, 0xec, 0x8c, 0xc5, 0xe0, 0x87, 0x5b, 0x26, 0xfe, 0x2d, 0xec, 0xf5,
-	0x75, 0x98, 0x1f, 0xeb, 0xdc, 0x24, 0x53, 0xfe, 0x88, 0x05, 0x21, 0x4b, 0xf0, 0x26, 0xb5, 0x76,
-	0xd3, 0xcd, 0xcb, 0x90, 0xb1, 0x95, 0xb6, 0xdd, 0x6e, 0x90, 0xa8, 0x1e, 0x53, 0x1e, 0xfa, 0x75,
-	0x4a, 0xcf, 0x5f, 0x1e, 0x58, 0x94, 0x20, 0xc8, 0x82, 0x85, 0x7c, 0x01, 0xb5, 0x39, 0xb4, 0xc4,
-	0x2b, 0xd7, 0xda, 0x07, 0x73, 0x40, 0x5d, 0x2a, 0x57, 0x97, 0xca, 0x4d, 0x8f, 0xdc, 0x0e, 0x57,
-	0x0f, 0x92, 0x24, 0xb8, 0xa4, 0x90, 0x23, 0x49, 0x72, 0x17, 0x36, 0xb8, 0x34, 0x34, 0x20, 0x01,
-	0x55, 0x5a, 0xe5, 0x32, 0xbb, 0xbe, 0x73, 0x02, 0xd5, 0x6e, 0x22, 0x62, 0x21, 0x83, 0x31, 0xf9,
-	0x1c, 0xaa, 0xb1, 0x59, 0xe3, 0xad, 0x6b, 0xed, 0xbb, 0xcb, 0x12, 0x37, 0x2e, 0x26, 0xe7, 0x3c,
-	0xc4, 0xf9, 0xc5, 0x86, 0xda, 0xcc, 0xd8, 0x7d, 0xfc, 0x68, 0x25, 0x85, 0x1f, 0x03, 0x99, 0xc5,
-	0xf8, 0xb1, 0x18, 0xfb, 0x45, 0x3e, 0xdf, 0x9e, 0x59, 0xba, 0x62, 0x8c, 0xa5, 0x21, 0x1e, 0x6c,
-	0x16, 0xbd, 0x0d, 0xa3, 0x37, 0x11, 0x60, 0x92, 0xab, 0x15, 0xe0, 0x9c, 0x31, 0x6c, 0x74, 0x66,
-	0xac, 0xdc, 0xb2, 0xbe, 0x47, 0x50, 0xd2, 0xf4, 0x9b, 0xc3, 0xef, 0xac, 0x28, 0xa7, 0x39, 0x14,
-	0x5d, 0x9d, 0x63, 0x28, 0x3d, 0x15, 0x8a, 0x91, 0x7b, 0x50, 0x4a, 0x85, 0x62, 0x86, 0xd0, 0x65,
-	0xa1, 0xda, 0x8d, 0xa2, 0x93, 0xf3, 0x93, 0x0d, 0x15, 0x2f, 0x90, 0x18, 0x78, 0xbb, 0x0c, 0x3f,
-	0x81, 0x92, 0x06, 0xc4, 0x0c, 0xb7, 0x97, 0x36, 0x5c, 0x8f, 0x0f, 0x23, 0x16, 0x9e, 0xca, 0xe1,
-	0x93, 0xcb, 0x98, 0x51, 0xf4, 0xd6, 0x58, 0x3c, 0x0a, 0xd9, 0x8f, 0xd8, 0x56, 0x65, 0x9a, 0x7d,
-	0x38, 0xbf, 0xd9, 0xb0, 0xa9, 0x53, 0xe8, 0x31, 0x75, 0x1a, 0x7c, 0xdf, 0x3e, 0xfe, 0x5f, 0x52,
-	0xf9, 0x1a, 0xaa, 0x59, 0x9f, 0xf3, 0xd0, 0x34, 0xf9, 0xfe, 0x92, 0x48, 0x2c, 0xe0, 0xc9, 0x57,
-	0x9d, 0x1d, 0xcd, 0xf4, 0xf4, 0xe5, 0x41, 0xc5, 0x6c, 0xd0, 0x0a, 0x06, 0x9f, 0x84, 0xce, 0x5f,
-	0x36, 0xd4, 0x4c, 0xf2, 0x1d, 0xae, 0xe4, 0x9b, 0x94, 0x3b, 0xb9, 0x0f, 0x65, 0xdd, 0x06, 0x12,
-	0xa7, 0xf4, 0x5f, 0x37, 0x79, 0x16, 0xe3, 0x3c, 0x83, 0x5d, 0x2f, 0x90, 0xf9, 0x74, 0xfe, 0xc7,
-	0x4e, 0xcf, 0x3b, 0x62, 0xad, 0xd8, 0x11, 0x7f, 0x96, 0xa1, 0x72, 0xca, 0xa4, 0x0c, 0x86, 0x8c,
-	0x3c, 0x84, 0xed, 0x88, 0x5d, 0x64, 0x53, 0xeb, 0xa3, 0x5c, 0x67, 0xad, 0xfd, 0x81, 0xbb, 0xf4,
-	0xad, 0x71, 0x8b, 0xef, 0x81, 0x67, 0xd1, 0xcd, 0xa8, 0xf8, 0x3e, 0x9c, 0xc1, 0x8e, 0x06, 0x4b,
-	0xb5, 0xf0, 0xfa, 0x48, 0x03, 0xa6, 0x53, 0x6b, 0x7f, 0xb8, 0x1a, 0x6d, 0xae, 0xd2, 0x9e, 0x45,
-	0xb7, 0xa2, 0x05, 0xd9, 0x2e, 0x4a, 0xd8, 0x2b, 0x4a, 0xb1, 0x00, 0x34, 0x23, 0xca, 0x2b, 0x48,
-	0x18, 0xf9, 0xe6, 0x9a, 0xd8, 0x64, 0xc5, 0x74, 0x6e, 0x80, 0xe8, 0x3e, 0x7e, 0xe4, 0x2d, 0x6a,
-	0x0d, 0x79, 0x00, 0x30, 0x57, 0x6d, 0x53, 0xce, 0xe6, 0x0a, 0x98, 0xbc, 0x54, 0x9e, 0x45, 0x37,
-	0x72, 0xdd, 0xd6, 0x9a, 0x83, 0xc2, 0xb1, 0x7e, 0x5d, 0x89, 0x17, 0x82, 0x75, 0xab, 0x7b, 0x56,
-	0x26, 0x1f, 0xe4, 0x3e, 0x54, 0x47, 0x81, 0xf4, 0x31, 0xac, 0x82, 0x61, 0x8d, 0x15, 0x61, 0x46,
-	0x64, 0x3c, 0x8b, 0x56, 0x46, 0x46, 0x6f, 0x1e, 0xc2, 0xb6, 0x0e, 0xc4, 0xd7, 0x6b, 0xa2, 0xc7,
-	0xbe, 0x5e, 0x7d, 0x6d, 0x5d, 0x8b, 0x0a, 0xa1, 0xeb, 0x9a, 0x16, 0x15, 0xc3, 0x83, 0xad, 0x1c,
-	0x4c, 0xb7, 0x6d, 0x7d, 0xe3, 0xb5, 0x4c, 0x16, 0x06, 0x56, 0x33, 0x99, 0x16, 0xe6, 0x37, 0x84,
-	0x3b, 0xfa, 0x4e, 0x79, 0x59, 0x0a, 0xb4, 0x02, 0x62, 0xde, 0x5b, 0x7d, 0xc5, 0x57, 0x86, 0xc1,
-	0xb3, 0xe8, 0xee, 0x68, 0xc9, 0x7e, 0xa7, 0x0c, 0x6b, 0xf2, 0x7c, 0xd2, 0xe9, 0x3e, 0x9f, 0x36,
-	0xec, 0x17, 0xd3, 0x86, 0xfd, 0xc7, 0xb4, 0x61, 0xff, 0x7c, 0xd5, 0xb0, 0x5e, 0x5c, 0x35, 0xac,
-	0xdf, 0xaf, 0x1a, 0xd6, 0xb3, 0x4f, 0x87, 0x5c, 0x8d, 0xce, 0xfb, 0xfa, 0xac, 0x56, 0xe1, 0xb7,
-	0xcb, 0x2c, 0x82, 0x98, 0xb7, 0x96, 0xfe, 0x8c, 0xf5, 0xd7, 0xf1, 0xc7, 0xe8, 0xf8, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xc2, 0x8d, 0x4e, 0xe0, 0xac, 0x09, 0x00, 0x00,
+	// 1101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6e, 0x23, 0xc5,
+	0x13, 0x9f, 0xf1, 0x47, 0xec, 0x94, 0xed, 0xe4, 0x9f, 0xfe, 0x27, 0xec, 0x90, 0x15, 0x8e, 0x19,
+	0x40, 0xb2, 0x58, 0x34, 0x56, 0x1c, 0xb4, 0x1c, 0xa2, 0x95, 0x58, 0x2f, 0x82, 0x09, 0x6c, 0xb2,
+	0x56, 0x3b, 0x5a, 0x89, 0x5c, 0x46, 0x63, 0xbb, 0xb1, 0x1b, 0x3c, 0x1f, 0x72, 0x77, 0x1c, 0x87,
+	0x03, 0x17, 0xce, 0x48, 0xbc, 0x00, 0x8f, 0xc1, 0x85, 0x27, 0xd8, 0xe3, 0x1e, 0x39, 0x2d, 0x28,
+	0x79, 0x07, 0xb8, 0xa2, 0xee, 0x1e, 0x8f, 0xc7, 0x89, 0x27, 0x60, 0x0e, 0x08, 0x6e, 0x5d, 0xdd,
+	0x55, 0xbf, 0xae, 0xfa, 0x55, 0x75, 0xd5, 0x0c, 0xbc, 0xd9, 0x0b, 0x3c, 0xc2, 0xbb, 0x5f, 0xf0,
+	0x46, 0x2f, 0xf0, 0x19, 0xf1, 0xd9, 0x39, 0x6b, 0x4c, 0x9a, 0x0d, 0x7e, 0x19, 0x12, 0x66, 0x85,
+	0xe3, 0x80, 0x07, 0x68, 0x67, 0xa6, 0x62, 0xc5, 0x2a, 0xd6, 0xa4, 0xb9, 0xbb, 0x3d, 0x08, 0x06,
+	0x81, 0xd4, 0x68, 0x88, 0x95, 0x52, 0xde, 0x9d, 0xe3, 0x8d, 0x68, 0x97, 0x35, 0xba, 0x94, 0xb3,
+	0xc6, 0x64, 0x3f, 0x89, 0xb7, 0xfb, 0x46, 0xac, 0x22, 0x77, 0xc5, 0x75, 0xdd, 0x51, 0xd0, 0xfb,
+	0x2a, 0xfd, 0x38, 0x61, 0x6d, 0xfe, 0xa8, 0x43, 0xf9, 0x84, 0x5c, 0xe0, 0xe0, 0xdc, 0xef, 0x77,
+	0x38, 0x09, 0xd1, 0x6b, 0xb0, 0x36, 0x24, 0x74, 0x30, 0xe4, 0x86, 0x5e, 0xd3, 0xeb, 0x59, 0x1c,
+	0x49, 0x68, 0x1b, 0xf2, 0x63, 0xa1, 0x64, 0x64, 0x6a, 0x7a, 0x3d, 0x8f, 0x95, 0x80, 0x10, 0xe4,
+	0x18, 0x27, 0xa1, 0x91, 0xad, 0xe9, 0xf5, 0x0a, 0x96, 0x6b, 0xf4, 0x01, 0x18, 0x8c, 0xf4, 0x02,
+	0xbf, 0xcf, 0x1c, 0x46, 0xfd, 0x1e, 0x71, 0x18, 0x77, 0xc7, 0xdc, 0xe1, 0xd4, 0x23, 0x46, 0x4e,
+	0x62, 0xee, 0x44, 0xe7, 0x1d, 0x71, 0xdc, 0x11, 0xa7, 0xa7, 0xd4, 0x23, 0xe8, 0x5d, 0xd8, 0x1a,
+	0xb9, 0x8c, 0x3b, 0xbd, 0xc0, 0xf3, 0x28, 0x77, 0xd4, 0x75, 0x79, 0x79, 0xdd, 0xa6, 0x38, 0x78,
+	0x22, 0xf7, 0xa5, 0xab, 0xe6, 0xef, 0x3a, 0x54, 0x4e, 0xc8, 0xc5, 0x73, 0x77, 0x44, 0xfb, 0x2d,
+	0x11, 0xee, 0x8a, 0x8e, 0x7f, 0x0e, 0x3b, 0x92, 0x25, 0x27, 0x14, 0xbe, 0x31, 0xc2, 0x9d, 0x21,
+	0x71, 0xfb, 0x64, 0x2c, 0x23, 0x29, 0x35, 0x6b, 0x56, 0x9c, 0x25, 0xc5, 0xd6, 0xa4, 0x69, 0xb5,
+	0xdd, 0x31, 0xef, 0x10, 0x6e, 0x4b, 0xbd, 0x56, 0xee, 0xc5, 0xab, 0x3d, 0x0d, 0x23, 0x09, 0xb2,
+	0x70, 0x82, 0x3e, 0x84, 0xd2, 0x1c, 0x9a, 0xc9, 0x90, 0x4b, 0xcd, 0xbd, 0x39, 0xa0, 0xc8, 0xa4,
+	0x25, 0x32, 0x69, 0x4d, 0xf6, 0xad, 0x16, 0xe5, 0x8f, 0xc7, 0x63, 0xf7, 0x12, 0x43, 0x8c, 0xc4,
+	0xd0, 0x7d, 0x58, 0xa7, 0x2c, 0xa2, 0x41, 0x12, 0x50, 0xc4, 0x45, 0xca, 0x54, 0xf8, 0xe6, 0x11,
+	0x14, 0xdb, 0xe3, 0x20, 0x0c, 0x98, 0x3b, 0x42, 0x8f, 0xa0, 0x18, 0x46, 0x6b, 0x19, 0x75, 0xa9,
+	0x79, 0x7f, 0x99, 0xe3, 0x91, 0x4a, 0xe4, 0x73, 0x6c, 0x62, 0xfe, 0xa0, 0x43, 0x69, 0x76, 0xd8,
+	0x7e, 0xf6, 0x34, 0x95, 0xc2, 0xf7, 0x00, 0xcd, 0x6c, 0x9c, 0x30, 0x18, 0x39, 0x49, 0x3e, 0xff,
+	0x37, 0x3b, 0x69, 0x07, 0x23, 0x99, 0x1a, 0x64, 0x43, 0x39, 0xa9, 0x6d, 0x64, 0xff, 0x12, 0x01,
+	0x91, 0x73, 0xa5, 0x04, 0x9c, 0x39, 0x82, 0xf5, 0xd6, 0x8c, 0x95, 0x15, 0xf3, 0xbb, 0x0f, 0x39,
+	0x41, 0x7f, 0x74, 0xf9, 0xbd, 0x94, 0x74, 0x46, 0x97, 0x4a, 0x55, 0xf3, 0x00, 0x72, 0xcf, 0x03,
+	0x4e, 0xd0, 0x03, 0xc8, 0x4d, 0x02, 0x4e, 0x0c, 0x3d, 0xd5, 0x54, 0xa8, 0x61, 0xa9, 0x64, 0x7e,
+	0xab, 0x43, 0xc1, 0x76, 0x99, 0x34, 0x5c, 0xcd, 0xc3, 0xf7, 0x21, 0x27, 0x00, 0xa5, 0x87, 0x1b,
+	0x4b, 0x0b, 0xae, 0x43, 0x07, 0x3e, 0xe9, 0x1f, 0xb3, 0xc1, 0xe9, 0x65, 0x48, 0xb0, 0xd4, 0x16,
+	0x58, 0xd4, 0xef, 0x93, 0xa9, 0x2c, 0xab, 0x3c, 0x56, 0x82, 0xf9, 0x93, 0x0e, 0x65, 0xe1, 0x42,
+	0x87, 0xf0, 0x63, 0xf7, 0xcb, 0xe6, 0xc1, 0x3f, 0xe2, 0xca, 0xc7, 0x50, 0x54, 0x75, 0x4e, 0xfb,
+	0x51, 0x91, 0xef, 0x2e, 0xb1, 0x94, 0x09, 0x3c, 0xfa, 0xa8, 0xb5, 0x29, 0x98, 0xbe, 0x7a, 0xb5,
+	0x57, 0x88, 0x36, 0x70, 0x41, 0x1a, 0x1f, 0xf5, 0xcd, 0xdf, 0x74, 0x28, 0x45, 0xce, 0xb7, 0x28,
+	0x67, 0xff, 0x25, 0xdf, 0xd1, 0x21, 0xe4, 0x45, 0x19, 0x30, 0x23, 0xbf, 0x4a, 0x91, 0x2b, 0x1b,
+	0xf3, 0x0c, 0xb6, 0x6d, 0x97, 0xc5, 0xaf, 0xf3, 0x6f, 0x56, 0x7a, 0x5c, 0x11, 0xd9, 0x64, 0x45,
+	0xfc, 0x92, 0x81, 0xf2, 0x93, 0xc0, 0x0b, 0xdd, 0x1e, 0xff, 0x97, 0xb5, 0x47, 0x0b, 0xf2, 0x72,
+	0x37, 0xe2, 0xdd, 0x48, 0xe3, 0x1d, 0x2b, 0x35, 0xd1, 0x0c, 0xf9, 0xd4, 0x19, 0xba, 0x6c, 0x28,
+	0x69, 0xce, 0xd6, 0xcb, 0xb8, 0xc8, 0xa7, 0xb6, 0x94, 0xd1, 0xeb, 0x50, 0xe4, 0x53, 0x87, 0xd1,
+	0xaf, 0x09, 0x33, 0xd6, 0x6a, 0xd9, 0x7a, 0x05, 0x17, 0xf8, 0xb4, 0x23, 0x44, 0xf4, 0x08, 0xb2,
+	0x7c, 0xca, 0x8c, 0x42, 0x2d, 0x5b, 0x2f, 0x35, 0xdf, 0xb1, 0x96, 0x4e, 0x5d, 0x2b, 0x49, 0xd1,
+	0xe9, 0x34, 0xf2, 0x5a, 0xd8, 0xa1, 0x3d, 0x28, 0xc9, 0xd8, 0xa3, 0x8b, 0x8b, 0xf2, 0x62, 0x10,
+	0x5b, 0xea, 0x6a, 0xf3, 0x21, 0x6c, 0x2c, 0x5a, 0xcf, 0x33, 0xa1, 0xcb, 0x69, 0xa8, 0x04, 0xb4,
+	0x01, 0x19, 0x3e, 0x95, 0xec, 0x96, 0x71, 0x86, 0x4f, 0xcd, 0x6f, 0x60, 0xeb, 0x98, 0x32, 0x46,
+	0xfd, 0x41, 0x6b, 0xde, 0xf1, 0x57, 0xcb, 0xce, 0x21, 0xe4, 0xd5, 0x6c, 0x59, 0xa9, 0xb5, 0x2a,
+	0x1b, 0xf3, 0xbb, 0x02, 0x14, 0x8e, 0x09, 0x63, 0xee, 0x80, 0xa0, 0xcf, 0x60, 0xc3, 0x27, 0x17,
+	0xaa, 0x9f, 0x3b, 0x72, 0x90, 0xab, 0xa6, 0xf7, 0x56, 0x0a, 0x5d, 0xc9, 0x2f, 0x05, 0x5b, 0xc3,
+	0x65, 0x3f, 0x21, 0xa3, 0x13, 0xd8, 0x14, 0x60, 0x13, 0x31, 0x92, 0x1d, 0x95, 0xe2, 0x8c, 0x44,
+	0x7b, 0x3b, 0x1d, 0x6d, 0x3e, 0xbf, 0x6d, 0x0d, 0x57, 0xfc, 0xe4, 0xc6, 0xc2, 0x70, 0xbb, 0x15,
+	0xe8, 0x02, 0xd0, 0xec, 0x09, 0xd9, 0x89, 0xe1, 0x86, 0x3e, 0xb9, 0x31, 0x86, 0x54, 0xb9, 0x99,
+	0x7f, 0x02, 0xd1, 0x7e, 0xf6, 0xd4, 0x5e, 0x9c, 0x42, 0xe8, 0x31, 0xc0, 0xfc, 0x2d, 0x18, 0xf9,
+	0x9b, 0x0f, 0x60, 0x01, 0x26, 0x4e, 0xa9, 0xad, 0xe1, 0xf5, 0xb8, 0xf8, 0xc5, 0x34, 0x92, 0x23,
+	0x65, 0xed, 0xe6, 0x8c, 0x5e, 0x30, 0x16, 0x4d, 0xd0, 0xd6, 0xd4, 0x60, 0x41, 0x87, 0x50, 0x1c,
+	0xba, 0xcc, 0x91, 0x66, 0x05, 0x69, 0x56, 0x4d, 0x31, 0x8b, 0xc6, 0x8f, 0xad, 0xe1, 0xc2, 0x50,
+	0x2d, 0x45, 0x5e, 0x85, 0xa1, 0x7c, 0xb8, 0x9e, 0x18, 0x08, 0x46, 0xf1, 0xce, 0xbc, 0x26, 0x67,
+	0x87, 0xc8, 0xeb, 0x24, 0x21, 0x23, 0x1b, 0x2a, 0x31, 0x98, 0x28, 0x2d, 0x63, 0xfd, 0x4e, 0x26,
+	0x13, 0xad, 0x5c, 0x30, 0x39, 0x99, 0x8b, 0xa8, 0x0f, 0xf7, 0x44, 0x4c, 0x71, 0x5a, 0x12, 0xb4,
+	0x82, 0xc4, 0x7c, 0x90, 0x1e, 0xe2, 0xad, 0x36, 0x69, 0x6b, 0x78, 0x7b, 0xb8, 0x64, 0x1f, 0x7d,
+	0x0a, 0x95, 0x9e, 0x7a, 0x98, 0x51, 0x15, 0x96, 0xee, 0x8c, 0x3d, 0xf9, 0x88, 0x45, 0xec, 0xbd,
+	0x84, 0x8c, 0xce, 0xe0, 0xff, 0x9e, 0x7a, 0xac, 0x4e, 0xf2, 0x9b, 0xae, 0x2c, 0x11, 0xeb, 0x29,
+	0x88, 0xb7, 0x9e, 0xb7, 0xad, 0xe1, 0x2d, 0xef, 0xe6, 0x66, 0x2b, 0x0f, 0x59, 0x76, 0xee, 0xb5,
+	0xda, 0x2f, 0xae, 0xaa, 0xfa, 0xcb, 0xab, 0xaa, 0xfe, 0xeb, 0x55, 0x55, 0xff, 0xfe, 0xba, 0xaa,
+	0xbd, 0xbc, 0xae, 0x6a, 0x3f, 0x5f, 0x57, 0xb5, 0xb3, 0x87, 0x03, 0xca, 0x87, 0xe7, 0x5d, 0x71,
+	0x4b, 0x23, 0xf1, 0x5f, 0x11, 0x2d, 0xdc, 0x90, 0x36, 0x96, 0xfe, 0x6d, 0x74, 0xd7, 0xe4, 0xa7,
+	0xfd, 0xc1, 0x1f, 0x03, 0x00, 0xf7, 0xbd, 0x45, 0x20, 0x8d, 0x0c, 0x00, 0x00,
 }
 
 func (m *NewRoundStep) Marshal() (dAtA []byte, err error) {
@@ -1393,7 +1656,7 @@ func (m *HasProposalBlockPart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
+func (m *CompactBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1403,38 +1666,69 @@ func (m *Message) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompactBlock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+	if len(m.PartHashes) > 0 {
+		for iNdEx := len(m.PartHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartHashes[iNdEx])
+			copy(dAtA[i:], m.PartHashes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.PartHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
 			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Message_NewRoundStep) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NewRoundStep != nil {
+	if len(m.TxSizes) > 0 {
+		dAtA11 := make([]byte, len(m.TxSizes)*10)
+		var j10 int
+		for _, num := range m.TxSizes {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTypes(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TxHashes) > 0 {
+		for iNdEx := len(m.TxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxHashes[iNdEx])
+			copy(dAtA[i:], m.TxHashes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.TxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Block != nil {
 		{
-			size, err := m.NewRoundStep.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1442,60 +1736,212 @@ func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.BlockPartSetHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_NewValidBlock) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *CompactBlockTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactBlockTx) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_NewValidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompactBlockTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.NewValidBlock != nil {
-		{
-			size, err := m.NewValidBlock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tx)))
 		i--
 		dAtA[i] = 0x12
 	}
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Message_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Proposal != nil {
-		{
-			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (m *MissingBlockParts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *Message_ProposalPol) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *MissingBlockParts) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_ProposalPol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MissingBlockParts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.ProposalPol != nil {
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Parts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Message_NewRoundStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_NewRoundStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NewRoundStep != nil {
+		{
+			size, err := m.NewRoundStep.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_NewValidBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_NewValidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NewValidBlock != nil {
+		{
+			size, err := m.NewValidBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_ProposalPol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_ProposalPol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ProposalPol != nil {
 		{
 			size, err := m.ProposalPol.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -1635,6 +2081,48 @@ func (m *Message_HasProposalBlockPart) MarshalToSizedBuffer(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_CompactBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_CompactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CompactBlock != nil {
+		{
+			size, err := m.CompactBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_MissingBlockParts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MissingBlockParts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MissingBlockParts != nil {
+		{
+			size, err := m.MissingBlockParts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1833,6 +2321,85 @@ func (m *HasProposalBlockPart) Size() (n int) {
 	return n
 }
 
+func (m *CompactBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	l = m.BlockPartSetHeader.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.TxHashes) > 0 {
+		for _, b := range m.TxHashes {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.TxSizes) > 0 {
+		l = 0
+		for _, e := range m.TxSizes {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.PartHashes) > 0 {
+		for _, b := range m.PartHashes {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompactBlockTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MissingBlockParts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	l = m.Parts.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1965,6 +2532,30 @@ func (m *Message_HasProposalBlockPart) Size() (n int) {
 	}
 	return n
 }
+func (m *Message_CompactBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactBlock != nil {
+		l = m.CompactBlock.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Message_MissingBlockParts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissingBlockParts != nil {
+		l = m.MissingBlockParts.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -3251,7 +3842,7 @@ func (m *HasProposalBlockPart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Message) Unmarshal(dAtA []byte) error {
+func (m *CompactBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3274,17 +3865,17 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRoundStep", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3294,22 +3885,577 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			v := &NewRoundStep{}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockPartSetHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockPartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &v2.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHashes = append(m.TxHashes, make([]byte, postIndex-iNdEx))
+			copy(m.TxHashes[len(m.TxHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TxSizes = append(m.TxSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TxSizes) == 0 {
+					m.TxSizes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TxSizes = append(m.TxSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizes", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, CompactBlockTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartHashes = append(m.PartHashes, make([]byte, postIndex-iNdEx))
+			copy(m.PartHashes[len(m.PartHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactBlockTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactBlockTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactBlockTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissingBlockParts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissingBlockParts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissingBlockParts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Parts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoundStep", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NewRoundStep{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -3630,6 +4776,76 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_HasProposalBlockPart{v}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CompactBlock{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_CompactBlock{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingBlockParts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MissingBlockParts{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_MissingBlockParts{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/privval/v2/service.proto
+
+package v2
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("cometbft/privval/v2/service.proto", fileDescriptor_5bfc107d4131c50c) }
+
+var fileDescriptor_5bfc107d4131c50c = []byte{
+	// 273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0xcf, 0x4d,
+	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x28, 0xca, 0x2c, 0x2b, 0x4b, 0xcc, 0xd1, 0x2f, 0x33, 0xd2,
+	0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
+	0x29, 0xd1, 0x83, 0x2a, 0xd1, 0x2b, 0x33, 0x92, 0x92, 0xc7, 0xa6, 0xaf, 0xa4, 0xb2, 0x20, 0xb5,
+	0x18, 0xa2, 0xcb, 0xa8, 0x8b, 0x99, 0x4b, 0x24, 0xa0, 0x28, 0xb3, 0x2c, 0x2c, 0x31, 0x27, 0x33,
+	0x25, 0xb1, 0x24, 0xbf, 0x28, 0x18, 0x62, 0xa8, 0x50, 0x08, 0x17, 0xa7, 0x7b, 0x6a, 0x49, 0x40,
+	0x69, 0x92, 0x77, 0x6a, 0xa5, 0x90, 0x92, 0x1e, 0x16, 0xc3, 0xf5, 0x20, 0x92, 0x41, 0xa9, 0x85,
+	0xa5, 0xa9, 0xc5, 0x25, 0x52, 0xca, 0x78, 0xd5, 0x14, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x0a, 0x45,
+	0x72, 0x71, 0x04, 0x67, 0xa6, 0xe7, 0x85, 0xe5, 0x97, 0xa4, 0x0a, 0xa9, 0x60, 0xd5, 0x00, 0x93,
+	0x86, 0x19, 0xab, 0x8e, 0x53, 0x55, 0x6a, 0x0a, 0x44, 0x1d, 0xd4, 0xe8, 0x54, 0x2e, 0x1e, 0x90,
+	0x68, 0x40, 0x51, 0x7e, 0x41, 0x7e, 0x71, 0x62, 0x8e, 0x90, 0x06, 0x4e, 0x8d, 0x30, 0x25, 0x30,
+	0x2b, 0xb4, 0xf1, 0x58, 0x81, 0x50, 0x0b, 0xb5, 0x26, 0x8a, 0x8b, 0x13, 0x24, 0xe3, 0x54, 0x59,
+	0x92, 0x5a, 0x2c, 0xa4, 0x8a, 0x53, 0x27, 0x58, 0x1e, 0x66, 0x81, 0x1a, 0x21, 0x65, 0x10, 0xb3,
+	0x9d, 0xfc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
+	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x24, 0x3d, 0xb3,
+	0x24, 0xa3, 0x34, 0x09, 0x64, 0x8e, 0x3e, 0x3c, 0x4a, 0xe1, 0x8c, 0xc4, 0x82, 0x4c, 0x7d, 0x2c,
+	0x11, 0x9d, 0xc4, 0x06, 0x8e, 0x63, 0x63, 0xc0, 0x00, 0xe8, 0x1f, 0x89, 0x3c, 0x3e, 0x02, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PrivValidatorServiceClient is the client API for PrivValidatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PrivValidatorServiceClient interface {
+	// GetPubKey returns the consensus public key of the validator.
+	GetPubKey(ctx context.Context, in *PubKeyRequest, opts ...grpc.CallOption) (*PubKeyResponse, error)
+	// SignVote signs a vote, unless it would be a double sign.
+	SignVote(ctx context.Context, in *SignVoteRequest, opts ...grpc.CallOption) (*SignedVoteResponse, error)
+	// SignProposal signs a proposal, unless it would be a double sign.
+	SignProposal(ctx context.Context, in *SignProposalRequest, opts ...grpc.CallOption) (*SignedProposalResponse, error)
+	// SignBytes signs arbitrary bytes.
+	SignBytes(ctx context.Context, in *SignBytesRequest, opts ...grpc.CallOption) (*SignBytesResponse, error)
+}
+
+type privValidatorServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewPrivValidatorServiceClient(cc grpc1.ClientConn) PrivValidatorServiceClient {
+	return &privValidatorServiceClient{cc}
+}
+
+func (c *privValidatorServiceClient) GetPubKey(ctx context.Context, in *PubKeyRequest, opts ...grpc.CallOption) (*PubKeyResponse, error) {
+	out := new(PubKeyResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.privval.v2.PrivValidatorService/GetPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privValidatorServiceClient) SignVote(ctx context.Context, in *SignVoteRequest, opts ...grpc.CallOption) (*SignedVoteResponse, error) {
+	out := new(SignedVoteResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.privval.v2.PrivValidatorService/SignVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privValidatorServiceClient) SignProposal(ctx context.Context, in *SignProposalRequest, opts ...grpc.CallOption) (*SignedProposalResponse, error) {
+	out := new(SignedProposalResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.privval.v2.PrivValidatorService/SignProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privValidatorServiceClient) SignBytes(ctx context.Context, in *SignBytesRequest, opts ...grpc.CallOption) (*SignBytesResponse, error) {
+	out := new(SignBytesResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.privval.v2.PrivValidatorService/SignBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PrivValidatorServiceServer is the server API for PrivValidatorService service.
+type PrivValidatorServiceServer interface {
+	// GetPubKey returns the consensus public key of the validator.
+	GetPubKey(context.Context, *PubKeyRequest) (*PubKeyResponse, error)
+	// SignVote signs a vote, unless it would be a double sign.
+	SignVote(context.Context, *SignVoteRequest) (*SignedVoteResponse, error)
+	// SignProposal signs a proposal, unless it would be a double sign.
+	SignProposal(context.Context, *SignProposalRequest) (*SignedProposalResponse, error)
+	// SignBytes signs arbitrary bytes.
+	SignBytes(context.Context, *SignBytesRequest) (*SignBytesResponse, error)
+}
+
+// UnimplementedPrivValidatorServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPrivValidatorServiceServer struct {
+}
+
+func (*UnimplementedPrivValidatorServiceServer) GetPubKey(ctx context.Context, req *PubKeyRequest) (*PubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPubKey not implemented")
+}
+func (*UnimplementedPrivValidatorServiceServer) SignVote(ctx context.Context, req *SignVoteRequest) (*SignedVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignVote not implemented")
+}
+func (*UnimplementedPrivValidatorServiceServer) SignProposal(ctx context.Context, req *SignProposalRequest) (*SignedProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignProposal not implemented")
+}
+func (*UnimplementedPrivValidatorServiceServer) SignBytes(ctx context.Context, req *SignBytesRequest) (*SignBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignBytes not implemented")
+}
+
+func RegisterPrivValidatorServiceServer(s grpc1.Server, srv PrivValidatorServiceServer) {
+	s.RegisterService(&_PrivValidatorService_serviceDesc, srv)
+}
+
+func _PrivValidatorService_GetPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorServiceServer).GetPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.privval.v2.PrivValidatorService/GetPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorServiceServer).GetPubKey(ctx, req.(*PubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivValidatorService_SignVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorServiceServer).SignVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.privval.v2.PrivValidatorService/SignVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorServiceServer).SignVote(ctx, req.(*SignVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivValidatorService_SignProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorServiceServer).SignProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.privval.v2.PrivValidatorService/SignProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorServiceServer).SignProposal(ctx, req.(*SignProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivValidatorService_SignBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivValidatorServiceServer).SignBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.privval.v2.PrivValidatorService/SignBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivValidatorServiceServer).SignBytes(ctx, req.(*SignBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var PrivValidatorService_serviceDesc = _PrivValidatorService_serviceDesc
+var _PrivValidatorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cometbft.privval.v2.PrivValidatorService",
+	HandlerType: (*PrivValidatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPubKey",
+			Handler:    _PrivValidatorService_GetPubKey_Handler,
+		},
+		{
+			MethodName: "SignVote",
+			Handler:    _PrivValidatorService_SignVote_Handler,
+		},
+		{
+			MethodName: "SignProposal",
+			Handler:    _PrivValidatorService_SignProposal_Handler,
+		},
+		{
+			MethodName: "SignBytes",
+			Handler:    _PrivValidatorService_SignBytes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cometbft/privval/v2/service.proto",
+}
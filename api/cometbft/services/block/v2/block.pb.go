@@ -206,11 +206,206 @@ func (m *GetLatestHeightResponse) GetHeight() int64 {
 	return 0
 }
 
+// GetLightBlockRequest is a request for the light block at the specified height.
+type GetLightBlockRequest struct {
+	// The height of the light block requested. 0 means the latest height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetLightBlockRequest) Reset()         { *m = GetLightBlockRequest{} }
+func (m *GetLightBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLightBlockRequest) ProtoMessage()    {}
+func (*GetLightBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4818f43c6b99905f, []int{4}
+}
+func (m *GetLightBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLightBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLightBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLightBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLightBlockRequest.Merge(m, src)
+}
+func (m *GetLightBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLightBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLightBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLightBlockRequest proto.InternalMessageInfo
+
+func (m *GetLightBlockRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GetLightBlockResponse contains the light block at the specified height.
+type GetLightBlockResponse struct {
+	LightBlock *v2.LightBlock `protobuf:"bytes,1,opt,name=light_block,json=lightBlock,proto3" json:"light_block,omitempty"`
+}
+
+func (m *GetLightBlockResponse) Reset()         { *m = GetLightBlockResponse{} }
+func (m *GetLightBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLightBlockResponse) ProtoMessage()    {}
+func (*GetLightBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4818f43c6b99905f, []int{5}
+}
+func (m *GetLightBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLightBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLightBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLightBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLightBlockResponse.Merge(m, src)
+}
+func (m *GetLightBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLightBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLightBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLightBlockResponse proto.InternalMessageInfo
+
+func (m *GetLightBlockResponse) GetLightBlock() *v2.LightBlock {
+	if m != nil {
+		return m.LightBlock
+	}
+	return nil
+}
+
+// GetLightBlocksRequest is a request for the light blocks of consecutive heights.
+type GetLightBlocksRequest struct {
+	// The height of the first light block requested.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// The height of the last light block requested, inclusive.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *GetLightBlocksRequest) Reset()         { *m = GetLightBlocksRequest{} }
+func (m *GetLightBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLightBlocksRequest) ProtoMessage()    {}
+func (*GetLightBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4818f43c6b99905f, []int{6}
+}
+func (m *GetLightBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLightBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLightBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLightBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLightBlocksRequest.Merge(m, src)
+}
+func (m *GetLightBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLightBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLightBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLightBlocksRequest proto.InternalMessageInfo
+
+func (m *GetLightBlocksRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *GetLightBlocksRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// GetLightBlocksResponse contains one of the light blocks requested.
+type GetLightBlocksResponse struct {
+	LightBlock *v2.LightBlock `protobuf:"bytes,1,opt,name=light_block,json=lightBlock,proto3" json:"light_block,omitempty"`
+}
+
+func (m *GetLightBlocksResponse) Reset()         { *m = GetLightBlocksResponse{} }
+func (m *GetLightBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLightBlocksResponse) ProtoMessage()    {}
+func (*GetLightBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4818f43c6b99905f, []int{7}
+}
+func (m *GetLightBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLightBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLightBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLightBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLightBlocksResponse.Merge(m, src)
+}
+func (m *GetLightBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLightBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLightBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLightBlocksResponse proto.InternalMessageInfo
+
+func (m *GetLightBlocksResponse) GetLightBlock() *v2.LightBlock {
+	if m != nil {
+		return m.LightBlock
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetByHeightRequest)(nil), "cometbft.services.block.v2.GetByHeightRequest")
 	proto.RegisterType((*GetByHeightResponse)(nil), "cometbft.services.block.v2.GetByHeightResponse")
 	proto.RegisterType((*GetLatestHeightRequest)(nil), "cometbft.services.block.v2.GetLatestHeightRequest")
 	proto.RegisterType((*GetLatestHeightResponse)(nil), "cometbft.services.block.v2.GetLatestHeightResponse")
+	proto.RegisterType((*GetLightBlockRequest)(nil), "cometbft.services.block.v2.GetLightBlockRequest")
+	proto.RegisterType((*GetLightBlockResponse)(nil), "cometbft.services.block.v2.GetLightBlockResponse")
+	proto.RegisterType((*GetLightBlocksRequest)(nil), "cometbft.services.block.v2.GetLightBlocksRequest")
+	proto.RegisterType((*GetLightBlocksResponse)(nil), "cometbft.services.block.v2.GetLightBlocksResponse")
 }
 
 func init() {
@@ -218,24 +413,30 @@ func init() {
 }
 
 var fileDescriptor_4818f43c6b99905f = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xce, 0xcf, 0x4d,
-	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x4f, 0xca,
-	0xc9, 0x4f, 0xce, 0xd6, 0x2f, 0x33, 0x82, 0x30, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4,
-	0x60, 0xea, 0xf4, 0x60, 0xea, 0xf4, 0x20, 0xd2, 0x65, 0x46, 0x52, 0xb2, 0x70, 0x33, 0x4a, 0x2a,
-	0x0b, 0x52, 0x8b, 0x41, 0x5a, 0xc1, 0x0c, 0x88, 0x56, 0x6c, 0xd2, 0x48, 0x26, 0x2b, 0xe9, 0x70,
-	0x09, 0xb9, 0xa7, 0x96, 0x38, 0x55, 0x7a, 0xa4, 0x66, 0xa6, 0x67, 0x94, 0x04, 0xa5, 0x16, 0x96,
-	0xa6, 0x16, 0x97, 0x08, 0x89, 0x71, 0xb1, 0x65, 0x80, 0x05, 0x24, 0x18, 0x15, 0x18, 0x35, 0x98,
-	0x83, 0xa0, 0x3c, 0xa5, 0x1a, 0x2e, 0x61, 0x14, 0xd5, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42,
-	0xa6, 0x5c, 0x1c, 0x60, 0x33, 0xe3, 0x33, 0x53, 0xc0, 0x1a, 0xb8, 0x8d, 0xa4, 0xf4, 0xe0, 0x2e,
-	0x86, 0x38, 0xa6, 0xcc, 0x48, 0xcf, 0x09, 0xa4, 0xc4, 0xd3, 0x25, 0x88, 0x1d, 0xac, 0xd6, 0x33,
-	0x45, 0x48, 0x8f, 0x8b, 0x15, 0xcc, 0x94, 0x60, 0x02, 0xeb, 0x91, 0xc0, 0xa5, 0x27, 0x08, 0xa2,
-	0x4c, 0x49, 0x82, 0x4b, 0xcc, 0x3d, 0xb5, 0xc4, 0x27, 0xb1, 0x24, 0xb5, 0xb8, 0x04, 0xc5, 0xbd,
-	0x4a, 0x86, 0x5c, 0xe2, 0x18, 0x32, 0x50, 0xb7, 0xe1, 0xf0, 0x8a, 0x53, 0xe8, 0x89, 0x47, 0x72,
-	0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7,
-	0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0x81, 0x5c,
-	0xa3, 0x0f, 0x0f, 0x3c, 0x38, 0x23, 0xb1, 0x20, 0x53, 0x1f, 0x77, 0xac, 0x25, 0xb1, 0x81, 0x83,
-	0xd5, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x88, 0x3a, 0x44, 0x9f, 0xda, 0x01, 0x00, 0x00,
+	// 353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x4f, 0x4b, 0x32, 0x41,
+	0x1c, 0x76, 0x7d, 0x79, 0xcd, 0x7e, 0xde, 0xb6, 0xb2, 0xc5, 0x70, 0x8a, 0x39, 0x44, 0x87, 0x98,
+	0xa5, 0x8d, 0x4e, 0x41, 0x07, 0x09, 0x4c, 0xe8, 0xb4, 0x20, 0x45, 0x17, 0x71, 0x75, 0xd4, 0x25,
+	0x6d, 0x36, 0xe7, 0xe7, 0x82, 0xd0, 0x87, 0xe8, 0x63, 0x75, 0xf4, 0xd8, 0x31, 0xf4, 0x8b, 0xc4,
+	0xcc, 0xb8, 0x8b, 0x9a, 0x4b, 0x97, 0x6e, 0xcf, 0xce, 0xf3, 0x67, 0x9e, 0x67, 0x19, 0x38, 0xed,
+	0x88, 0x11, 0xc7, 0xa0, 0x87, 0xae, 0xe4, 0xe3, 0x38, 0xec, 0x70, 0xe9, 0x06, 0x43, 0xd1, 0x79,
+	0x76, 0x63, 0xcf, 0x00, 0x16, 0x8d, 0x05, 0x0a, 0xbb, 0x92, 0xe8, 0x58, 0xa2, 0x63, 0x86, 0x8e,
+	0xbd, 0x4a, 0x35, 0xcd, 0xc0, 0x69, 0xc4, 0xa5, 0xb2, 0x6a, 0x60, 0xac, 0xdb, 0xe8, 0x95, 0x64,
+	0x7a, 0x0e, 0x76, 0x9d, 0x63, 0x6d, 0x7a, 0xc7, 0xc3, 0xfe, 0x00, 0x7d, 0xfe, 0x3a, 0xe1, 0x12,
+	0xed, 0x32, 0x14, 0x06, 0xfa, 0xc0, 0xb1, 0x4e, 0xac, 0xb3, 0x7f, 0xfe, 0xf2, 0x8b, 0xbe, 0xc1,
+	0xde, 0x9a, 0x5a, 0x46, 0xe2, 0x45, 0x72, 0xfb, 0x0a, 0x8a, 0x3a, 0xb3, 0x15, 0x76, 0xb5, 0xa1,
+	0xe4, 0x55, 0x58, 0xda, 0xd8, 0x94, 0x89, 0x3d, 0x56, 0x53, 0x92, 0xc6, 0xad, 0xbf, 0xa3, 0xb5,
+	0x8d, 0xae, 0xcd, 0xe0, 0xbf, 0x86, 0x4e, 0x5e, 0x7b, 0x9c, 0x2c, 0x8f, 0x6f, 0x64, 0xd4, 0x81,
+	0x72, 0x9d, 0xe3, 0x7d, 0x1b, 0xb9, 0xc4, 0xb5, 0xbe, 0xf4, 0x02, 0x0e, 0x7f, 0x30, 0xcb, 0x6e,
+	0x59, 0x53, 0x18, 0xec, 0x2b, 0x8b, 0xc2, 0xe6, 0x92, 0x5f, 0xa6, 0x3f, 0xc0, 0xc1, 0x86, 0x7e,
+	0x79, 0xc1, 0x0d, 0x94, 0x86, 0xea, 0xb4, 0x65, 0xb6, 0x98, 0xfd, 0xd5, 0x2d, 0x5b, 0x56, 0xbc,
+	0x30, 0x4c, 0x31, 0x6d, 0x6e, 0x04, 0xcb, 0xa4, 0xc9, 0x31, 0x94, 0x7a, 0x63, 0x31, 0x6a, 0xad,
+	0xd5, 0x01, 0x75, 0x64, 0x26, 0xda, 0x47, 0xb0, 0x8b, 0x22, 0xa1, 0xf3, 0x9a, 0x2e, 0xa2, 0x30,
+	0x24, 0x7d, 0x34, 0x3f, 0x6b, 0x35, 0xf6, 0x6f, 0x0a, 0xd7, 0x9a, 0x1f, 0x73, 0x62, 0xcd, 0xe6,
+	0xc4, 0xfa, 0x9a, 0x13, 0xeb, 0x7d, 0x41, 0x72, 0xb3, 0x05, 0xc9, 0x7d, 0x2e, 0x48, 0xee, 0xe9,
+	0xba, 0x1f, 0xe2, 0x60, 0x12, 0xa8, 0x28, 0x37, 0x7d, 0x76, 0x29, 0x68, 0x47, 0xa1, 0x9b, 0xfd,
+	0xde, 0x83, 0x82, 0x7e, 0x90, 0x97, 0xdf, 0x03, 0x00, 0xb9, 0xca, 0xc7, 0xe8, 0x14, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GetByHeightRequest) Marshal() (dAtA []byte, err error) {
@@ -364,6 +565,137 @@ func (m *GetLatestHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *GetLightBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLightBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLightBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLightBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLightBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLightBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LightBlock != nil {
+		{
+			size, err := m.LightBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLightBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLightBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLightBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLightBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLightBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLightBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LightBlock != nil {
+		{
+			size, err := m.LightBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlock(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlock(v)
 	base := offset
@@ -384,54 +716,348 @@ func (m *GetByHeightRequest) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovBlock(uint64(m.Height))
 	}
-	return n
-}
+	return n
+}
+
+func (m *GetByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLatestHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetLatestHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlock(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetLightBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlock(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetLightBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LightBlock != nil {
+		l = m.LightBlock.Size()
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLightBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovBlock(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovBlock(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *GetLightBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LightBlock != nil {
+		l = m.LightBlock.Size()
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	return n
+}
+
+func sovBlock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlock(x uint64) (n int) {
+	return sovBlock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetByHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetByHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockId == nil {
+				m.BlockId = &v2.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &v2.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *GetByHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockId != nil {
-		l = m.BlockId.Size()
-		n += 1 + l + sovBlock(uint64(l))
-	}
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovBlock(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *GetLatestHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetLatestHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLatestHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLatestHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *GetLatestHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovBlock(uint64(m.Height))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBlock(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBlock(x uint64) (n int) {
-	return sovBlock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GetByHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *GetLatestHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -454,10 +1080,10 @@ func (m *GetByHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetByHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLatestHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLatestHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -500,7 +1126,7 @@ func (m *GetByHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetByHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *GetLightBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -523,17 +1149,17 @@ func (m *GetByHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetByHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLightBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLightBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlock
@@ -543,31 +1169,64 @@ func (m *GetByHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBlock
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBlock
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockId == nil {
-				m.BlockId = &v2.BlockID{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLightBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
 			}
-			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLightBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLightBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LightBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -594,10 +1253,10 @@ func (m *GetByHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &v2.Block{}
+			if m.LightBlock == nil {
+				m.LightBlock = &v2.LightBlock{}
 			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LightBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -622,7 +1281,7 @@ func (m *GetByHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLatestHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *GetLightBlocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -645,12 +1304,50 @@ func (m *GetLatestHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLatestHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLightBlocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLatestHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLightBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlock(dAtA[iNdEx:])
@@ -672,7 +1369,7 @@ func (m *GetLatestHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLatestHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *GetLightBlocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -695,17 +1392,17 @@ func (m *GetLatestHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLatestHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLightBlocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLatestHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLightBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightBlock", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBlock
@@ -715,11 +1412,28 @@ func (m *GetLatestHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LightBlock == nil {
+				m.LightBlock = &v2.LightBlock{}
+			}
+			if err := m.LightBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlock(dAtA[iNdEx:])
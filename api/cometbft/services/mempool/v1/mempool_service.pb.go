@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/mempool/v1/mempool_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("cometbft/services/mempool/v1/mempool_service.proto", fileDescriptor_f8560b1ab7181466)
+}
+
+var fileDescriptor_f8560b1ab7181466 = []byte{
+	// 279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4a, 0xce, 0xcf, 0x4d,
+	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0xcf, 0x4d,
+	0xcd, 0x2d, 0xc8, 0xcf, 0xcf, 0xd1, 0x2f, 0x33, 0x84, 0x31, 0xe3, 0xa1, 0x72, 0x7a, 0x05, 0x45,
+	0xf9, 0x25, 0xf9, 0x42, 0x32, 0x30, 0x3d, 0x7a, 0x30, 0x3d, 0x7a, 0x50, 0x85, 0x7a, 0x65, 0x86,
+	0x52, 0x5a, 0xc4, 0x98, 0x08, 0x31, 0xc9, 0xe8, 0x13, 0x33, 0x17, 0x9f, 0x2f, 0x44, 0x24, 0x18,
+	0xa2, 0x58, 0xa8, 0x88, 0x8b, 0xdb, 0xa9, 0x28, 0x3f, 0x31, 0x25, 0x39, 0xb1, 0xb8, 0x24, 0xa4,
+	0x42, 0xc8, 0x40, 0x0f, 0x9f, 0x65, 0x7a, 0x48, 0x4a, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b,
+	0xa4, 0x0c, 0x49, 0xd0, 0x51, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x2a, 0x94, 0xc6, 0xc5, 0xee, 0x9c,
+	0x91, 0x9a, 0x9c, 0x1d, 0x52, 0x21, 0xa4, 0x83, 0x5f, 0x37, 0x54, 0x19, 0xcc, 0x2e, 0x5d, 0x22,
+	0x55, 0x43, 0xed, 0xa9, 0xe6, 0xe2, 0x0b, 0xcd, 0x4b, 0xce, 0xcf, 0x4b, 0xcb, 0x2c, 0xca, 0x4d,
+	0x4d, 0x09, 0xa9, 0x28, 0x16, 0x32, 0xc6, 0x6f, 0x00, 0xaa, 0x6a, 0x98, 0xad, 0x26, 0xa4, 0x69,
+	0x82, 0x5a, 0xde, 0xc6, 0xc8, 0x25, 0x18, 0x5c, 0x9a, 0x54, 0x9c, 0x5c, 0x94, 0x99, 0x94, 0x0a,
+	0x76, 0x54, 0x69, 0x4e, 0x89, 0x90, 0x19, 0x7e, 0xb3, 0x30, 0x34, 0xc0, 0xdc, 0x60, 0x4e, 0xb2,
+	0x3e, 0x88, 0x33, 0x0c, 0x18, 0x9d, 0xc2, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
+	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
+	0x21, 0xca, 0x36, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x09, 0x64, 0xb4, 0x3e, 0x3c, 0x15, 0xc1, 0x19,
+	0x89, 0x05, 0x99, 0xfa, 0xf8, 0xd2, 0x56, 0x12, 0x1b, 0x38, 0x51, 0x19, 0x03, 0x06, 0x00, 0x89,
+	0xdf, 0xfb, 0xc5, 0xd4, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MempoolServiceClient is the client API for MempoolService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MempoolServiceClient interface {
+	// BroadcastTx adds a transaction to the mempool and gossips it to peers.
+	// Depending on the requested mode, it returns once the transaction has
+	// been checked by the application, or once it has been committed.
+	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
+	// CheckTx checks a transaction with the application, without adding it to
+	// the mempool.
+	CheckTx(ctx context.Context, in *CheckTxRequest, opts ...grpc.CallOption) (*CheckTxResponse, error)
+	// UnconfirmedTxs returns the transactions in the mempool.
+	UnconfirmedTxs(ctx context.Context, in *UnconfirmedTxsRequest, opts ...grpc.CallOption) (*UnconfirmedTxsResponse, error)
+	// SubscribeTxResult returns a stream of the results of the transactions
+	// committed from now on. The stream is terminated by the server if an error
+	// occurs, or once the result of the requested transaction is sent.
+	SubscribeTxResult(ctx context.Context, in *SubscribeTxResultRequest, opts ...grpc.CallOption) (MempoolService_SubscribeTxResultClient, error)
+}
+
+type mempoolServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMempoolServiceClient(cc grpc1.ClientConn) MempoolServiceClient {
+	return &mempoolServiceClient{cc}
+}
+
+func (c *mempoolServiceClient) BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error) {
+	out := new(BroadcastTxResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.mempool.v1.MempoolService/BroadcastTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) CheckTx(ctx context.Context, in *CheckTxRequest, opts ...grpc.CallOption) (*CheckTxResponse, error) {
+	out := new(CheckTxResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.mempool.v1.MempoolService/CheckTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) UnconfirmedTxs(ctx context.Context, in *UnconfirmedTxsRequest, opts ...grpc.CallOption) (*UnconfirmedTxsResponse, error) {
+	out := new(UnconfirmedTxsResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.mempool.v1.MempoolService/UnconfirmedTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) SubscribeTxResult(ctx context.Context, in *SubscribeTxResultRequest, opts ...grpc.CallOption) (MempoolService_SubscribeTxResultClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MempoolService_serviceDesc.Streams[0], "/cometbft.services.mempool.v1.MempoolService/SubscribeTxResult", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mempoolServiceSubscribeTxResultClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MempoolService_SubscribeTxResultClient interface {
+	Recv() (*SubscribeTxResultResponse, error)
+	grpc.ClientStream
+}
+
+type mempoolServiceSubscribeTxResultClient struct {
+	grpc.ClientStream
+}
+
+func (x *mempoolServiceSubscribeTxResultClient) Recv() (*SubscribeTxResultResponse, error) {
+	m := new(SubscribeTxResultResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MempoolServiceServer is the server API for MempoolService service.
+type MempoolServiceServer interface {
+	// BroadcastTx adds a transaction to the mempool and gossips it to peers.
+	// Depending on the requested mode, it returns once the transaction has
+	// been checked by the application, or once it has been committed.
+	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
+	// CheckTx checks a transaction with the application, without adding it to
+	// the mempool.
+	CheckTx(context.Context, *CheckTxRequest) (*CheckTxResponse, error)
+	// UnconfirmedTxs returns the transactions in the mempool.
+	UnconfirmedTxs(context.Context, *UnconfirmedTxsRequest) (*UnconfirmedTxsResponse, error)
+	// SubscribeTxResult returns a stream of the results of the transactions
+	// committed from now on. The stream is terminated by the server if an error
+	// occurs, or once the result of the requested transaction is sent.
+	SubscribeTxResult(*SubscribeTxResultRequest, MempoolService_SubscribeTxResultServer) error
+}
+
+// UnimplementedMempoolServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMempoolServiceServer struct {
+}
+
+func (*UnimplementedMempoolServiceServer) BroadcastTx(ctx context.Context, req *BroadcastTxRequest) (*BroadcastTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastTx not implemented")
+}
+func (*UnimplementedMempoolServiceServer) CheckTx(ctx context.Context, req *CheckTxRequest) (*CheckTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTx not implemented")
+}
+func (*UnimplementedMempoolServiceServer) UnconfirmedTxs(ctx context.Context, req *UnconfirmedTxsRequest) (*UnconfirmedTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnconfirmedTxs not implemented")
+}
+func (*UnimplementedMempoolServiceServer) SubscribeTxResult(req *SubscribeTxResultRequest, srv MempoolService_SubscribeTxResultServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTxResult not implemented")
+}
+
+func RegisterMempoolServiceServer(s grpc1.Server, srv MempoolServiceServer) {
+	s.RegisterService(&_MempoolService_serviceDesc, srv)
+}
+
+func _MempoolService_BroadcastTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).BroadcastTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.mempool.v1.MempoolService/BroadcastTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).BroadcastTx(ctx, req.(*BroadcastTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_CheckTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).CheckTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.mempool.v1.MempoolService/CheckTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).CheckTx(ctx, req.(*CheckTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_UnconfirmedTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnconfirmedTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).UnconfirmedTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.mempool.v1.MempoolService/UnconfirmedTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).UnconfirmedTxs(ctx, req.(*UnconfirmedTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_SubscribeTxResult_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTxResultRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MempoolServiceServer).SubscribeTxResult(m, &mempoolServiceSubscribeTxResultServer{stream})
+}
+
+type MempoolService_SubscribeTxResultServer interface {
+	Send(*SubscribeTxResultResponse) error
+	grpc.ServerStream
+}
+
+type mempoolServiceSubscribeTxResultServer struct {
+	grpc.ServerStream
+}
+
+func (x *mempoolServiceSubscribeTxResultServer) Send(m *SubscribeTxResultResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var MempoolService_serviceDesc = _MempoolService_serviceDesc
+var _MempoolService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cometbft.services.mempool.v1.MempoolService",
+	HandlerType: (*MempoolServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BroadcastTx",
+			Handler:    _MempoolService_BroadcastTx_Handler,
+		},
+		{
+			MethodName: "CheckTx",
+			Handler:    _MempoolService_CheckTx_Handler,
+		},
+		{
+			MethodName: "UnconfirmedTxs",
+			Handler:    _MempoolService_UnconfirmedTxs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTxResult",
+			Handler:       _MempoolService_SubscribeTxResult_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cometbft/services/mempool/v1/mempool_service.proto",
+}
@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	cfg "github.com/cometbft/cometbft/v2/config"
+	"github.com/cometbft/cometbft/v2/proxy"
+)
+
+var abciReplayConnections []string
+
+func init() {
+	abciReplayCmd.Flags().String(
+		"proxy_app",
+		config.ProxyApp,
+		"address of the application to replay the recording against, or one of: 'kvstore',"+
+			" 'persistent_kvstore' or 'e2e' for a built-in application")
+	abciReplayCmd.Flags().String("abci", config.ABCI, "specify abci transport (socket | grpc)")
+	abciReplayCmd.Flags().StringSliceVar(&abciReplayConnections, "connections", proxy.DefaultReplayedConnections,
+		"the connections whose calls are replayed (consensus, mempool, query, snapshot)")
+	ABCICmd.AddCommand(abciReplayCmd)
+}
+
+// ABCICmd groups the commands operating on the traffic between the node and
+// the ABCI application.
+var ABCICmd = &cobra.Command{
+	Use:   "abci",
+	Short: "Inspect the traffic between the node and the ABCI application",
+}
+
+var abciReplayCmd = &cobra.Command{
+	Use:   "replay [recording]",
+	Short: "Replay a recording of the ABCI calls of a node against a fresh application",
+	Long: `
+replay sends the calls recorded to abci_record_file, in the order they were
+made, to a fresh instance of the application, and compares its responses with
+the recorded ones. It reports the first call to which the application responds
+differently, which helps tracking down non-determinism in the application.
+
+The application must be in the state the recorded application was in when the
+recording started: typically, a new application for a recording started at
+genesis. Only the calls of the consensus and snapshot connections, which change
+the state of the application, are replayed by default.
+
+The recording defaults to the abci_record_file of the node.
+	`,
+	Example: `
+	cometbft abci replay --proxy_app tcp://127.0.0.1:26658
+	cometbft abci replay --proxy_app kvstore /path/to/abci.rec
+	cometbft abci replay --connections consensus,mempool,query,snapshot
+	`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		file := config.ABCIRecordFile()
+		if len(args) > 0 {
+			file = args[0]
+		} else if config.ABCIRecord == "" {
+			return errors.New("no recording given, and abci_record_file is not set")
+		}
+		return replayABCIRecording(config, file, abciReplayConnections)
+	},
+}
+
+func replayABCIRecording(config *cfg.Config, file string, connections []string) error {
+	rec, err := proxy.OpenRecording(file)
+	if err != nil {
+		return fmt.Errorf("failed to open the recording: %w", err)
+	}
+	defer rec.Close()
+
+	// Built-in applications start from a clean state.
+	dbDir, err := os.MkdirTemp("", "cometbft-abci-replay")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dbDir)
+
+	client, err := proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, dbDir).NewABCIConsensusClient()
+	if err != nil {
+		return fmt.Errorf("failed to create the ABCI client: %w", err)
+	}
+	client.SetLogger(logger.With("module", "abci-client"))
+	if err := client.Start(); err != nil {
+		return fmt.Errorf("failed to connect to the application: %w", err)
+	}
+	defer func() { _ = client.Stop() }()
+
+	replayed, div, err := proxy.Replay(context.Background(), rec, client, connections)
+	if err != nil {
+		return fmt.Errorf("failed to replay the recording after %d calls: %w", replayed, err)
+	}
+	if div != nil {
+		fmt.Println(div)
+		return fmt.Errorf("the application diverged after %d calls", replayed)
+	}
+	fmt.Printf("Replayed %d calls: the application responded as recorded\n", replayed)
+	return nil
+}
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cfg "github.com/cometbft/cometbft/v2/config"
+	nm "github.com/cometbft/cometbft/v2/node"
+	"github.com/cometbft/cometbft/v2/proxy"
+)
+
+// SyncFromArchiveCmd syncs the node from a block archive.
+var SyncFromArchiveCmd = &cobra.Command{
+	Use:     "sync-from-archive [archive]",
+	Aliases: []string{"sync_from_archive"},
+	Short:   "Sync the node from a local block archive instead of from peers",
+	Long: `
+sync-from-archive applies the blocks of a block archive, a file written by
+blockstore export, following the latest block of the node. Each block is
+verified with the commit of the next block before being applied, exactly as
+when syncing from peers, so the last block of the archive is not applied.
+
+The application must be reachable at the proxy_app address, and the node must
+be stopped. Once done, the node can be started to sync the remaining blocks
+from peers, if any.
+	`,
+	Example: `
+	cometbft blockstore export blocks.export
+	cometbft sync-from-archive blocks.export
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		height, err := nm.SyncFromBlockArchive(cmd.Context(),
+			config,
+			proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+			nm.DefaultGenesisDocProviderFunc(config),
+			cfg.DefaultDBProvider,
+			args[0],
+			logger,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to sync from the block archive (height %d): %w", height, err)
+		}
+		fmt.Printf("Synced from the block archive to height %d\n", height)
+		return nil
+	},
+}
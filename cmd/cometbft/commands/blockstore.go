@@ -0,0 +1,174 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cfg "github.com/cometbft/cometbft/v2/config"
+	"github.com/cometbft/cometbft/v2/state"
+	"github.com/cometbft/cometbft/v2/store"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+var (
+	blockStoreFromHeight int64
+	blockStoreToHeight   int64
+	blockStoreTrustHash  []byte
+)
+
+func init() {
+	blockStoreExportCmd.Flags().Int64Var(&blockStoreFromHeight, "from", 0, "the lowest height to export (defaults to the base of the block store)")
+	blockStoreExportCmd.Flags().Int64Var(&blockStoreToHeight, "to", 0, "the highest height to export (defaults to the height of the block store)")
+	blockStoreImportCmd.Flags().BytesHexVar(&blockStoreTrustHash, "trust-hash", nil,
+		"the hash of the first block of the file, needed unless it follows the last block of the block store or is the initial block")
+	BlockStoreCmd.AddCommand(blockStoreExportCmd, blockStoreImportCmd)
+}
+
+// BlockStoreCmd groups the commands operating on the block store.
+var BlockStoreCmd = &cobra.Command{
+	Use:   "blockstore",
+	Short: "Export and import the content of the block store",
+	Long: `
+The blockstore commands move a range of heights between nodes, independently
+of their db_backend and experimental_db_key_layout: export writes the blocks,
+their commits and extended commits, and the validators, consensus params and
+ABCI results kept by the state store, to a portable file, and import saves
+them to the stores of a node. When the range ends at the latest height of the
+node, the state of the node is exported too, and import saves it unless the
+node already has a newer state.
+
+This allows migrating a node to another database backend or key layout: export
+all its heights, move its data directory away, change its configuration, then
+import them. The node must be stopped. The application keeps its own state and
+is not migrated.
+
+A file written by export can also be used by sync-from-archive, to sync a node
+from the heights it holds, applying their blocks instead of importing them.
+	`,
+}
+
+var blockStoreExportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Export a range of heights of the block store to a file",
+	Example: `
+	cometbft blockstore export blocks.export
+	cometbft blockstore export --from 100 --to 200 blocks.export
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		if blockStoreToHeight > 0 && blockStoreToHeight < blockStoreFromHeight {
+			return errors.New("--to must be greater than or equal to --from")
+		}
+		if err := exportBlockStore(config, args[0], blockStoreFromHeight, blockStoreToHeight); err != nil {
+			return fmt.Errorf("failed to export the block store: %w", err)
+		}
+		return nil
+	},
+}
+
+var blockStoreImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import the heights of a file written by export to the block store",
+	Long: `
+import saves the heights of a file written by export to the block store, and
+their validators, consensus params and ABCI results to the state store, of the
+node, as well as the exported state if any. The block store must be empty, or
+its height must precede the first height of the file.
+
+Every block is checked to be committed by its validators and to follow the
+previous one. As the file may come from an untrusted source, its first block
+must follow the last block of the block store, or be the initial block of the
+chain committed by the genesis validators, or else have the hash given by
+--trust-hash, which should be obtained from a trusted source.
+	`,
+	Example: `
+	cometbft blockstore import blocks.export
+	cometbft blockstore import --trust-hash 28B97BE9F6DE51AC69F70E0B7BFD7E5C9CD1A595B7DC31AFF27C50D4948020CD blocks.export
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		if err := importBlockStore(config, args[0], blockStoreTrustHash); err != nil {
+			return fmt.Errorf("failed to import the block store: %w", err)
+		}
+		return nil
+	},
+}
+
+func exportBlockStore(config *cfg.Config, filePath string, fromHeight, toHeight int64) error {
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return err
+	}
+	blockStore, stateStore, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+	if blockStore.Height() == 0 {
+		return errors.New("the block store is empty")
+	}
+	if fromHeight == 0 {
+		fromHeight = blockStore.Base()
+	}
+	if toHeight == 0 {
+		toHeight = blockStore.Height()
+	}
+
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := store.Export(file, blockStore, stateStore, genDoc.ChainID, fromHeight, toHeight); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	fmt.Printf("Exported heights %d to %d to %s\n", fromHeight, toHeight, filePath)
+	return nil
+}
+
+func importBlockStore(config *cfg.Config, filePath string, trustHash []byte) error {
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Unlike loadStateAndBlockStore, create the databases if needed.
+	dbType := dbm.BackendType(config.DBBackend)
+	blockStoreDB, err := dbm.NewDB("blockstore", dbType, config.DBDir())
+	if err != nil {
+		return err
+	}
+	blockStore := store.NewBlockStore(blockStoreDB, store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
+	defer blockStore.Close()
+	stateDB, err := dbm.NewDB("state", dbType, config.DBDir())
+	if err != nil {
+		return err
+	}
+	stateStore := state.NewStore(stateDB, state.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+		DBKeyLayout:          config.Storage.ExperimentalKeyLayout,
+	})
+	defer stateStore.Close()
+
+	header, err := store.Import(file, blockStore, stateStore, genDoc, trustHash)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Imported heights %d to %d from %s\n", header.FromHeight, header.ToHeight, filePath)
+	return nil
+}
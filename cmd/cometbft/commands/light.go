@@ -45,6 +45,9 @@ When /abci_query is called, the Merkle key path format is:
 
 Please verify with your application that this Merkle key format is used (true
 for applications built w/ Cosmos SDK).
+
+The evidence of the light client attacks detected is kept in the trusted
+store, and returned by /light_client_attacks.
 `,
 	RunE: runProxy,
 	Args: cobra.ExactArgs(1),
@@ -66,7 +69,10 @@ var (
 	trustedHash    []byte
 	trustLevelStr  string
 
-	verbose bool
+	prefetchConcurrency uint16
+
+	verbose    bool
+	prometheus bool
 
 	primaryKey   = []byte("primary")
 	witnessesKey = []byte("witnesses")
@@ -91,12 +97,17 @@ func init() {
 	LightCmd.Flags().Int64Var(&trustedHeight, "height", 1, "Trusted header's height")
 	LightCmd.Flags().BytesHexVar(&trustedHash, "hash", []byte{}, "Trusted header's hash")
 	LightCmd.Flags().BoolVar(&verbose, "verbose", false, "Verbose output")
+	LightCmd.Flags().BoolVar(&prometheus, "prometheus", false,
+		"Serve the Prometheus metrics, including the number of light client attacks detected, at /metrics")
 	LightCmd.Flags().StringVar(&trustLevelStr, "trust-level", "1/3",
 		"trust level. Must be between 1/3 and 3/3",
 	)
 	LightCmd.Flags().BoolVar(&sequential, "sequential", false,
 		"sequential verification. Verify all headers sequentially as opposed to using skipping verification",
 	)
+	LightCmd.Flags().Uint16Var(&prefetchConcurrency, "prefetch-concurrency", 0,
+		"maximum number of headers fetched concurrently and ahead of time during skipping verification (0 disables prefetching)",
+	)
 }
 
 func runProxy(_ *cobra.Command, args []string) error {
@@ -165,10 +176,14 @@ func runProxy(_ *cobra.Command, args []string) error {
 		}),
 	}
 
+	if prometheus {
+		options = append(options, light.WithMetrics(light.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", chainID)))
+	}
+
 	if sequential {
 		options = append(options, light.SequentialVerification())
 	} else {
-		options = append(options, light.SkippingVerification(trustLevel))
+		options = append(options, light.SkippingVerification(trustLevel), light.PrefetchConcurrency(prefetchConcurrency))
 	}
 
 	var c *light.Client
@@ -218,6 +233,7 @@ func runProxy(_ *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	p.ServeMetrics = prometheus
 
 	// Stop upon receiving SIGTERM or CTRL-C.
 	cmtos.TrapSignal(logger, func() {
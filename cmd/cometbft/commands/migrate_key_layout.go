@@ -0,0 +1,164 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cfg "github.com/cometbft/cometbft/v2/config"
+	"github.com/cometbft/cometbft/v2/internal/evidence"
+	"github.com/cometbft/cometbft/v2/internal/keylayout"
+	"github.com/cometbft/cometbft/v2/internal/progressbar"
+	"github.com/cometbft/cometbft/v2/state"
+	"github.com/cometbft/cometbft/v2/store"
+)
+
+var keyLayoutVersion string
+
+func init() {
+	MigrateKeyLayoutCmd.Flags().StringVar(&keyLayoutVersion, "to", "v2", "the key layout to migrate to (v1 or v2)")
+}
+
+// MigrateKeyLayoutCmd rewrites the databases of the node to another key
+// layout.
+var MigrateKeyLayoutCmd = &cobra.Command{
+	Use:     "migrate-key-layout",
+	Aliases: []string{"migrate_key_layout"},
+	Short:   "Migrate the databases of the node to another key layout",
+	Long: `
+migrate-key-layout rewrites, in place, the keys of the block store, state and
+evidence databases from their key layout to the one given by --to, then
+verifies the result. The key layout of a database is recorded in it, so the
+node uses the new layout regardless of experimental_db_key_layout.
+
+The node must be stopped. An interrupted migration is resumed by running the
+command again; the node must not be started before it completes.
+	`,
+	Example: `
+	cometbft migrate-key-layout
+	cometbft migrate-key-layout --to v1
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		if keyLayoutVersion != "v1" && keyLayoutVersion != "v2" {
+			return keylayout.ErrUnknownVersion
+		}
+		if err := migrateKeyLayout(config, keyLayoutVersion); err != nil {
+			return fmt.Errorf("failed to migrate the key layout: %w", err)
+		}
+		return nil
+	},
+}
+
+type keyLayoutDB struct {
+	name      string
+	converter func(fromVersion, toVersion string) (keylayout.ConvertFunc, error)
+}
+
+var keyLayoutDBs = []keyLayoutDB{
+	{name: "blockstore", converter: store.KeyLayoutConverter},
+	{name: "state", converter: state.KeyLayoutConverter},
+	{name: "evidence", converter: evidence.KeyLayoutConverter},
+}
+
+func migrateKeyLayout(config *cfg.Config, toVersion string) error {
+	dbType := dbm.BackendType(config.DBBackend)
+	for _, d := range keyLayoutDBs {
+		// The badgerdb backend does not add the .db extension.
+		if !dbm.FileExists(filepath.Join(config.DBDir(), d.name+".db")) &&
+			!dbm.FileExists(filepath.Join(config.DBDir(), d.name)) {
+			fmt.Printf("Skipping %s, the database does not exist\n", d.name)
+			continue
+		}
+		db, err := dbm.NewDB(d.name, dbType, config.DBDir())
+		if err != nil {
+			return err
+		}
+		err = migrateDBKeyLayout(db, d, toVersion)
+		if closeErr := db.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", d.name, err)
+		}
+	}
+
+	if err := verifyStores(config); err != nil {
+		return fmt.Errorf("verifying the migrated stores: %w", err)
+	}
+	fmt.Printf("The databases use the %s key layout\n", toVersion)
+	return nil
+}
+
+func migrateDBKeyLayout(db dbm.DB, d keyLayoutDB, toVersion string) error {
+	fromVersion, err := keylayout.Version(db)
+	if err != nil {
+		return err
+	}
+	if fromVersion == toVersion {
+		fmt.Printf("Skipping %s, it already uses the %s key layout\n", d.name, toVersion)
+		return nil
+	}
+	convert, err := d.converter(fromVersion, toVersion)
+	if err != nil {
+		return err
+	}
+
+	total, err := keylayout.Count(db, convert)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Migrating %d keys of %s from %s to %s:\n", total, d.name, fromVersion, toVersion)
+	var progress func(int64)
+	if total > 0 {
+		var bar progressbar.Bar
+		bar.NewOption(0, total)
+		defer bar.Finish()
+		progress = bar.Play
+	}
+	_, err = keylayout.Migrate(db, toVersion, convert, progress)
+	return err
+}
+
+// verifyStores checks that the stores load with their new key layout.
+func verifyStores(config *cfg.Config) error {
+	blockStore, stateStore, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	for height := blockStore.Base(); height > 0 && height <= blockStore.Height(); height++ {
+		meta := blockStore.LoadBlockMeta(height)
+		if meta == nil {
+			return fmt.Errorf("block meta at height %d not found", height)
+		}
+		if blockStore.LoadBlockMetaByHash(meta.BlockID.Hash) == nil {
+			return fmt.Errorf("block hash at height %d not found", height)
+		}
+		if blockStore.LoadBlockPart(height, 0) == nil {
+			return fmt.Errorf("block parts at height %d not found", height)
+		}
+		if blockStore.LoadBlockCommit(height) == nil && blockStore.LoadSeenCommit(height) == nil {
+			return fmt.Errorf("commit at height %d not found", height)
+		}
+	}
+
+	st, err := stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if st.IsEmpty() {
+		return nil
+	}
+	if _, err := stateStore.LoadValidators(st.LastBlockHeight + 1); err != nil {
+		return err
+	}
+	_, err = stateStore.LoadConsensusParams(st.LastBlockHeight + 1)
+	return err
+}
@@ -3,6 +3,7 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -15,6 +16,7 @@ import (
 	"github.com/cometbft/cometbft/v2/state/indexer"
 	blockidxkv "github.com/cometbft/cometbft/v2/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/v2/state/indexer/sink/psql"
+	"github.com/cometbft/cometbft/v2/state/indexer/sink/sqlite"
 	"github.com/cometbft/cometbft/v2/state/txindex"
 	"github.com/cometbft/cometbft/v2/state/txindex/kv"
 	"github.com/cometbft/cometbft/v2/types"
@@ -115,6 +117,12 @@ func loadEventSinks(cfg *cmtcfg.Config, chainID string) (indexer.BlockIndexer, t
 			return nil, nil, err
 		}
 		return es.BlockIndexer(), es.TxIndexer(), nil
+	case "sqlite":
+		es, err := sqlite.NewEventSink(filepath.Join(cfg.DBDir(), sqlite.FileName))
+		if err != nil {
+			return nil, nil, err
+		}
+		return es.BlockIndexer(), es.TxIndexer(), nil
 	case "kv":
 		store, err := dbm.NewDB("tx_index", dbm.BackendType(cfg.DBBackend), cfg.DBDir())
 		if err != nil {
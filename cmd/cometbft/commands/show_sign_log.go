@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	cmtbytes "github.com/cometbft/cometbft/v2/libs/bytes"
+	cmtjson "github.com/cometbft/cometbft/v2/libs/json"
+	"github.com/cometbft/cometbft/v2/libs/protoio"
+	"github.com/cometbft/cometbft/v2/privval"
+)
+
+var (
+	signLogFile       string
+	signLogFromHeight int64
+	signLogToHeight   int64
+)
+
+func init() {
+	ShowSignLogCmd.Flags().StringVar(&signLogFile, "file", "", "path to the sign log (defaults to priv_validator_sign_log_file)")
+	ShowSignLogCmd.Flags().Int64Var(&signLogFromHeight, "from-height", 0, "the lowest height of the entries to show")
+	ShowSignLogCmd.Flags().Int64Var(&signLogToHeight, "to-height", 0, "the highest height of the entries to show (0 for no limit)")
+}
+
+// ShowSignLogCmd shows the entries of the sign log of the validator.
+var ShowSignLogCmd = &cobra.Command{
+	Use:     "show-sign-log",
+	Aliases: []string{"show_sign_log"},
+	Short:   "Show what this node's validator signed, as recorded in its sign log",
+	Long: `
+show-sign-log prints the entries of the sign log of the validator, one JSON
+object per line, in the order in which they were recorded. The sign log is
+only written when priv_validator_sign_state_store is "log".
+
+Each entry contains the height, round and step of a signature, the signature
+and the signed bytes, as well as the hash of the signed block (empty for nil
+votes). The log can be read while the node is running.
+	`,
+	Example: `
+	cometbft show-sign-log
+	cometbft show-sign-log --from-height 100 --to-height 110
+	cometbft show-sign-log --file /path/to/priv_validator_sign.log
+	`,
+	RunE: func(*cobra.Command, []string) error {
+		filePath := signLogFile
+		if filePath == "" {
+			filePath = config.PrivValidatorSignLogFile()
+		}
+		return showSignLog(os.Stdout, filePath, signLogFromHeight, signLogToHeight)
+	},
+}
+
+// signLogRecord is the output format of an entry of the sign log.
+type signLogRecord struct {
+	Height    int64             `json:"height"`
+	Round     int32             `json:"round"`
+	Step      string            `json:"step"`
+	Time      time.Time         `json:"time"`
+	BlockHash cmtbytes.HexBytes `json:"block_hash"`
+	Signature []byte            `json:"signature"`
+	SignBytes cmtbytes.HexBytes `json:"sign_bytes"`
+}
+
+func showSignLog(w io.Writer, filePath string, fromHeight, toHeight int64) error {
+	if toHeight > 0 && toHeight < fromHeight {
+		return errors.New("to-height must be greater than or equal to from-height")
+	}
+	return privval.ReadSignLog(filePath, func(entry privval.SignLogEntry) error {
+		if entry.Height < fromHeight || (toHeight > 0 && entry.Height > toHeight) {
+			return nil
+		}
+		record := signLogRecord{
+			Height:    entry.Height,
+			Round:     entry.Round,
+			Time:      entry.Time,
+			Signature: entry.Signature,
+			SignBytes: entry.SignBytes,
+		}
+		var err error
+		record.Step, record.BlockHash, err = decodeSignBytes(entry.Step, entry.SignBytes)
+		if err != nil {
+			return fmt.Errorf("decoding the sign bytes of the entry at height %d, round %d: %w", entry.Height, entry.Round, err)
+		}
+		bz, err := cmtjson.Marshal(record)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(bz))
+		return err
+	})
+}
+
+// decodeSignBytes returns the name of the step, and the hash of the block
+// signed by signBytes.
+func decodeSignBytes(step int8, signBytes []byte) (string, []byte, error) {
+	switch step {
+	case 1:
+		var proposal cmtproto.CanonicalProposal
+		if err := protoio.UnmarshalDelimited(signBytes, &proposal); err != nil {
+			return "propose", nil, err
+		}
+		return "propose", proposal.GetBlockID().GetHash(), nil
+	case 2, 3:
+		name := "prevote"
+		if step == 3 {
+			name = "precommit"
+		}
+		var vote cmtproto.CanonicalVote
+		if err := protoio.UnmarshalDelimited(signBytes, &vote); err != nil {
+			return name, nil, err
+		}
+		return name, vote.GetBlockID().GetHash(), nil
+	default:
+		// e.g. the entry recorded when the validator is reset
+		return "none", nil, nil
+	}
+}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/v2/config"
+	"github.com/cometbft/cometbft/v2/crypto/tmhash"
+	cmtrand "github.com/cometbft/cometbft/v2/internal/rand"
+	cmtjson "github.com/cometbft/cometbft/v2/libs/json"
+	"github.com/cometbft/cometbft/v2/privval"
+	"github.com/cometbft/cometbft/v2/types"
+	cmttime "github.com/cometbft/cometbft/v2/types/time"
+)
+
+func Test_ShowSignLog(t *testing.T) {
+	config := cfg.TestConfig()
+	dir := t.TempDir()
+	config.SetRoot(dir)
+	cfg.EnsureRoot(dir)
+	require.NoError(t, initFilesWithConfig(config))
+
+	pv := privval.LoadFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	signLog, err := privval.OpenSignLog(config.PrivValidatorSignLogFile())
+	require.NoError(t, err)
+	require.NoError(t, pv.SetSignStateStore(signLog))
+	defer pv.Close()
+
+	blockID := types.BlockID{Hash: cmtrand.Bytes(tmhash.Size)}
+	for height := int64(1); height <= 3; height++ {
+		proposal := &types.Proposal{Type: types.ProposalType, Height: height, BlockID: blockID, Timestamp: cmttime.Now()}
+		require.NoError(t, pv.SignProposal("mychain", proposal.ToProto()))
+		vote := &types.Vote{Type: types.PrevoteType, Height: height, Timestamp: cmttime.Now()}
+		require.NoError(t, pv.SignVote("mychain", vote.ToProto(), false))
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, showSignLog(&out, config.PrivValidatorSignLogFile(), 2, 2))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var proposal, prevote signLogRecord
+	require.NoError(t, cmtjson.Unmarshal([]byte(lines[0]), &proposal))
+	require.NoError(t, cmtjson.Unmarshal([]byte(lines[1]), &prevote))
+	assert.Equal(t, int64(2), proposal.Height)
+	assert.Equal(t, "propose", proposal.Step)
+	assert.Equal(t, blockID.Hash, proposal.BlockHash)
+	assert.Equal(t, "prevote", prevote.Step)
+	assert.Empty(t, prevote.BlockHash)
+
+	out.Reset()
+	require.NoError(t, showSignLog(&out, config.PrivValidatorSignLogFile(), 0, 0))
+	assert.Len(t, strings.Split(strings.TrimSpace(out.String()), "\n"), 6)
+
+	require.Error(t, showSignLog(&out, config.PrivValidatorSignLogFile(), 3, 2))
+}
@@ -22,6 +22,7 @@ func main() {
 		cmd.ResetPrivValidatorCmd,
 		cmd.ResetStateCmd,
 		cmd.ShowValidatorCmd,
+		cmd.ShowSignLogCmd,
 		cmd.TestnetFilesCmd,
 		cmd.ShowNodeIDCmd,
 		cmd.ReIndexEventCmd,
@@ -30,6 +31,10 @@ func main() {
 		cmd.RollbackStateCmd,
 		cmd.CompactGoLevelDBCmd,
 		cmd.InspectCmd,
+		cmd.SyncFromArchiveCmd,
+		cmd.BlockStoreCmd,
+		cmd.MigrateKeyLayoutCmd,
+		cmd.ABCICmd,
 		debug.DebugCmd,
 		config.Command(),
 		cli.NewCompletionCmd(rootCmd, true),
@@ -2,6 +2,7 @@ package main
 
 import (
 	"flag"
+	"net"
 	"os"
 	"time"
 
@@ -18,6 +19,12 @@ func main() {
 		chainID          = flag.String("chain-id", "mychain", "chain id")
 		privValKeyPath   = flag.String("priv-key", "", "priv val key file path")
 		privValStatePath = flag.String("priv-state", "", "priv val state file path")
+		signLogPath      = flag.String("sign-log", "", "if set, also record every signature in this append-only log")
+
+		grpcListenAddr = flag.String("grpc-laddr", "", "Address to serve gRPC on, instead of connecting to addr")
+		certFile       = flag.String("cert", "", "gRPC server certificate file path")
+		keyFile        = flag.String("key", "", "gRPC server certificate key file path")
+		caFile         = flag.String("ca", "", "file path of the CA certificates that client certificates must be signed by")
 
 		logger = log.NewLogger(
 			os.Stdout,
@@ -34,6 +41,22 @@ func main() {
 	)
 
 	pv := privval.LoadFilePV(*privValKeyPath, *privValStatePath)
+	if *signLogPath != "" {
+		signLog, err := privval.OpenSignLog(*signLogPath)
+		if err != nil {
+			logger.Error("Failed to open sign log", "path", *signLogPath, "err", err)
+			os.Exit(1)
+		}
+		if err := pv.SetSignStateStore(signLog); err != nil {
+			logger.Error("Failed to load sign state from sign log", "path", *signLogPath, "err", err)
+			os.Exit(1)
+		}
+	}
+
+	if *grpcListenAddr != "" {
+		serveGRPC(*grpcListenAddr, *chainID, pv, *certFile, *keyFile, *caFile, logger)
+		return
+	}
 
 	var dialer privval.SocketDialer
 	protocol, address := cmtnet.ProtocolAndAddress(*addr)
@@ -67,3 +90,36 @@ func main() {
 	// Run forever.
 	select {}
 }
+
+// serveGRPC serves the signing requests over gRPC, authenticating clients
+// with mutual TLS.
+func serveGRPC(laddr, chainID string, pv *privval.FilePV, certFile, keyFile, caFile string, logger log.Logger) {
+	tlsConfig, err := privval.NewGRPCServerTLSConfig(certFile, keyFile, caFile)
+	if err != nil {
+		logger.Error("Failed to load TLS configuration", "err", err)
+		os.Exit(1)
+	}
+
+	protocol, address := cmtnet.ProtocolAndAddress(laddr)
+	ln, err := net.Listen(protocol, address)
+	if err != nil {
+		logger.Error("Failed to listen", "laddr", laddr, "err", err)
+		os.Exit(1)
+	}
+
+	ss := privval.NewGRPCSignerServer(ln, chainID, pv, tlsConfig, logger)
+	if err := ss.Start(); err != nil {
+		panic(err)
+	}
+
+	// Stop upon receiving SIGTERM or CTRL-C.
+	cmtos.TrapSignal(logger, func() {
+		err := ss.Stop()
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	// Run forever.
+	select {}
+}
@@ -9,6 +9,8 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,6 +41,7 @@ const (
 
 	DefaultPrivValKeyName   = "priv_validator_key.json"
 	DefaultPrivValStateName = "priv_validator_state.json"
+	DefaultPrivValSignLog   = "priv_validator_sign.log"
 
 	DefaultNodeKeyName  = "node_key.json"
 	DefaultAddrBookName = "addrbook.json"
@@ -49,8 +52,18 @@ const (
 	v1 = "v1"
 	v2 = "v2"
 
-	MempoolTypeFlood = "flood"
-	MempoolTypeNop   = "nop"
+	MempoolTypeFlood    = "flood"
+	MempoolTypePriority = "priority"
+	MempoolTypeNop      = "nop"
+
+	P2PTransportTCP  = "tcp"
+	P2PTransportQUIC = "quic"
+
+	SignStateStoreFile = "file"
+	SignStateStoreLog  = "log"
+
+	ABCIQueryDispatchRoundRobin = "round_robin"
+	ABCIQueryDispatchLeastBusy  = "least_busy"
 )
 
 // NOTE: Most of the structs & relevant comments + the
@@ -64,6 +77,7 @@ var (
 	defaultGenesisJSONPath  = filepath.Join(DefaultConfigDir, DefaultGenesisJSONName)
 	defaultPrivValKeyPath   = filepath.Join(DefaultConfigDir, DefaultPrivValKeyName)
 	defaultPrivValStatePath = filepath.Join(DefaultDataDir, DefaultPrivValStateName)
+	defaultPrivValSignLog   = filepath.Join(DefaultDataDir, DefaultPrivValSignLog)
 
 	defaultNodeKeyPath  = filepath.Join(DefaultConfigDir, DefaultNodeKeyName)
 	defaultAddrBookPath = filepath.Join(DefaultConfigDir, DefaultAddrBookName)
@@ -267,16 +281,67 @@ type BaseConfig struct {
 	// Path to the JSON file containing the last sign state of a validator
 	PrivValidatorState string `mapstructure:"priv_validator_state_file"`
 
+	// Where the last sign state of a validator is persisted: file | log
+	// * file
+	//   - only the last sign state is kept, in priv_validator_state_file
+	// * log
+	//   - every sign state is recorded in priv_validator_sign_log_file, an
+	//     append-only log which can be audited with the show-sign-log command
+	PrivValidatorSignStateStore string `mapstructure:"priv_validator_sign_state_store"`
+
+	// Path to the append-only log recording every sign state of a validator
+	PrivValidatorSignLog string `mapstructure:"priv_validator_sign_log_file"`
+
 	// TCP or UNIX socket address for CometBFT to listen on for
 	// connections from an external PrivValidator process
 	PrivValidatorListenAddr string `mapstructure:"priv_validator_laddr"`
 
+	// gRPC address of an external PrivValidator process for CometBFT to dial,
+	// authenticating with mutual TLS
+	PrivValidatorGRPCAddr string `mapstructure:"priv_validator_grpc_addr"`
+
+	// Path to the PEM file containing the certificate CometBFT presents to the
+	// gRPC PrivValidator process
+	PrivValidatorClientCertificate string `mapstructure:"priv_validator_client_certificate_file"`
+
+	// Path to the PEM file containing the key of the certificate CometBFT
+	// presents to the gRPC PrivValidator process
+	PrivValidatorClientKey string `mapstructure:"priv_validator_client_key_file"`
+
+	// Path to the PEM file containing the certificates of the CAs which the
+	// certificate of the gRPC PrivValidator process must be signed by
+	PrivValidatorRootCA string `mapstructure:"priv_validator_root_ca_file"`
+
 	// A JSON file containing the private key to use for p2p authenticated encryption
 	NodeKey string `mapstructure:"node_key_file"`
 
 	// Mechanism to connect to the ABCI application: socket | grpc
 	ABCI string `mapstructure:"abci"`
 
+	// Number of connections to the ABCI application serving the Info and
+	// Query calls, e.g. of the abci_info and abci_query RPC endpoints. With
+	// more than one, socket and gRPC applications serve these calls
+	// concurrently.
+	ABCIQueryConnections int `mapstructure:"abci_query_connections"`
+
+	// How the Info and Query calls are dispatched among the query
+	// connections: round_robin | least_busy
+	ABCIQueryDispatch string `mapstructure:"abci_query_dispatch"`
+
+	// Path to the file to which the calls to the ABCI application and their
+	// responses are recorded, to replay them with `cometbft abci replay`.
+	// Recording is disabled if empty.
+	ABCIRecord string `mapstructure:"abci_record_file"`
+
+	// If true, the connections to an out-of-process ABCI application are
+	// reestablished when it goes away, e.g. when it is restarted, instead of
+	// stopping the node. The application is brought back in sync with the
+	// committed blocks before consensus resumes.
+	ABCIReconnect bool `mapstructure:"abci_reconnect"`
+
+	// Maximum delay between two attempts to reconnect to the ABCI application
+	ABCIReconnectMaxBackoff time.Duration `mapstructure:"abci_reconnect_max_backoff"`
+
 	// If true, query the ABCI app on connecting to a new peer
 	// so the app can decide if we should keep the connection or not
 	FilterPeers bool `mapstructure:"filter_peers"` // false
@@ -289,16 +354,26 @@ func DefaultBaseConfig() BaseConfig {
 		Genesis:            defaultGenesisJSONPath,
 		PrivValidatorKey:   defaultPrivValKeyPath,
 		PrivValidatorState: defaultPrivValStatePath,
-		NodeKey:            defaultNodeKeyPath,
-		Moniker:            defaultMoniker,
-		ProxyApp:           "tcp://127.0.0.1:26658",
-		ABCI:               "socket",
-		LogLevel:           DefaultLogLevel,
-		LogFormat:          LogFormatPlain,
-		LogColors:          true,
-		FilterPeers:        false,
-		DBBackend:          "pebbledb",
-		DBPath:             DefaultDataDir,
+
+		PrivValidatorSignStateStore: SignStateStoreFile,
+		PrivValidatorSignLog:        defaultPrivValSignLog,
+
+		NodeKey:     defaultNodeKeyPath,
+		Moniker:     defaultMoniker,
+		ProxyApp:    "tcp://127.0.0.1:26658",
+		ABCI:        "socket",
+		LogLevel:    DefaultLogLevel,
+		LogFormat:   LogFormatPlain,
+		LogColors:   true,
+		FilterPeers: false,
+		DBBackend:   "pebbledb",
+		DBPath:      DefaultDataDir,
+
+		ABCIQueryConnections: 1,
+		ABCIQueryDispatch:    ABCIQueryDispatchRoundRobin,
+
+		ABCIReconnect:           false,
+		ABCIReconnectMaxBackoff: 10 * time.Second,
 	}
 }
 
@@ -325,11 +400,40 @@ func (cfg BaseConfig) PrivValidatorStateFile() string {
 	return rootify(cfg.PrivValidatorState, cfg.RootDir)
 }
 
+// PrivValidatorSignLogFile returns the full path to the priv_validator_sign.log file.
+func (cfg BaseConfig) PrivValidatorSignLogFile() string {
+	return rootify(cfg.PrivValidatorSignLog, cfg.RootDir)
+}
+
+// PrivValidatorClientCertificateFile returns the full path to the certificate
+// presented to the gRPC PrivValidator process.
+func (cfg BaseConfig) PrivValidatorClientCertificateFile() string {
+	return rootify(cfg.PrivValidatorClientCertificate, cfg.RootDir)
+}
+
+// PrivValidatorClientKeyFile returns the full path to the key of the
+// certificate presented to the gRPC PrivValidator process.
+func (cfg BaseConfig) PrivValidatorClientKeyFile() string {
+	return rootify(cfg.PrivValidatorClientKey, cfg.RootDir)
+}
+
+// PrivValidatorRootCAFile returns the full path to the certificates of the CAs
+// trusted to sign the certificate of the gRPC PrivValidator process.
+func (cfg BaseConfig) PrivValidatorRootCAFile() string {
+	return rootify(cfg.PrivValidatorRootCA, cfg.RootDir)
+}
+
 // NodeKeyFile returns the full path to the node_key.json file.
 func (cfg BaseConfig) NodeKeyFile() string {
 	return rootify(cfg.NodeKey, cfg.RootDir)
 }
 
+// ABCIRecordFile returns the full path to the recording of the calls to the
+// ABCI application.
+func (cfg BaseConfig) ABCIRecordFile() string {
+	return rootify(cfg.ABCIRecord, cfg.RootDir)
+}
+
 // DBDir returns the full path to the database directory.
 func (cfg BaseConfig) DBDir() string {
 	return rootify(cfg.DBPath, cfg.RootDir)
@@ -350,6 +454,46 @@ func (cfg BaseConfig) ValidateBasic() error {
 		return errors.New("unknown log_format (must be 'plain' or 'json')")
 	}
 
+	switch cfg.PrivValidatorSignStateStore {
+	case SignStateStoreFile:
+	case SignStateStoreLog:
+		if cfg.PrivValidatorSignLog == "" {
+			return errors.New("priv_validator_sign_log_file must be set when priv_validator_sign_state_store is 'log'")
+		}
+	default:
+		return fmt.Errorf("unknown priv_validator_sign_state_store %q (must be '%s' or '%s')",
+			cfg.PrivValidatorSignStateStore, SignStateStoreFile, SignStateStoreLog)
+	}
+
+	if cfg.PrivValidatorGRPCAddr != "" {
+		if cfg.PrivValidatorListenAddr != "" {
+			return errors.New("priv_validator_laddr and priv_validator_grpc_addr cannot both be set")
+		}
+		if cfg.PrivValidatorClientCertificate == "" || cfg.PrivValidatorClientKey == "" || cfg.PrivValidatorRootCA == "" {
+			return errors.New("priv_validator_client_certificate_file, priv_validator_client_key_file and " +
+				"priv_validator_root_ca_file must be set when priv_validator_grpc_addr is set")
+		}
+	}
+
+	if cfg.ABCIQueryConnections < 1 {
+		return errors.New("abci_query_connections must be at least 1")
+	}
+	switch cfg.ABCIQueryDispatch {
+	case ABCIQueryDispatchRoundRobin, ABCIQueryDispatchLeastBusy:
+	default:
+		return fmt.Errorf("unknown abci_query_dispatch %q (must be '%s' or '%s')",
+			cfg.ABCIQueryDispatch, ABCIQueryDispatchRoundRobin, ABCIQueryDispatchLeastBusy)
+	}
+
+	if cfg.ABCIReconnect {
+		if slices.Contains(proxyAppList, cfg.ProxyApp) {
+			return errors.New("abci_reconnect requires proxy_app to be the address of an out-of-process application")
+		}
+		if cfg.ABCIReconnectMaxBackoff <= 0 {
+			return errors.New("abci_reconnect_max_backoff must be positive")
+		}
+	}
+
 	return cfg.validateProxyApp()
 }
 
@@ -440,11 +584,15 @@ type RPCConfig struct {
 	// Maximum number of unique clientIDs that can /subscribe
 	// If you're using /broadcast_tx_commit, set to the estimated maximum number
 	// of broadcast_tx_commit calls per block.
+	// The same limit applies separately to the clients of the gRPC mempool and
+	// event services, identified by their address.
 	MaxSubscriptionClients int `mapstructure:"max_subscription_clients"`
 
 	// Maximum number of unique queries a given client can /subscribe to. If
 	// you're using /broadcast_tx_commit, set to the estimated maximum number
 	// of broadcast_tx_commit calls per block.
+	// The same limit applies to the subscriptions of the gRPC mempool and event
+	// services.
 	MaxSubscriptionsPerClient int `mapstructure:"max_subscriptions_per_client"`
 
 	// The number of events that can be buffered per subscription before
@@ -486,6 +634,23 @@ type RPCConfig struct {
 	// Maximum size of request header, in bytes
 	MaxHeaderBytes int `mapstructure:"max_header_bytes"`
 
+	// Maximum rate of the calls of each remote IP, in calls per second.
+	// Calls of the methods in RateLimitMethodCosts count as several calls.
+	// 0 disables the limit.
+	RateLimitPerIP float64 `mapstructure:"rate_limit_per_ip"`
+
+	// Maximum number of calls a remote IP can make in a burst, when
+	// RateLimitPerIP is enabled.
+	RateLimitPerIPBurst int `mapstructure:"rate_limit_per_ip_burst"`
+
+	// Number of calls a call of each of the given methods counts as for
+	// RateLimitPerIP, in the "<method>:<cost>" format. Other methods cost 1.
+	RateLimitMethodCosts []string `mapstructure:"rate_limit_method_costs"`
+
+	// Limits on the calls of the given methods, shared by all the clients, in
+	// the "<method>:<calls per second>:<burst>" format.
+	RateLimitMethods []string `mapstructure:"rate_limit_methods"`
+
 	// The path to a file containing certificate that is used to create the HTTPS server.
 	// Might be either absolute path or path related to CometBFT's config directory.
 	//
@@ -530,6 +695,11 @@ func DefaultRPCConfig() *RPCConfig {
 		MaxBodyBytes:        int64(1000000), // 1MB
 		MaxHeaderBytes:      1 << 20,        // same as the net/http default
 
+		RateLimitPerIP:       0, // unlimited
+		RateLimitPerIPBurst:  100,
+		RateLimitMethodCosts: []string{"tx_search:10", "block_search:10", "block_results:5"},
+		RateLimitMethods:     []string{},
+
 		TLSCertFile: "",
 		TLSKeyFile:  "",
 	}
@@ -576,9 +746,72 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.MaxHeaderBytes < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_header_bytes"}
 	}
+	if cfg.RateLimitPerIP < 0 {
+		return cmterrors.ErrNegativeField{Field: "rate_limit_per_ip"}
+	}
+	if cfg.RateLimitPerIP > 0 && cfg.RateLimitPerIPBurst < 1 {
+		return errors.New("rate_limit_per_ip_burst must be positive when rate_limit_per_ip is enabled")
+	}
+	if _, err := cfg.RateLimitMethodCostsMap(); err != nil {
+		return fmt.Errorf("invalid rate_limit_method_costs: %w", err)
+	}
+	if _, err := cfg.RateLimitMethodsMap(); err != nil {
+		return fmt.Errorf("invalid rate_limit_methods: %w", err)
+	}
 	return nil
 }
 
+// RPCMethodRateLimit is a limit on the rate of the calls of an RPC method.
+type RPCMethodRateLimit struct {
+	Rate  float64 // calls per second
+	Burst int
+}
+
+// RateLimitMethodCostsMap parses RateLimitMethodCosts into a map from method
+// names to costs.
+func (cfg *RPCConfig) RateLimitMethodCostsMap() (map[string]int, error) {
+	costs := make(map[string]int, len(cfg.RateLimitMethodCosts))
+	for _, entry := range cfg.RateLimitMethodCosts {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("%q is not in the <method>:<cost> format", entry)
+		}
+		cost, err := strconv.Atoi(parts[1])
+		if err != nil || cost < 0 {
+			return nil, fmt.Errorf("invalid cost in %q", entry)
+		}
+		costs[parts[0]] = cost
+	}
+	return costs, nil
+}
+
+// RateLimitMethodsMap parses RateLimitMethods into a map from method names to
+// rate limits.
+func (cfg *RPCConfig) RateLimitMethodsMap() (map[string]RPCMethodRateLimit, error) {
+	limits := make(map[string]RPCMethodRateLimit, len(cfg.RateLimitMethods))
+	for _, entry := range cfg.RateLimitMethods {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 || parts[0] == "" {
+			return nil, fmt.Errorf("%q is not in the <method>:<rate>:<burst> format", entry)
+		}
+		rate, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid rate in %q", entry)
+		}
+		burst, err := strconv.Atoi(parts[2])
+		if err != nil || burst < 1 {
+			return nil, fmt.Errorf("invalid burst in %q", entry)
+		}
+		limits[parts[0]] = RPCMethodRateLimit{Rate: rate, Burst: burst}
+	}
+	return limits, nil
+}
+
+// IsRateLimitEnabled returns true if the rate of the RPC calls is limited.
+func (cfg *RPCConfig) IsRateLimitEnabled() bool {
+	return cfg.RateLimitPerIP > 0 || len(cfg.RateLimitMethods) != 0
+}
+
 // IsCorsEnabled returns true if cross-origin resource sharing is enabled.
 func (cfg *RPCConfig) IsCorsEnabled() bool {
 	return len(cfg.CORSAllowedOrigins) != 0
@@ -628,6 +861,14 @@ type GRPCConfig struct {
 	// If no height is provided, the block results of the latest height are returned
 	BlockResultsService *GRPCBlockResultsServiceConfig `mapstructure:"block_results_service"`
 
+	// The gRPC mempool service allows clients to broadcast transactions, to
+	// inspect the mempool and to follow the results of committed transactions
+	MempoolService *GRPCMempoolServiceConfig `mapstructure:"mempool_service"`
+
+	// The gRPC event service streams the events matching a query, optionally
+	// replaying the events of past blocks first
+	EventService *GRPCEventServiceConfig `mapstructure:"event_service"`
+
 	// The "privileged" section provides configuration for the gRPC server
 	// dedicated to privileged clients.
 	Privileged *GRPCPrivilegedConfig `mapstructure:"privileged"`
@@ -639,6 +880,8 @@ func DefaultGRPCConfig() *GRPCConfig {
 		VersionService:      DefaultGRPCVersionServiceConfig(),
 		BlockService:        DefaultGRPCBlockServiceConfig(),
 		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
+		MempoolService:      DefaultGRPCMempoolServiceConfig(),
+		EventService:        DefaultGRPCEventServiceConfig(),
 		Privileged:          DefaultGRPCPrivilegedConfig(),
 	}
 }
@@ -649,6 +892,8 @@ func TestGRPCConfig() *GRPCConfig {
 		VersionService:      TestGRPCVersionServiceConfig(),
 		BlockService:        TestGRPCBlockServiceConfig(),
 		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
+		MempoolService:      TestGRPCMempoolServiceConfig(),
+		EventService:        DefaultGRPCEventServiceConfig(),
 		Privileged:          TestGRPCPrivilegedConfig(),
 	}
 }
@@ -663,6 +908,15 @@ func (cfg *GRPCConfig) ValidateBasic() error {
 			)
 		}
 	}
+	if cfg.MempoolService.TimeoutBroadcastTxCommit < 0 {
+		return cmterrors.ErrNegativeField{Field: "mempool_service.timeout_broadcast_tx_commit"}
+	}
+	if cfg.EventService.BufferSize <= 0 {
+		return errors.New("event_service.buffer_size must be positive")
+	}
+	if cfg.EventService.MaxReplayHeights < 0 {
+		return cmterrors.ErrNegativeField{Field: "event_service.max_replay_heights"}
+	}
 	return nil
 }
 
@@ -708,6 +962,48 @@ func TestGRPCBlockServiceConfig() *GRPCBlockServiceConfig {
 	}
 }
 
+type GRPCMempoolServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// How long to wait for a transaction to be committed when it is broadcast
+	// in the commit mode
+	TimeoutBroadcastTxCommit time.Duration `mapstructure:"timeout_broadcast_tx_commit"`
+}
+
+func DefaultGRPCMempoolServiceConfig() *GRPCMempoolServiceConfig {
+	return &GRPCMempoolServiceConfig{
+		Enabled:                  false,
+		TimeoutBroadcastTxCommit: 10 * time.Second,
+	}
+}
+
+func TestGRPCMempoolServiceConfig() *GRPCMempoolServiceConfig {
+	return &GRPCMempoolServiceConfig{
+		Enabled:                  true,
+		TimeoutBroadcastTxCommit: 10 * time.Second,
+	}
+}
+
+type GRPCEventServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Maximum number of events buffered for each subscription. Subscriptions
+	// of clients that do not keep up with the events are terminated
+	BufferSize int `mapstructure:"buffer_size"`
+
+	// Maximum number of past blocks whose events can be replayed when a
+	// subscription starts. 0 means no limit
+	MaxReplayHeights int64 `mapstructure:"max_replay_heights"`
+}
+
+func DefaultGRPCEventServiceConfig() *GRPCEventServiceConfig {
+	return &GRPCEventServiceConfig{
+		Enabled:          true,
+		BufferSize:       200,
+		MaxReplayHeights: 1000,
+	}
+}
+
 // -----------------------------------------------------------------------------
 // GRPCPrivilegedConfig
 
@@ -766,6 +1062,13 @@ type P2PConfig struct { //nolint: maligned
 	// Address to advertise to peers for them to dial
 	ExternalAddress string `mapstructure:"external_address"`
 
+	// Transport used to connect to peers:
+	//   - "tcp": MConnection multiplexed over a TCP connection, secured with
+	//     SecretConnection.
+	//   - "quic": each channel is mapped to a QUIC stream (over UDP), secured
+	//     with TLS 1.3. Peers must use the same transport to connect.
+	Transport string `mapstructure:"transport"`
+
 	// Comma separated list of seed nodes to connect to
 	// We only use these if we can’t connect to peers in the addrbook
 	Seeds string `mapstructure:"seeds"`
@@ -833,6 +1136,7 @@ func DefaultP2PConfig() *P2PConfig {
 	return &P2PConfig{
 		ListenAddress:                "tcp://0.0.0.0:26656",
 		ExternalAddress:              "",
+		Transport:                    P2PTransportTCP,
 		AddrBook:                     defaultAddrBookPath,
 		AddrBookStrict:               true,
 		MaxNumInboundPeers:           40,
@@ -867,6 +1171,12 @@ func (cfg *P2PConfig) AddrBookFile() string {
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
+	switch cfg.Transport {
+	case P2PTransportTCP, P2PTransportQUIC:
+	case "": // allow empty string to be backwards compatible
+	default:
+		return fmt.Errorf("unknown p2p transport: %q", cfg.Transport)
+	}
 	if cfg.MaxNumInboundPeers < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_num_inbound_peers"}
 	}
@@ -924,9 +1234,13 @@ type MempoolConfig struct {
 	// The type of mempool for this node to use.
 	//
 	//  Possible types:
-	//  - "flood" : concurrent linked list mempool with flooding gossip protocol
+	//  - "flood"    : concurrent linked list mempool with flooding gossip protocol
 	//  (default)
-	//  - "nop"   : nop-mempool (short for no operation; the ABCI app is
+	//  - "priority" : like "flood", but transactions are reaped in the order of
+	//  the priority returned by the application in CheckTx, and the
+	//  lowest-priority transactions are evicted when the mempool is full.
+	//  Lanes are not supported.
+	//  - "nop"      : nop-mempool (short for no operation; the ABCI app is
 	//  responsible for storing, disseminating and proposing txs).
 	//  "create_empty_blocks=false" is not supported.
 	Type string `mapstructure:"type"`
@@ -966,6 +1280,21 @@ type MempoolConfig struct {
 	// Set to true if it's not possible for any invalid transaction to become
 	// valid again in the future.
 	KeepInvalidTxsInCache bool `mapstructure:"keep-invalid-txs-in-cache"`
+	// Journal (default: false) records the transactions in the mempool, along
+	// with their lane, in the "mempool" database, and replays them through
+	// CheckTx when the node restarts, after the handshake with the application.
+	// Transactions of the lanes with the highest priority are replayed first,
+	// up to MaxTxsBytes. It is only supported by the Flood and Priority mempool
+	// types.
+	Journal bool `mapstructure:"journal"`
+	// TxTraceSize is the number of transactions for which the mempool keeps
+	// the events of their lifecycle (received, checked, added to a lane,
+	// rechecked, rejected or removed with a reason, included in a block),
+	// queryable with the tx_status RPC endpoint. When the limit is reached, the
+	// trace of the oldest transaction is dropped. If set to 0 (default),
+	// tracing is disabled. It is only supported by the Flood and Priority
+	// mempool types.
+	TxTraceSize int `mapstructure:"tx_trace_size"`
 	// Experimental parameters to limit gossiping txs to up to the specified number of peers.
 	// We use two independent upper values for persistent and non-persistent peers.
 	// Unconditional peers are not affected by this feature.
@@ -986,7 +1315,12 @@ type MempoolConfig struct {
 	// Use this feature with caution and consider the impact on transaction processing performance.
 	ExperimentalPublishEventPendingTx bool `mapstructure:"experimental_publish_event_pending_tx"`
 
-	// When using the Flood mempool type, enable the DOG gossip protocol to
+	// ExperimentalPublishEventMempoolTx enables publishing a `MempoolTx` event for each event of the
+	// lifecycle of a transaction traced by the mempool. It requires TxTraceSize to be greater than 0.
+	// Note: Enabling this feature may introduce potential delays in transaction processing due to blocking behavior.
+	ExperimentalPublishEventMempoolTx bool `mapstructure:"experimental_publish_event_mempool_tx"`
+
+	// When using the Flood or Priority mempool type, enable the DOG gossip protocol to
 	// reduce network bandwidth on transaction dissemination (for details, see
 	// specs/mempool/gossip/).
 	DOGProtocolEnabled bool `mapstructure:"dog_protocol_enabled"`
@@ -1035,7 +1369,7 @@ func TestMempoolConfig() *MempoolConfig {
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
 	switch cfg.Type {
-	case MempoolTypeFlood, MempoolTypeNop:
+	case MempoolTypeFlood, MempoolTypePriority, MempoolTypeNop:
 	case "": // allow empty string to be backwards compatible
 	default:
 		return fmt.Errorf("unknown mempool type: %q", cfg.Type)
@@ -1052,6 +1386,9 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.MaxTxBytes < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_tx_bytes"}
 	}
+	if cfg.TxTraceSize < 0 {
+		return cmterrors.ErrNegativeField{Field: "tx_trace_size"}
+	}
 	if cfg.ExperimentalMaxGossipConnectionsToPersistentPeers < 0 {
 		return cmterrors.ErrNegativeField{Field: "experimental_max_gossip_connections_to_persistent_peers"}
 	}
@@ -1072,11 +1409,30 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 		}
 	}
 
+	if cfg.Type == MempoolTypeNop && cfg.Journal {
+		return cmterrors.ErrWrongField{
+			Field: "journal",
+			Err:   errors.New("the journal only works with the Flood and Priority mempool types"),
+		}
+	}
+	if cfg.Type == MempoolTypeNop && cfg.TxTraceSize > 0 {
+		return cmterrors.ErrWrongField{
+			Field: "tx_trace_size",
+			Err:   errors.New("tracing transactions only works with the Flood and Priority mempool types"),
+		}
+	}
+	if cfg.ExperimentalPublishEventMempoolTx && cfg.TxTraceSize == 0 {
+		return cmterrors.ErrWrongField{
+			Field: "experimental_publish_event_mempool_tx",
+			Err:   errors.New("publishing mempool tx events requires tx_trace_size to be greater than 0"),
+		}
+	}
+
 	// DOG gossip protocol
-	if cfg.Type != MempoolTypeFlood && cfg.DOGProtocolEnabled {
+	if cfg.Type != MempoolTypeFlood && cfg.Type != MempoolTypePriority && cfg.DOGProtocolEnabled {
 		return cmterrors.ErrWrongField{
 			Field: "dog_protocol_enabled",
-			Err:   errors.New("DOG protocol only works with the Flood mempool type"),
+			Err:   errors.New("DOG protocol only works with the Flood and Priority mempool types"),
 		}
 	}
 	if cfg.DOGProtocolEnabled &&
@@ -1202,12 +1558,17 @@ func (cfg *StateSyncConfig) PossibleMisconfigurations() []string {
 // BlockSyncConfig (formerly known as FastSync) defines the configuration for the CometBFT block sync service.
 type BlockSyncConfig struct {
 	Version string `mapstructure:"version"`
+
+	// The number of downloaded blocks, ahead of the block being applied, whose
+	// commit signatures are verified concurrently. 0 disables it.
+	VerifyAhead int `mapstructure:"verify_ahead"`
 }
 
 // DefaultBlockSyncConfig returns a default configuration for the block sync service.
 func DefaultBlockSyncConfig() *BlockSyncConfig {
 	return &BlockSyncConfig{
-		Version: "v0",
+		Version:     "v0",
+		VerifyAhead: 64,
 	}
 }
 
@@ -1218,6 +1579,9 @@ func TestBlockSyncConfig() *BlockSyncConfig {
 
 // ValidateBasic performs basic validation.
 func (cfg *BlockSyncConfig) ValidateBasic() error {
+	if cfg.VerifyAhead < 0 {
+		return cmterrors.ErrNegativeField{Field: "verify_ahead"}
+	}
 	switch cfg.Version {
 	case v0:
 		return nil
@@ -1259,6 +1623,17 @@ type ConsensusConfig struct {
 	PeerGossipIntraloopSleepDuration time.Duration `mapstructure:"peer_gossip_intraloop_sleep_duration"` // upper bound on randomly selected values
 
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
+
+	// If true, a proposed block is executed as soon as the node prevotes it,
+	// rather than once it is decided. The application must
+	// discard the state of the FinalizeBlock calls made on blocks which are
+	// not decided.
+	OptimisticExecution bool `mapstructure:"optimistic_execution"`
+
+	// If true, the proposed block is gossiped as a compact block to the peers
+	// which also enabled it: the hashes of its transactions, which the peers
+	// take from their mempool, rather than its parts.
+	CompactBlocks bool `mapstructure:"compact_blocks"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service.
@@ -1276,6 +1651,8 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		PeerQueryMaj23SleepDuration:      2000 * time.Millisecond,
 		PeerGossipIntraloopSleepDuration: 0 * time.Second,
 		DoubleSignCheckHeight:            int64(0),
+		OptimisticExecution:              false,
+		CompactBlocks:                    false,
 	}
 }
 
@@ -1459,6 +1836,8 @@ type TxIndexConfig struct {
 	//   2) "kv" (default) - the simplest possible indexer,
 	//      backed by key-value storage (defaults to levelDB; see DBBackend).
 	//   3) "psql" - the indexer services backed by PostgreSQL.
+	//   4) "sqlite" - the indexer services backed by an embedded SQLite
+	//      database, stored in the DBDir.
 	Indexer string `mapstructure:"indexer"`
 
 	// The PostgreSQL connection configuration, the connection format:
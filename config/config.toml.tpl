@@ -61,16 +61,72 @@ priv_validator_key_file = "{{ js .BaseConfig.PrivValidatorKey }}"
 # Path to the JSON file containing the last sign state of a validator
 priv_validator_state_file = "{{ js .BaseConfig.PrivValidatorState }}"
 
+# Where the last sign state of a validator is persisted: file | log
+# * file
+#   - only the last sign state is kept, in priv_validator_state_file
+# * log
+#   - every sign state, i.e. the height, round and step of every signature
+#     along with the signed bytes, is recorded in priv_validator_sign_log_file,
+#     an append-only log which can be audited with the show-sign-log command
+#   - when switching to the log, the state of priv_validator_state_file is
+#     carried over; the state file is still kept up to date, so that switching
+#     back is safe
+priv_validator_sign_state_store = "{{ .BaseConfig.PrivValidatorSignStateStore }}"
+
+# Path to the append-only log recording every sign state of a validator
+priv_validator_sign_log_file = "{{ js .BaseConfig.PrivValidatorSignLog }}"
+
 # TCP or UNIX socket address for CometBFT to listen on for
 # connections from an external PrivValidator process
 priv_validator_laddr = "{{ .BaseConfig.PrivValidatorListenAddr }}"
 
+# gRPC address of an external PrivValidator process for CometBFT to dial
+# (e.g. "signer.example.com:26659" or "dns:///signer.example.com:26659"),
+# authenticating with mutual TLS. Cannot be set along with priv_validator_laddr.
+priv_validator_grpc_addr = "{{ .BaseConfig.PrivValidatorGRPCAddr }}"
+
+# Paths to the PEM files containing the certificate and key CometBFT presents
+# to the gRPC PrivValidator process, and the certificates of the CAs which
+# the certificate of the PrivValidator process must be signed by.
+# The files are reloaded when they change, to allow rotating certificates.
+priv_validator_client_certificate_file = "{{ js .BaseConfig.PrivValidatorClientCertificate }}"
+priv_validator_client_key_file = "{{ js .BaseConfig.PrivValidatorClientKey }}"
+priv_validator_root_ca_file = "{{ js .BaseConfig.PrivValidatorRootCA }}"
+
 # Path to the JSON file containing the private key to use for node authentication in the p2p protocol
 node_key_file = "{{ js .BaseConfig.NodeKey }}"
 
 # Mechanism to connect to the ABCI application: socket | grpc
 abci = "{{ .BaseConfig.ABCI }}"
 
+# Number of connections to the ABCI application serving the Info and Query
+# calls, e.g. of the abci_info and abci_query RPC endpoints. With more than one,
+# socket and gRPC applications serve these calls concurrently, so that a slow
+# query does not block the others. The application must handle concurrent
+# queries.
+abci_query_connections = {{ .BaseConfig.ABCIQueryConnections }}
+
+# How the Info and Query calls are dispatched among the query connections:
+#   - "round_robin": to each connection in turn
+#   - "least_busy": to the connection with the fewest calls in progress
+abci_query_dispatch = "{{ .BaseConfig.ABCIQueryDispatch }}"
+
+# Path to the file to which the calls to the ABCI application and their
+# responses are recorded, to replay them against a fresh instance of the
+# application with `cometbft abci replay`. The recording is rotated like the
+# consensus WAL. Recording is disabled if empty.
+abci_record_file = "{{ js .BaseConfig.ABCIRecord }}"
+
+# If true, the connections to an out-of-process ABCI application are
+# reestablished when it goes away, e.g. when it is restarted, instead of
+# stopping the node. Before consensus resumes, the blocks the application lost
+# are replayed to it, like on start.
+abci_reconnect = {{ .BaseConfig.ABCIReconnect }}
+
+# Maximum delay between two attempts to reconnect to the ABCI application. The
+# delay doubles after each failed attempt, from 500ms.
+abci_reconnect_max_backoff = "{{ .BaseConfig.ABCIReconnectMaxBackoff }}"
+
 # If true, query the ABCI app on connecting to a new peer
 # so the app can decide if we should keep the connection or not
 filter_peers = {{ .BaseConfig.FilterPeers }}
@@ -113,11 +169,15 @@ max_open_connections = {{ .RPC.MaxOpenConnections }}
 # Maximum number of unique clientIDs that can /subscribe.
 # If you're using /broadcast_tx_commit, set to the estimated maximum number
 # of broadcast_tx_commit calls per block.
+# The same limit applies separately to the clients of the gRPC mempool and
+# event services, identified by their address.
 max_subscription_clients = {{ .RPC.MaxSubscriptionClients }}
 
 # Maximum number of unique queries a given client can /subscribe to.
 # If you're using /broadcast_tx_commit, set to the estimated maximum number
 # of broadcast_tx_commit calls per block.
+# The same limit applies to the subscriptions of the gRPC mempool and event
+# services.
 max_subscriptions_per_client = {{ .RPC.MaxSubscriptionsPerClient }}
 
 # Experimental parameter to specify the maximum number of events a node will
@@ -164,6 +224,24 @@ max_body_bytes = {{ .RPC.MaxBodyBytes }}
 # Maximum size of request header, in bytes
 max_header_bytes = {{ .RPC.MaxHeaderBytes }}
 
+# Maximum rate of the calls of each remote IP, in calls per second.
+# Calls of the methods in rate_limit_method_costs count as several calls.
+# Calls exceeding the limit are rejected with a JSON-RPC error (code -32005).
+# 0 - unlimited.
+rate_limit_per_ip = {{ .RPC.RateLimitPerIP }}
+
+# Maximum number of calls a remote IP can make in a burst, when
+# rate_limit_per_ip is enabled.
+rate_limit_per_ip_burst = {{ .RPC.RateLimitPerIPBurst }}
+
+# Number of calls a call of each of the given methods counts as for
+# rate_limit_per_ip, in the "<method>:<cost>" format. Other methods cost 1.
+rate_limit_method_costs = [{{ range .RPC.RateLimitMethodCosts }}{{ printf "%q, " . }}{{end}}]
+
+# Limits on the calls of the given methods, shared by all the clients, in the
+# "<method>:<calls per second>:<burst>" format, e.g. ["tx_search:5:10"].
+rate_limit_methods = [{{ range .RPC.RateLimitMethods }}{{ printf "%q, " . }}{{end}}]
+
 # The path to a file containing certificate that is used to create the HTTPS server.
 # Might be either absolute path or path related to CometBFT's config directory.
 # If the certificate is signed by a certificate authority,
@@ -221,6 +299,32 @@ enabled = {{ .GRPC.BlockService.Enabled }}
 [grpc.block_results_service]
 enabled = {{ .GRPC.BlockResultsService.Enabled }}
 
+# The gRPC mempool service allows clients to broadcast transactions, check them
+# with the application, list the transactions of the mempool and follow the
+# results of committed transactions. It is disabled by default, since it lets
+# clients submit transactions.
+[grpc.mempool_service]
+enabled = {{ .GRPC.MempoolService.Enabled }}
+
+# How long to wait for a transaction to be committed when it is broadcast in
+# the commit mode.
+timeout_broadcast_tx_commit = "{{ .GRPC.MempoolService.TimeoutBroadcastTxCommit }}"
+
+# The gRPC event service streams the events matching a query. Subscriptions
+# can replay the events of past blocks first, so that clients do not miss
+# events across reconnections.
+[grpc.event_service]
+enabled = {{ .GRPC.EventService.Enabled }}
+
+# Maximum number of events buffered for each subscription. Subscriptions of
+# clients that do not keep up with the events are terminated, and are expected
+# to be resumed from the height of the last event processed.
+buffer_size = {{ .GRPC.EventService.BufferSize }}
+
+# Maximum number of past blocks whose events can be replayed when a
+# subscription starts. 0 means no limit.
+max_replay_heights = {{ .GRPC.EventService.MaxReplayHeights }}
+
 #
 # Configuration for privileged gRPC endpoints, which should **never** be exposed
 # to the public internet.
@@ -255,6 +359,15 @@ laddr = "{{ .P2P.ListenAddress }}"
 # address. IP and port are required. Example: 159.89.10.97:26656
 external_address = "{{ .P2P.ExternalAddress }}"
 
+# Transport used to connect to peers. Peers must use the same transport.
+#   1) "tcp" (default) - channels are multiplexed over a single TCP connection
+#      (MConnection), secured with SecretConnection.
+#   2) "quic" - each channel is mapped to a QUIC stream, so a congested channel
+#      (e.g., mempool) doesn't delay the others (e.g., consensus). Connections
+#      are secured with TLS 1.3 and authenticated with the node key. QUIC uses
+#      UDP on the port of laddr.
+transport = "{{ .P2P.Transport }}"
+
 # Comma separated list of seed nodes to connect to
 seeds = "{{ .P2P.Seeds }}"
 
@@ -315,9 +428,12 @@ allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 # The type of mempool for this node to use.
 #
 #  Possible types:
-#  - "flood" : concurrent linked list mempool with flooding gossip protocol
+#  - "flood"    : concurrent linked list mempool with flooding gossip protocol
 #  (default)
-#  - "nop"   : nop-mempool (short for no operation; the ABCI app is responsible
+#  - "priority" : like "flood", but transactions are reaped in the order of the
+#  priority returned by the application in CheckTx, and the lowest-priority
+#  transactions are evicted when the mempool is full. Lanes are not supported.
+#  - "nop"      : nop-mempool (short for no operation; the ABCI app is responsible
 #  for storing, disseminating and proposing txs). "create_empty_blocks=false" is
 #  not supported.
 type = "{{ .Mempool.Type }}"
@@ -362,6 +478,21 @@ cache_size = {{ .Mempool.CacheSize }}
 # again in the future.
 keep-invalid-txs-in-cache = {{ .Mempool.KeepInvalidTxsInCache }}
 
+# Record the transactions in the mempool, along with their lane, in the
+# "mempool" database, and replay them through CheckTx when the node restarts
+# (default: false). Transactions of the lanes with the highest priority are
+# replayed first, up to max_txs_bytes.
+# Only supported by the "flood" and "priority" mempool types.
+journal = {{ .Mempool.Journal }}
+
+# Number of transactions for which the mempool keeps the events of their
+# lifecycle (received, checked, added to a lane, rechecked, rejected or removed
+# with a reason, included in a block), queryable with the tx_status RPC
+# endpoint. When the limit is reached, the trace of the oldest transaction is
+# dropped. Set to 0 to disable tracing (default).
+# Only supported by the "flood" and "priority" mempool types.
+tx_trace_size = {{ .Mempool.TxTraceSize }}
+
 # Experimental parameters to limit gossiping txs to up to the specified number of peers.
 # We use two independent upper values for persistent and non-persistent peers.
 # Unconditional peers are not affected by this feature.
@@ -382,7 +513,12 @@ experimental_max_gossip_connections_to_non_persistent_peers = {{ .Mempool.Experi
 # Use this feature with caution and consider the impact on transaction processing performance.
 experimental_publish_event_pending_tx = {{ .Mempool.ExperimentalPublishEventPendingTx }}
 
-# When using the Flood mempool type, enable the DOG gossip protocol to
+# ExperimentalPublishEventMempoolTx enables publishing a `MempoolTx` event for each event of the lifecycle of a
+# transaction traced by the mempool. It requires tx_trace_size to be greater than 0.
+# Note: Enabling this feature may introduce potential delays in transaction processing due to blocking behavior.
+experimental_publish_event_mempool_tx = {{ .Mempool.ExperimentalPublishEventMempoolTx }}
+
+# When using the Flood or Priority mempool type, enable the DOG gossip protocol to
 # reduce network bandwidth on transaction dissemination (for details, see
 # specs/mempool/gossip/).
 dog_protocol_enabled = {{ .Mempool.DOGProtocolEnabled }}
@@ -447,6 +583,12 @@ chunk_fetchers = "{{ .StateSync.ChunkFetchers }}"
 #   1) "v0" - the default block sync implementation
 version = "{{ .BlockSync.Version }}"
 
+# The number of downloaded blocks, ahead of the block being applied, whose
+# commit signatures are verified concurrently with the application of the
+# earlier blocks. Set to 0 to verify the signatures of each block only before
+# applying it.
+verify_ahead = {{ .BlockSync.VerifyAhead }}
+
 #######################################################
 ###         Consensus Configuration Options         ###
 #######################################################
@@ -471,6 +613,12 @@ timeout_commit = "{{ .Consensus.TimeoutCommit }}"
 # So, validators should stop the state machine, wait for some blocks, and then restart the state machine to avoid panic.
 double_sign_check_height = {{ .Consensus.DoubleSignCheckHeight }}
 
+# If true, a proposed block is executed with FinalizeBlock as soon as the node
+# prevotes it, while the validators vote on it, instead of once it is decided. If another block is decided, FinalizeBlock is
+# called again at the same height with that block: the application must then
+# discard the state resulting from the previous call.
+optimistic_execution = {{ .Consensus.OptimisticExecution }}
+
 # EmptyBlocks mode and possible interval between empty blocks
 create_empty_blocks = {{ .Consensus.CreateEmptyBlocks }}
 create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
@@ -480,6 +628,12 @@ peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_gossip_intraloop_sleep_duration = "{{ .Consensus.PeerGossipIntraloopSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# If true, the proposed block is gossiped to the peers which also enabled this
+# setting as a compact block: the hashes of its transactions, which the peers
+# take from their mempool, and the few transactions they are unlikely to have.
+# The peers only request the parts of the block they could not reconstruct.
+compact_blocks = {{ .Consensus.CompactBlocks }}
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -494,10 +648,10 @@ discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 # The representation of keys in the database.
 # The current representation of keys in Comet's stores is considered to be v1
 # Users can experiment with a different layout by setting this field to v2.
-# Note that this is an experimental feature and switching back from v2 to v1
-# is not supported by CometBFT.
+# Note that this is an experimental feature.
 # If the database was initially created with v1, it is necessary to migrate the DB
-# before switching to v2. The migration is not done automatically.
+# before switching to v2. The migration is not done automatically: stop the node
+# and run "cometbft migrate-key-layout", which also migrates back from v2 to v1.
 # v1 - the legacy layout existing in Comet prior to v1.
 # v2 - Order preserving representation ordering entries by height.
 experimental_db_key_layout = "{{ .Storage.ExperimentalKeyLayout }}"
@@ -560,7 +714,9 @@ initial_block_results_retain_height = {{ .Storage.Pruning.DataCompanion.InitialB
 #   2) "kv" (default) - the simplest possible indexer, backed by key-value storage (defaults to levelDB; see DBBackend).
 # 		- When "kv" is chosen "tx.height" and "tx.hash" will always be indexed.
 #   3) "psql" - the indexer services backed by PostgreSQL.
-# When "kv" or "psql" is chosen "tx.height" and "tx.hash" will always be indexed.
+#   4) "sqlite" - the indexer services backed by an embedded SQLite database,
+#      stored in tx_index.sqlite in the db_dir.
+# When "kv", "psql" or "sqlite" is chosen "tx.height" and "tx.hash" will always be indexed.
 indexer = "{{ .TxIndex.Indexer }}"
 
 # The PostgreSQL connection configuration, the connection format:
@@ -24,9 +24,11 @@ func TestDefaultConfig(t *testing.T) {
 	cfg.SetRoot("/foo")
 	cfg.Genesis = "bar"
 	cfg.DBPath = "/opt/data"
+	cfg.ABCIRecord = "data/abci.rec"
 
 	assert.Equal("/foo/bar", cfg.GenesisFile())
 	assert.Equal("/opt/data", cfg.DBDir())
+	assert.Equal("/foo/data/abci.rec", cfg.ABCIRecordFile())
 }
 
 func TestConfigValidateBasic(t *testing.T) {
@@ -68,6 +70,59 @@ func TestBaseConfigValidateBasic(t *testing.T) {
 	require.Error(t, cfg.ValidateBasic())
 }
 
+func TestBaseConfigSignStateStore_ValidateBasic(t *testing.T) {
+	cfg := config.TestBaseConfig()
+	cfg.PrivValidatorSignStateStore = config.SignStateStoreLog
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.PrivValidatorSignLog = ""
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg.PrivValidatorSignStateStore = "invalid"
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestBaseConfigPrivValidatorGRPC_ValidateBasic(t *testing.T) {
+	cfg := config.TestBaseConfig()
+	cfg.PrivValidatorGRPCAddr = "signer.example.com:26659"
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg.PrivValidatorClientCertificate = "client.crt"
+	cfg.PrivValidatorClientKey = "client.key"
+	cfg.PrivValidatorRootCA = "ca.crt"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.PrivValidatorListenAddr = "tcp://127.0.0.1:26659"
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestBaseConfigABCIQuery_ValidateBasic(t *testing.T) {
+	cfg := config.TestBaseConfig()
+	cfg.ABCIQueryConnections = 4
+	cfg.ABCIQueryDispatch = config.ABCIQueryDispatchLeastBusy
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.ABCIQueryDispatch = "invalid"
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg.ABCIQueryDispatch = config.ABCIQueryDispatchRoundRobin
+	cfg.ABCIQueryConnections = 0
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestBaseConfigABCIReconnect_ValidateBasic(t *testing.T) {
+	cfg := config.TestBaseConfig()
+	cfg.ABCIReconnect = true
+	// Built-in applications cannot be reconnected to.
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg.ProxyApp = "tcp://127.0.0.1:26658"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.ABCIReconnectMaxBackoff = 0
+	require.Error(t, cfg.ValidateBasic())
+}
+
 func TestBaseConfigProxyApp_ValidateBasic(t *testing.T) {
 	testcases := map[string]struct {
 		proxyApp  string
@@ -118,6 +173,42 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 	}
 }
 
+func TestRPCConfigRateLimits(t *testing.T) {
+	cfg := config.TestRPCConfig()
+	assert.False(t, cfg.IsRateLimitEnabled())
+
+	cfg.RateLimitPerIP = 10
+	cfg.RateLimitMethods = []string{"broadcast_tx_sync:2.5:5"}
+	require.NoError(t, cfg.ValidateBasic())
+	assert.True(t, cfg.IsRateLimitEnabled())
+
+	costs, err := cfg.RateLimitMethodCostsMap()
+	require.NoError(t, err)
+	assert.Equal(t, 10, costs["tx_search"])
+	methods, err := cfg.RateLimitMethodsMap()
+	require.NoError(t, err)
+	assert.Equal(t, config.RPCMethodRateLimit{Rate: 2.5, Burst: 5}, methods["broadcast_tx_sync"])
+
+	cfg.RateLimitPerIP = -1
+	require.Error(t, cfg.ValidateBasic())
+	cfg.RateLimitPerIP = 10
+
+	cfg.RateLimitPerIPBurst = 0
+	require.Error(t, cfg.ValidateBasic())
+	cfg.RateLimitPerIPBurst = 100
+
+	for _, costs := range [][]string{{"tx_search"}, {"tx_search:x"}, {"tx_search:-1"}} {
+		cfg.RateLimitMethodCosts = costs
+		require.Error(t, cfg.ValidateBasic(), costs)
+	}
+	cfg.RateLimitMethodCosts = nil
+
+	for _, methods := range [][]string{{"status:1"}, {"status:0:1"}, {"status:1:0"}, {"status:x:1"}} {
+		cfg.RateLimitMethods = methods
+		require.Error(t, cfg.ValidateBasic(), methods)
+	}
+}
+
 func TestP2PConfigValidateBasic(t *testing.T) {
 	cfg := config.TestP2PConfig()
 	require.NoError(t, cfg.ValidateBasic())
@@ -179,6 +270,17 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		}
 	}
 
+	// tracing transactions
+	setFieldTo("TxTraceSize", -1)
+	require.Error(t, cfg.ValidateBasic())
+	setFieldTo("TxTraceSize", 1)
+	require.NoError(t, cfg.ValidateBasic())
+	reflect.ValueOf(cfg).Elem().FieldByName("ExperimentalPublishEventMempoolTx").SetBool(true)
+	require.NoError(t, cfg.ValidateBasic())
+	setFieldTo("TxTraceSize", 0)
+	require.Error(t, cfg.ValidateBasic())
+	reflect.ValueOf(cfg).Elem().FieldByName("ExperimentalPublishEventMempoolTx").SetBool(false)
+
 	// with noop mempool, zero values are allowed for the fields below
 	reflect.ValueOf(cfg).Elem().FieldByName("Type").SetString(config.MempoolTypeNop)
 	fieldNames := []string{
@@ -192,6 +294,16 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		setFieldTo(name, 1) // reset
 	}
 
+	// the journal does not work with the nop mempool
+	reflect.ValueOf(cfg).Elem().FieldByName("Journal").SetBool(true)
+	require.Error(t, cfg.ValidateBasic())
+	reflect.ValueOf(cfg).Elem().FieldByName("Journal").SetBool(false)
+
+	// tracing transactions does not work with the nop mempool
+	setFieldTo("TxTraceSize", 1)
+	require.Error(t, cfg.ValidateBasic())
+	setFieldTo("TxTraceSize", 0)
+
 	// with DOG protocol only works with Flood and no MaxGossip feature.
 	reflect.ValueOf(cfg).Elem().FieldByName("DOGProtocolEnabled").SetBool(true)
 	require.Error(t, cfg.ValidateBasic())
@@ -216,6 +328,10 @@ func TestBlockSyncConfigValidateBasic(t *testing.T) {
 
 	cfg.Version = "invalid"
 	require.Error(t, cfg.ValidateBasic())
+
+	cfg = config.TestBlockSyncConfig()
+	cfg.VerifyAhead = -1
+	require.Error(t, cfg.ValidateBasic())
 }
 
 func TestConsensusConfig_ValidateBasic(t *testing.T) {
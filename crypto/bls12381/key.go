@@ -108,3 +108,17 @@ func (PubKey) Bytes() []byte {
 func (PubKey) Type() string {
 	return KeyType
 }
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// AggregateSignatures returns ErrDisabled.
+func AggregateSignatures([][]byte) ([]byte, error) {
+	return nil, ErrDisabled
+}
+
+// VerifyAggregateSignature always returns false.
+func VerifyAggregateSignature([]crypto.PubKey, [][]byte, []byte) bool {
+	return false
+}
@@ -26,6 +26,8 @@ var (
 	// ErrInfinitePubKey is returned when the public key is infinite. It is part
 	// of a more comprehensive subgroup check on the key.
 	ErrInfinitePubKey = errors.New("bls12381: pubkey is infinite")
+	// ErrNoSignatures is returned when there are no signatures to aggregate.
+	ErrNoSignatures = errors.New("bls12381: no signatures to aggregate")
 
 	dstMinPk = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
 )
@@ -37,8 +39,8 @@ var (
 type (
 	blstPublicKey          = blst.P1Affine
 	blstSignature          = blst.P2Affine
-	blstAggregateSignature = blst.P1Aggregate
-	blstAggregatePublicKey = blst.P2Aggregate
+	blstAggregateSignature = blst.P2Aggregate
+	blstAggregatePublicKey = blst.P1Aggregate
 )
 
 // -------------------------------------.
@@ -222,3 +224,61 @@ func (pubkey *PubKey) UnmarshalJSON(bz []byte) error {
 	pubkey.pk = pk.pk
 	return nil
 }
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// AggregateSignatures aggregates the given signatures into a single signature,
+// which can be verified with VerifyAggregateSignature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, ErrNoSignatures
+	}
+	agg := new(blstAggregateSignature)
+	if !agg.AggregateCompressed(sigs, true) {
+		return nil, ErrDeserialization
+	}
+	return agg.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies that sig aggregates a signature of msgs[i]
+// by pubKeys[i] for every i.
+//
+// The messages must be distinct, otherwise the verification fails. Without
+// proofs of possession of the keys, this is what protects against rogue key
+// attacks.
+func VerifyAggregateSignature(pubKeys []crypto.PubKey, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+
+	pks := make([]*blstPublicKey, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		switch pk := pubKey.(type) {
+		case PubKey:
+			pks[i] = pk.pk
+		case *PubKey:
+			pks[i] = pk.pk
+		default:
+			return false
+		}
+	}
+
+	blstMsgs := make([]blst.Message, len(msgs))
+	seen := make(map[string]struct{}, len(msgs))
+	for i, msg := range msgs {
+		if _, ok := seen[string(msg)]; ok {
+			return false
+		}
+		seen[string(msg)] = struct{}{}
+		blstMsgs[i] = msg
+	}
+
+	signature := new(blstSignature).Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+
+	return signature.AggregateVerify(true, pks, false, blstMsgs, dstMinPk)
+}
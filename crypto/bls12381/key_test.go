@@ -214,3 +214,42 @@ func TestPubKey_NewPublicKeyFromInvalidBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestAggregateSignatures(t *testing.T) {
+	const n = 4
+	pubKeys := make([]crypto.PubKey, n)
+	msgs := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		privKey, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		defer privKey.Zeroize()
+		pubKeys[i] = privKey.PubKey()
+		msgs[i] = crypto.CRandBytes(32)
+		sigs[i], err = privKey.Sign(msgs[i])
+		require.NoError(t, err)
+	}
+
+	aggSig, err := bls12381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	assert.Len(t, aggSig, bls12381.SignatureLength)
+	assert.True(t, bls12381.VerifyAggregateSignature(pubKeys, msgs, aggSig))
+
+	// A subset of the signers.
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys[1:], msgs[1:], aggSig))
+
+	// A different message.
+	otherMsgs := append([][]byte{crypto.CRandBytes(32)}, msgs[1:]...)
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys, otherMsgs, aggSig))
+
+	// The messages must be distinct.
+	sig, err := bls12381.AggregateSignatures([][]byte{sigs[0], sigs[0]})
+	require.NoError(t, err)
+	assert.False(t, bls12381.VerifyAggregateSignature(
+		[]crypto.PubKey{pubKeys[0], pubKeys[0]}, [][]byte{msgs[0], msgs[0]}, sig))
+
+	_, err = bls12381.AggregateSignatures(nil)
+	require.ErrorIs(t, err, bls12381.ErrNoSignatures)
+	_, err = bls12381.AggregateSignatures([][]byte{crypto.CRandBytes(bls12381.SignatureLength)})
+	require.ErrorIs(t, err, bls12381.ErrDeserialization)
+}
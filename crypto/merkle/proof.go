@@ -74,6 +74,24 @@ func ProofsFromByteSlices(items [][]byte) (rootHash []byte, proofs []*Proof) {
 	return rootHash, proofs
 }
 
+// ProofsFromLeafHashes computes inclusion proofs for the items with the given
+// leaf hashes, without the items themselves.
+// proofs[0] is the proof for the item with leafHashes[0].
+func ProofsFromLeafHashes(leafHashes [][]byte) (rootHash []byte, proofs []*Proof) {
+	trails, rootSPN := trailsFromLeafHashes(tmhash.New(), leafHashes)
+	rootHash = rootSPN.Hash
+	proofs = make([]*Proof, len(leafHashes))
+	for i, trail := range trails {
+		proofs[i] = &Proof{
+			Total:    int64(len(leafHashes)),
+			Index:    int64(i),
+			LeafHash: trail.Hash,
+			Aunts:    trail.FlattenAunts(),
+		}
+	}
+	return rootHash, proofs
+}
+
 // Verify that the Proof proves the root hash.
 // Check sp.Index/sp.Total manually if needed.
 func (sp *Proof) Verify(rootHash []byte, leaf []byte) error {
@@ -298,3 +316,26 @@ func trailsFromByteSlicesInternal(hash hash.Hash, items [][]byte) (trails []*Pro
 		return append(lefts, rights...), root
 	}
 }
+
+// trailsFromLeafHashes is like trailsFromByteSlicesInternal, for the items
+// with the given leaf hashes.
+func trailsFromLeafHashes(hash hash.Hash, leafHashes [][]byte) (trails []*ProofNode, root *ProofNode) {
+	switch len(leafHashes) {
+	case 0:
+		return []*ProofNode{}, &ProofNode{emptyHash(), nil, nil, nil}
+	case 1:
+		trail := &ProofNode{leafHashes[0], nil, nil, nil}
+		return []*ProofNode{trail}, trail
+	default:
+		k := getSplitPoint(int64(len(leafHashes)))
+		lefts, leftRoot := trailsFromLeafHashes(hash, leafHashes[:k])
+		rights, rightRoot := trailsFromLeafHashes(hash, leafHashes[k:])
+		rootHash := innerHashOpt(hash, leftRoot.Hash, rightRoot.Hash)
+		root := &ProofNode{rootHash, nil, nil, nil}
+		leftRoot.Parent = root
+		leftRoot.Right = rightRoot
+		rightRoot.Parent = root
+		rightRoot.Left = leftRoot
+		return append(lefts, rights...), root
+	}
+}
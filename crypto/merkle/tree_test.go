@@ -98,6 +98,24 @@ func TestProof(t *testing.T) {
 	}
 }
 
+func TestProofsFromLeafHashes(t *testing.T) {
+	for _, total := range []int{0, 1, 2, 7, 100} {
+		items := make([][]byte, total)
+		for i := range items {
+			items[i] = cmtrand.Bytes(tmhash.Size)
+		}
+		rootHash, proofs := ProofsFromByteSlices(items)
+
+		leafHashes := make([][]byte, total)
+		for i, proof := range proofs {
+			leafHashes[i] = proof.LeafHash
+		}
+		rootHash2, proofs2 := ProofsFromLeafHashes(leafHashes)
+		require.Equal(t, rootHash, rootHash2)
+		require.Equal(t, proofs, proofs2)
+	}
+}
+
 func TestHashAlternatives(t *testing.T) {
 	total := 100
 
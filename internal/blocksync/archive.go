@@ -0,0 +1,160 @@
+package blocksync
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	sm "github.com/cometbft/cometbft/v2/state"
+	"github.com/cometbft/cometbft/v2/store"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// A block archive holds consecutive blocks, along with what is needed to
+// verify them, to sync from instead of from peers (see SyncFromArchive). It is
+// a block store export, as written by `cometbft blockstore export` (see
+// store.Export).
+
+// SyncFromArchive syncs the node from the block archive at archivePath
+// instead of from peers: the blocks of the archive following state are
+// verified, saved to store and applied, exactly as the Reactor does with the
+// blocks received from peers. The last block of the archive is not applied,
+// since the commit of the next block is needed to verify it.
+//
+// It returns the state after the last applied block, which is also returned
+// along with an error if a block cannot be verified.
+func SyncFromArchive(
+	state sm.State,
+	blockExec *sm.BlockExecutor,
+	store sm.BlockStore,
+	archivePath string,
+	logger log.Logger,
+) (sm.State, error) {
+	// If state sync was performed offline, the store is empty.
+	if storeHeight := store.Height(); storeHeight != 0 && storeHeight != state.LastBlockHeight {
+		return state, fmt.Errorf("state (%v) and store (%v) height mismatch, stores were left in an inconsistent state",
+			state.LastBlockHeight, storeHeight)
+	}
+	startHeight := state.LastBlockHeight + 1
+	if startHeight == 1 {
+		startHeight = state.InitialHeight
+	}
+
+	r, err := openArchiveExport(archivePath, startHeight)
+	if err != nil {
+		return state, err
+	}
+	defer r.Close()
+
+	first, firstExtCommit, err := r.next()
+	if errors.Is(err, io.EOF) {
+		logger.Info("No block to sync in the archive", "height", startHeight)
+		return state, nil
+	} else if err != nil {
+		return state, err
+	}
+	for {
+		second, secondExtCommit, err := r.next()
+		if errors.Is(err, io.EOF) {
+			logger.Info("Synced from the block archive", "height", state.LastBlockHeight)
+			return state, nil
+		} else if err != nil {
+			return state, err
+		}
+
+		firstParts, err := first.MakePartSet(types.BlockPartSizeBytes)
+		if err != nil {
+			return state, err
+		}
+		firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstParts.Header()}
+		if err := verifyBlock(state, blockExec, first, firstID, second, firstExtCommit, nil); err != nil {
+			return state, fmt.Errorf("invalid block at height %d: %w", first.Height, err)
+		}
+		saveBlock(state, store, first, firstParts, second, firstExtCommit)
+		state, err = blockExec.ApplyVerifiedBlock(state, firstID, first, r.maxHeight())
+		if err != nil {
+			return state, fmt.Errorf("failed to process committed block (%d:%X): %w", first.Height, first.Hash(), err)
+		}
+
+		if first.Height%100 == 0 {
+			logger.Info("Syncing from the block archive", "height", first.Height, "max_height", r.maxHeight())
+		}
+		first, firstExtCommit = second, secondExtCommit
+	}
+}
+
+// decodeArchiveBlock decodes the block at height and its extended commit, if
+// any.
+func decodeArchiveBlock(
+	height int64,
+	pbBlock *cmtproto.Block,
+	pbExtCommit *cmtproto.ExtendedCommit,
+) (*types.Block, *types.ExtendedCommit, error) {
+	block, err := types.BlockFromProto(pbBlock)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid block at height %d: %w", height, err)
+	}
+	if block.Height != height {
+		return nil, nil, fmt.Errorf("the block archive has the block at height %d instead of %d", block.Height, height)
+	}
+	var extCommit *types.ExtendedCommit
+	if pbExtCommit != nil {
+		extCommit, err = types.ExtendedCommitFromProto(pbExtCommit)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid extended commit at height %d: %w", height, err)
+		}
+	}
+	return block, extCommit, nil
+}
+
+// archiveExport reads the consecutive blocks of a block store export.
+type archiveExport struct {
+	file   *os.File
+	r      *store.ExportReader
+	height int64 // of the next block
+}
+
+func openArchiveExport(archivePath string, startHeight int64) (*archiveExport, error) {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	r, err := store.NewExportReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &archiveExport{file: file, r: r, height: startHeight}, nil
+}
+
+// next returns the next block and its extended commit, or io.EOF if there are
+// no more blocks.
+func (a *archiveExport) next() (*types.Block, *types.ExtendedCommit, error) {
+	height := a.height
+	if height < a.r.Header().FromHeight {
+		return nil, nil, fmt.Errorf("the block archive does not have the block at height %d", height)
+	}
+	for {
+		exported, err := a.r.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		if exported.Block != nil && exported.Block.Header.Height < height {
+			continue
+		}
+		a.height++
+		return decodeArchiveBlock(height, exported.Block, exported.ExtendedCommit)
+	}
+}
+
+// maxHeight returns the highest height of the archive.
+func (a *archiveExport) maxHeight() int64 {
+	return a.r.Header().ToHeight
+}
+
+func (a *archiveExport) Close() error {
+	return a.file.Close()
+}
@@ -0,0 +1,109 @@
+package blocksync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/v2/internal/test"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	"github.com/cometbft/cometbft/v2/store"
+)
+
+// writeTestExport writes the heights fromHeight to toHeight of the stores of
+// r to a block store export.
+func writeTestExport(t *testing.T, r *Reactor, fromHeight, toHeight int64) string {
+	t.Helper()
+	exportPath := filepath.Join(t.TempDir(), "blocks.export")
+	f, err := os.Create(exportPath)
+	require.NoError(t, err)
+	defer f.Close()
+	bs, ok := r.store.(*store.BlockStore)
+	require.True(t, ok)
+	require.NoError(t, store.Export(f, bs, r.blockExec.Store(), test.DefaultTestChainID, fromHeight, toHeight))
+	return exportPath
+}
+
+func TestSyncFromArchive(t *testing.T) {
+	config = test.ResetTestRoot("blocksync_archive_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc()
+
+	maxBlockHeight := int64(20)
+	source := newReactor(t, log.TestingLogger(), genDoc, privVals, maxBlockHeight)
+	defer func() { require.NoError(t, source.app.Stop()) }()
+	archivePath := writeTestExport(t, source.reactor.Reactor, 1, maxBlockHeight)
+
+	target := newReactor(t, log.TestingLogger(), genDoc, privVals, 0)
+	defer func() { require.NoError(t, target.app.Stop()) }()
+
+	// The last block cannot be verified.
+	state, err := SyncFromArchive(target.reactor.initialState, target.reactor.blockExec, target.reactor.store, archivePath, log.TestingLogger())
+	require.NoError(t, err)
+	assert.Equal(t, maxBlockHeight-1, state.LastBlockHeight)
+	assert.Equal(t, maxBlockHeight-1, target.reactor.store.Height())
+	for height := int64(1); height < maxBlockHeight; height++ {
+		assert.Equal(t, source.reactor.store.LoadBlockMeta(height).BlockID, target.reactor.store.LoadBlockMeta(height).BlockID)
+	}
+
+	// Syncing again is a no-op.
+	state, err = SyncFromArchive(state, target.reactor.blockExec, target.reactor.store, archivePath, log.TestingLogger())
+	require.NoError(t, err)
+	assert.Equal(t, maxBlockHeight-1, state.LastBlockHeight)
+}
+
+func TestSyncFromArchiveInvalid(t *testing.T) {
+	config = test.ResetTestRoot("blocksync_archive_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc()
+
+	maxBlockHeight := int64(10)
+	source := newReactor(t, log.TestingLogger(), genDoc, privVals, maxBlockHeight)
+	defer func() { require.NoError(t, source.app.Stop()) }()
+
+	// Blocks of another chain cannot be verified.
+	otherGenDoc, otherPrivVals := randGenesisDoc()
+	other := newReactor(t, log.TestingLogger(), otherGenDoc, otherPrivVals, maxBlockHeight)
+	defer func() { require.NoError(t, other.app.Stop()) }()
+
+	// garble flips a byte at the middle of the export at archivePath.
+	garble := func(t *testing.T, archivePath string) string {
+		t.Helper()
+		bz, err := os.ReadFile(archivePath)
+		require.NoError(t, err)
+		bz[len(bz)/2] ^= 0xff
+		require.NoError(t, os.WriteFile(archivePath, bz, 0o600))
+		return archivePath
+	}
+
+	testCases := map[string]func(t *testing.T) string{
+		"missing blocks": func(t *testing.T) string {
+			t.Helper()
+			return writeTestExport(t, source.reactor.Reactor, 5, maxBlockHeight)
+		},
+		"other chain": func(t *testing.T) string {
+			t.Helper()
+			return writeTestExport(t, other.reactor.Reactor, 1, maxBlockHeight)
+		},
+		"garbled export": func(t *testing.T) string {
+			t.Helper()
+			return garble(t, writeTestExport(t, source.reactor.Reactor, 1, maxBlockHeight))
+		},
+	}
+	for name, makeArchive := range testCases {
+		t.Run(name, func(t *testing.T) {
+			archivePath := makeArchive(t)
+
+			target := newReactor(t, log.TestingLogger(), genDoc, privVals, 0)
+			defer func() { require.NoError(t, target.app.Stop()) }()
+			state, err := SyncFromArchive(target.reactor.initialState, target.reactor.blockExec, target.reactor.store, archivePath, log.TestingLogger())
+			require.Error(t, err)
+			// The blocks before the invalid one are applied.
+			assert.Less(t, state.LastBlockHeight, maxBlockHeight-1)
+			assert.Equal(t, state.LastBlockHeight, target.reactor.store.Height())
+		})
+	}
+}
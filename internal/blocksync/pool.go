@@ -229,6 +229,21 @@ func (pool *BlockPool) PeekTwoBlocks() (first, second *types.Block, firstExtComm
 	return first, second, firstExtCommit
 }
 
+// PeekBlocks returns the blocks at pool.height up to pool.height+n-1. The
+// block at pool.height+i is at index i, and is nil if it was not received yet.
+func (pool *BlockPool) PeekBlocks(n int) []*types.Block {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+
+	blocks := make([]*types.Block, n)
+	for i := range blocks {
+		if r := pool.requesters[pool.height+int64(i)]; r != nil {
+			blocks[i] = r.getBlock()
+		}
+	}
+	return blocks
+}
+
 // PopRequest removes the requester at pool.height and increments pool.height.
 func (pool *BlockPool) PopRequest() {
 	pool.mtx.Lock()
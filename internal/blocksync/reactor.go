@@ -71,12 +71,27 @@ type Reactor struct {
 
 	switchToConsensusMs int
 
+	// The number of blocks ahead of the block being applied whose commits are
+	// verified concurrently.
+	verifyAhead int
+
 	metrics *Metrics
 }
 
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
+// WithVerifyAhead sets the number of downloaded blocks, ahead of the block
+// being applied, whose commit signatures are verified concurrently. 0 (the
+// default) disables it.
+func WithVerifyAhead(n int) ReactorOption {
+	return func(bcR *Reactor) { bcR.verifyAhead = n }
+}
+
 // NewReactor returns new reactor instance.
 func NewReactor(state sm.State, blockExec *sm.BlockExecutor, store *store.BlockStore,
 	blockSync bool, localAddr crypto.Address, metrics *Metrics, offlineStateSyncHeight int64,
+	options ...ReactorOption,
 ) *Reactor {
 	storeHeight := store.Height()
 	if storeHeight == 0 {
@@ -118,6 +133,9 @@ func NewReactor(state sm.State, blockExec *sm.BlockExecutor, store *store.BlockS
 		metrics:      metrics,
 	}
 	bcR.BaseReactor = *p2p.NewBaseReactor("Reactor", bcR)
+	for _, option := range options {
+		option(bcR)
+	}
 	return bcR
 }
 
@@ -325,6 +343,13 @@ func (bcR *Reactor) poolRoutine(stateSynced bool) {
 
 	go bcR.handleBlockRequestsRoutine()
 
+	var verifier *commitVerifier
+	if bcR.verifyAhead > 0 {
+		quit := make(chan struct{})
+		defer close(quit)
+		verifier = newCommitVerifier(state.ChainID, bcR.verifyAhead, quit)
+	}
+
 	if bcR.switchToConsensusMs == 0 {
 		bcR.switchToConsensusMs = switchToConsensusIntervalSeconds * 1000
 	}
@@ -369,6 +394,11 @@ FOR_LOOP:
 			// coupling them as it's written here.  TODO uncouple from request
 			// routine.
 
+			// Verify the commits of the next blocks while this one is applied.
+			if verifier != nil {
+				verifier.schedule(bcR.pool.PeekBlocks(bcR.verifyAhead+1), state.Validators, state.NextValidators)
+			}
+
 			// See if there are any blocks to sync.
 			first, second, extCommit := bcR.pool.PeekTwoBlocks()
 			if first == nil || second == nil {
@@ -404,7 +434,11 @@ FOR_LOOP:
 				break FOR_LOOP
 			}
 
-			if state, err = bcR.processBlock(first, second, firstParts, state, extCommit); err != nil {
+			var sigCache types.SignatureCache
+			if verifier != nil {
+				sigCache = verifier.take(first.Height, second.LastCommit)
+			}
+			if state, err = bcR.processBlock(first, second, firstParts, state, extCommit, sigCache); err != nil {
 				bcR.Logger.Error("Invalid block", "height", first.Height, "err", err)
 				continue FOR_LOOP
 			}
@@ -532,39 +566,19 @@ func (bcR *Reactor) isCaughtUp(state sm.State, blocksSynced uint64, stateSynced
 	return false
 }
 
-func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.PartSet, state sm.State, extCommit *types.ExtendedCommit) (sm.State, error) {
-	var (
-		chainID            = bcR.initialState.ChainID
-		firstPartSetHeader = firstParts.Header()
-		firstID            = types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
-	)
-
-	// Finally, verify the first block using the second's commit
-	// NOTE: we can probably make this more efficient, but note that calling
-	// first.Hash() doesn't verify the tx contents, so MakePartSet() is
-	// currently necessary.
-	// TODO(sergio): Should we also validate against the extended commit?
-	err := state.Validators.VerifyCommitLight(
-		chainID, firstID, first.Height, second.LastCommit)
-
-	if err == nil {
-		// validate the block before we persist it
-		err = bcR.blockExec.ValidateBlock(state, first)
-	}
-
-	presentExtCommit := extCommit != nil
-	extensionsEnabled := state.ConsensusParams.Feature.VoteExtensionsEnabled(first.Height)
-	if presentExtCommit != extensionsEnabled {
-		err = fmt.Errorf("non-nil extended commit must be received iff vote extensions are enabled for its height "+
-			"(height %d, non-nil extended commit %t, extensions enabled %t)",
-			first.Height, presentExtCommit, extensionsEnabled,
-		)
-	}
-	if err == nil && extensionsEnabled {
-		// if vote extensions were required at this height, ensure they exist.
-		err = extCommit.EnsureExtensions(true)
-	}
-
+// processBlock verifies first using the LastCommit of second, then applies it.
+// The signatures recorded in sigCache, which may be nil, are not verified
+// again.
+func (bcR *Reactor) processBlock(
+	first, second *types.Block,
+	firstParts *types.PartSet,
+	state sm.State,
+	extCommit *types.ExtendedCommit,
+	sigCache types.SignatureCache,
+) (sm.State, error) {
+	firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstParts.Header()}
+
+	err := verifyBlock(state, bcR.blockExec, first, firstID, second, extCommit, sigCache)
 	if err != nil {
 		peerID := bcR.pool.RemovePeerAndRedoAllPeerRequests(first.Height)
 		peer := bcR.Switch.Peers().Get(peerID)
@@ -586,16 +600,7 @@ func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.P
 	// SUCCESS. Pop the block from the pool.
 	bcR.pool.PopRequest()
 
-	// TODO: batch saves so we dont persist to disk every block
-	if extensionsEnabled {
-		bcR.store.SaveBlockWithExtendedCommit(first, firstParts, extCommit)
-	} else {
-		// We use LastCommit here instead of extCommit. extCommit is not
-		// guaranteed to be populated by the peer if extensions are not enabled.
-		// Currently, the peer should provide an extCommit even if the vote extension data are absent
-		// but this may change so using second.LastCommit is safer.
-		bcR.store.SaveBlock(first, firstParts, second.LastCommit)
-	}
+	saveBlock(state, bcR.store, first, firstParts, second, extCommit)
 
 	// TODO: same thing for app - but we would need a way to
 	// get the hash without persisting the state
@@ -609,3 +614,64 @@ func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.P
 
 	return state, nil
 }
+
+// verifyBlock verifies first, identified by firstID, using the LastCommit of
+// second, and checks that extCommit, the extended commit of first, is present
+// iff vote extensions are enabled at its height.
+func verifyBlock(
+	state sm.State,
+	blockExec *sm.BlockExecutor,
+	first *types.Block,
+	firstID types.BlockID,
+	second *types.Block,
+	extCommit *types.ExtendedCommit,
+	sigCache types.SignatureCache,
+) error {
+	// Finally, verify the first block using the second's commit
+	// NOTE: we can probably make this more efficient, but note that calling
+	// first.Hash() doesn't verify the tx contents, so MakePartSet() is
+	// currently necessary.
+	// TODO(sergio): Should we also validate against the extended commit?
+	err := state.Validators.VerifyCommitLightWithCache(
+		state.ChainID, firstID, first.Height, second.LastCommit, sigCache)
+
+	if err == nil {
+		// validate the block before we persist it
+		err = blockExec.ValidateBlock(state, first)
+	}
+
+	presentExtCommit := extCommit != nil
+	extensionsEnabled := state.ConsensusParams.Feature.VoteExtensionsEnabled(first.Height)
+	if presentExtCommit != extensionsEnabled {
+		err = fmt.Errorf("non-nil extended commit must be received iff vote extensions are enabled for its height "+
+			"(height %d, non-nil extended commit %t, extensions enabled %t)",
+			first.Height, presentExtCommit, extensionsEnabled,
+		)
+	}
+	if err == nil && extensionsEnabled {
+		// if vote extensions were required at this height, ensure they exist.
+		err = extCommit.EnsureExtensions(true)
+	}
+	return err
+}
+
+// saveBlock saves first, verified by verifyBlock, to store.
+func saveBlock(
+	state sm.State,
+	store sm.BlockStore,
+	first *types.Block,
+	firstParts *types.PartSet,
+	second *types.Block,
+	extCommit *types.ExtendedCommit,
+) {
+	// TODO: batch saves so we dont persist to disk every block
+	if state.ConsensusParams.Feature.VoteExtensionsEnabled(first.Height) {
+		store.SaveBlockWithExtendedCommit(first, firstParts, extCommit)
+	} else {
+		// We use LastCommit here instead of extCommit. extCommit is not
+		// guaranteed to be populated by the peer if extensions are not enabled.
+		// Currently, the peer should provide an extCommit even if the vote extension data are absent
+		// but this may change so using second.LastCommit is safer.
+		store.SaveBlock(first, firstParts, second.LastCommit)
+	}
+}
@@ -0,0 +1,146 @@
+package blocksync
+
+import (
+	"bytes"
+	"runtime"
+
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// commitVerifier verifies the signatures of the commits of the blocks
+// downloaded ahead of the block being applied, concurrently with its
+// application. The signatures verified for a height are recorded in a
+// signature cache, which processBlock passes to VerifyCommitLightWithCache so
+// that only the signatures missing from the cache are verified again.
+//
+// This is only an optimization: the commit of every block is still verified
+// against the validator set of its height before the block is applied, and a
+// signature is only skipped if the cache records that it was verified for the
+// same validator and sign bytes.
+type commitVerifier struct {
+	chainID string
+	jobs    chan *verifyJob
+	quit    <-chan struct{}
+
+	mtx     cmtsync.Mutex
+	pending map[int64]*verifyJob // by height
+}
+
+// verifyJob is the verification of the commit of the block at height, i.e.
+// the LastCommit of the block at height+1.
+type verifyJob struct {
+	height int64
+	commit *types.Commit
+	vals   *types.ValidatorSet
+	cache  types.SignatureCache
+	done   chan struct{}
+}
+
+// newCommitVerifier returns a commitVerifier verifying up to maxPending
+// commits ahead of time, using as many workers as there are CPUs. The workers
+// stop when quit is closed.
+func newCommitVerifier(chainID string, maxPending int, quit <-chan struct{}) *commitVerifier {
+	v := &commitVerifier{
+		chainID: chainID,
+		jobs:    make(chan *verifyJob, maxPending),
+		quit:    quit,
+		pending: make(map[int64]*verifyJob, maxPending),
+	}
+	for i := 0; i < runtime.GOMAXPROCS(0); i++ {
+		go v.verifyRoutine()
+	}
+	return v
+}
+
+func (v *commitVerifier) verifyRoutine() {
+	for {
+		select {
+		case <-v.quit:
+			return
+		case job := <-v.jobs:
+			// An invalid commit is reported by processBlock, which verifies it
+			// again.
+			_ = job.vals.VerifyCommitLightWithCache(v.chainID, job.commit.BlockID, job.height, job.commit, job.cache)
+			close(job.done)
+		}
+	}
+}
+
+// schedule schedules the verification of the commits of the given
+// consecutive blocks, as returned by BlockPool.PeekBlocks. The commit of a
+// block is verified with the first of vals whose hash is the validators hash
+// of the block. The commits of the blocks whose validator set is unknown yet
+// are scheduled by a later call, once the state has caught up.
+func (v *commitVerifier) schedule(blocks []*types.Block, vals ...*types.ValidatorSet) {
+	valsHashes := make([][]byte, len(vals))
+	for i, vs := range vals {
+		valsHashes[i] = vs.Hash()
+	}
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	for i := 0; i+1 < len(blocks); i++ {
+		block, next := blocks[i], blocks[i+1]
+		if block == nil || next == nil || next.LastCommit == nil {
+			continue
+		}
+		// A block replaced after its peer was removed has a new commit.
+		if job, ok := v.pending[block.Height]; ok && job.commit == next.LastCommit {
+			continue
+		}
+		var blockVals *types.ValidatorSet
+		for j, hash := range valsHashes {
+			if bytes.Equal(hash, block.ValidatorsHash) {
+				blockVals = vals[j]
+				break
+			}
+		}
+		if blockVals == nil {
+			continue
+		}
+
+		job := &verifyJob{
+			height: block.Height,
+			commit: next.LastCommit,
+			// Each job has its own copy, as the validator set is not safe for
+			// concurrent use.
+			vals:  blockVals.Copy(),
+			cache: types.NewSignatureCache(),
+			done:  make(chan struct{}),
+		}
+		select {
+		case v.jobs <- job:
+			v.pending[block.Height] = job
+		default:
+			// The workers are busy, try again later.
+			return
+		}
+	}
+}
+
+// take returns the signature cache of the verification of commit, the commit
+// of the block at height, and forgets about the verifications up to height.
+// It waits for the verification to complete if it is in progress. It returns
+// nil if commit was not scheduled.
+func (v *commitVerifier) take(height int64, commit *types.Commit) types.SignatureCache {
+	v.mtx.Lock()
+	job := v.pending[height]
+	for h := range v.pending {
+		if h <= height {
+			delete(v.pending, h)
+		}
+	}
+	v.mtx.Unlock()
+
+	if job == nil || job.commit != commit {
+		return nil
+	}
+	select {
+	case <-job.done:
+		return job.cache
+	case <-v.quit:
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package blocksync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/v2/internal/test"
+	"github.com/cometbft/cometbft/v2/types"
+	cmttime "github.com/cometbft/cometbft/v2/types/time"
+)
+
+// makeVerifierTestBlocks returns numBlocks consecutive blocks from height 1,
+// the LastCommit of each block being signed by privVals.
+func makeVerifierTestBlocks(t *testing.T, numBlocks int, vals *types.ValidatorSet, privVals []types.PrivValidator) []*types.Block {
+	t.Helper()
+	blocks := make([]*types.Block, numBlocks)
+	var lastCommit *types.Commit
+	for i := range blocks {
+		height := int64(i + 1)
+		block := types.MakeBlock(height, nil, lastCommit, nil)
+		block.ChainID = test.DefaultTestChainID
+		block.ValidatorsHash = vals.Hash()
+		blocks[i] = block
+
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		lastCommit, err = test.MakeCommit(blockID, height, 0, vals, privVals, test.DefaultTestChainID, cmttime.Now())
+		require.NoError(t, err)
+	}
+	return blocks
+}
+
+func TestCommitVerifier(t *testing.T) {
+	vals, privVals := types.RandValidatorSet(4, 10)
+	otherVals, _ := types.RandValidatorSet(4, 10)
+	blocks := makeVerifierTestBlocks(t, 5, vals, privVals)
+
+	quit := make(chan struct{})
+	defer close(quit)
+	v := newCommitVerifier(test.DefaultTestChainID, 8, quit)
+
+	// Commits with an unknown validator set are not verified.
+	v.schedule(blocks, otherVals)
+	assert.Nil(t, v.take(1, blocks[1].LastCommit))
+
+	// The last block has no next block to take its commit from.
+	v.schedule(blocks, otherVals, vals)
+	for i, block := range blocks[:len(blocks)-1] {
+		commit := blocks[i+1].LastCommit
+		cache := v.take(block.Height, commit)
+		require.NotNil(t, cache)
+		assert.Positive(t, cache.Len())
+
+		// The cache can only be used to verify the same commit.
+		parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		require.NoError(t, vals.VerifyCommitLightWithCache(test.DefaultTestChainID, blockID, block.Height, commit, cache))
+		require.Error(t, otherVals.VerifyCommitLightWithCache(test.DefaultTestChainID, blockID, block.Height, commit, cache))
+	}
+	assert.Nil(t, v.take(int64(len(blocks)), nil))
+
+	// Taking a height forgets about the lower ones.
+	v.schedule(blocks, vals)
+	require.NotNil(t, v.take(3, blocks[3].LastCommit))
+	assert.Nil(t, v.take(2, blocks[2].LastCommit))
+
+	// The cache of a replaced commit is not returned.
+	v.schedule(blocks, vals)
+	assert.Nil(t, v.take(1, blocks[2].LastCommit))
+}
+
+func TestCommitVerifierInvalidCommit(t *testing.T) {
+	vals, privVals := types.RandValidatorSet(4, 10)
+	blocks := makeVerifierTestBlocks(t, 2, vals, privVals)
+	commit := blocks[1].LastCommit
+	for i := range commit.Signatures {
+		commit.Signatures[i].Signature[0] ^= 0xff
+	}
+
+	quit := make(chan struct{})
+	defer close(quit)
+	v := newCommitVerifier(test.DefaultTestChainID, 8, quit)
+	v.schedule(blocks, vals)
+	cache := v.take(1, commit)
+	require.NotNil(t, cache)
+
+	parts, err := blocks[0].MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: blocks[0].Hash(), PartSetHeader: parts.Header()}
+	require.Error(t, vals.VerifyCommitLightWithCache(test.DefaultTestChainID, blockID, 1, commit, cache))
+}
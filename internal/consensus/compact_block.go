@@ -0,0 +1,132 @@
+package consensus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/v2/crypto/merkle"
+	"github.com/cometbft/cometbft/v2/internal/bits"
+	cmtmath "github.com/cometbft/cometbft/v2/libs/math"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// errCompactBlockTooBig is returned by makeCompactBlock if the transactions to
+// include along with the block do not fit in a message.
+var errCompactBlockTooBig = errors.New("compact block too big")
+
+// txFetcher looks up transactions by hash, usually in the mempool.
+type txFetcher interface {
+	GetTxByHash(hash []byte) types.Tx
+}
+
+// makeCompactBlock returns the compact block of the proposal block, made of
+// the given parts, for the given height and round. The transactions missing
+// from txs are included, as the peers are unlikely to have them either. It
+// returns errCompactBlockTooBig if the message would exceed maxMsgSize, in which
+// case the parts of the block should be gossiped instead.
+func makeCompactBlock(
+	height int64,
+	round int32,
+	block *types.Block,
+	parts *types.PartSet,
+	txs txFetcher,
+) (*CompactBlockMessage, error) {
+	pb, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	pb.Data.Txs = nil
+
+	msg := &CompactBlockMessage{
+		Height:             height,
+		Round:              round,
+		BlockPartSetHeader: parts.Header(),
+		Block:              pb,
+		TxHashes:           make([][]byte, len(block.Txs)),
+		TxSizes:            make([]uint32, len(block.Txs)),
+		Txs:                make(map[uint32]types.Tx),
+		PartHashes:         make([][]byte, parts.Total()),
+	}
+	inlineSize := 0
+	for i, tx := range block.Txs {
+		hash := tx.Hash()
+		msg.TxHashes[i] = hash
+		msg.TxSizes[i] = uint32(len(tx))
+		if txs.GetTxByHash(hash) == nil {
+			msg.Txs[uint32(i)] = tx
+			inlineSize += len(tx)
+			if inlineSize > maxMsgSize {
+				return nil, errCompactBlockTooBig
+			}
+		}
+	}
+	for i := range msg.PartHashes {
+		msg.PartHashes[i] = parts.GetPart(i).Proof.LeafHash
+	}
+
+	wrapped, err := MsgToWrappedProto(msg)
+	if err != nil {
+		return nil, err
+	}
+	if wrapped.Size() > maxMsgSize {
+		return nil, errCompactBlockTooBig
+	}
+	return msg, nil
+}
+
+// reconstructParts rebuilds the parts of the block of the compact block from
+// its transactions and the ones found in txs. It returns the parts it rebuilt,
+// with their proofs, and the indexes of the parts which could not be rebuilt
+// because they contain unknown transactions.
+func (m *CompactBlockMessage) reconstructParts(txs txFetcher) ([]*types.Part, *bits.BitArray, error) {
+	root, proofs := merkle.ProofsFromLeafHashes(m.PartHashes)
+	if !bytes.Equal(root, m.BlockPartSetHeader.Hash) {
+		return nil, nil, errors.New("part hashes do not match the block part set header")
+	}
+
+	// The unknown transactions are replaced by as many zeros, for the parts
+	// which do not contain them to be rebuilt nevertheless.
+	blockTxs := make([][]byte, len(m.TxHashes))
+	for i, hash := range m.TxHashes {
+		tx, ok := m.Txs[uint32(i)]
+		if !ok {
+			tx = txs.GetTxByHash(hash)
+		}
+		switch {
+		case tx == nil:
+			blockTxs[i] = make([]byte, m.TxSizes[i])
+		case !bytes.Equal(tx.Hash(), hash):
+			return nil, nil, fmt.Errorf("transaction #%d does not match its hash", i)
+		default:
+			blockTxs[i] = tx
+		}
+	}
+	pb := *m.Block
+	pb.Data.Txs = blockTxs
+	bz, err := pb.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	partSize := int(types.BlockPartSizeBytes)
+	total := int(m.BlockPartSetHeader.Total)
+	if (len(bz)+partSize-1)/partSize != total {
+		return nil, nil, fmt.Errorf("block of %d bytes does not have %d parts", len(bz), total)
+	}
+	parts := make([]*types.Part, 0, total)
+	missing := bits.NewBitArray(total)
+	for i := 0; i < total; i++ {
+		part := &types.Part{
+			Index: uint32(i),
+			Bytes: bz[i*partSize : cmtmath.MinInt(len(bz), (i+1)*partSize)],
+			Proof: *proofs[i],
+		}
+		if part.Proof.Verify(root, part.Bytes) != nil {
+			missing.SetIndex(i, true)
+			continue
+		}
+		parts = append(parts, part)
+	}
+	return parts, missing, nil
+}
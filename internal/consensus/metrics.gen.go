@@ -136,6 +136,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "duplicate_block_part",
 			Help:      "Number of times we received a duplicate block part",
 		}, labels).With(labelsAndValues...),
+		CompactBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_blocks",
+			Help:      "Number of compact blocks received, by whether all the parts of the block were reconstructed from them (\"complete\") or some had to be requested (\"partial\").",
+		}, append(labels, "result")).With(labelsAndValues...),
 		DuplicateVote: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -231,6 +237,7 @@ func NopMetrics() *Metrics {
 		CommittedHeight:             discard.NewGauge(),
 		BlockParts:                  discard.NewCounter(),
 		DuplicateBlockPart:          discard.NewCounter(),
+		CompactBlocks:               discard.NewCounter(),
 		DuplicateVote:               discard.NewCounter(),
 		StepDurationSeconds:         discard.NewHistogram(),
 		BlockGossipPartsReceived:    discard.NewCounter(),
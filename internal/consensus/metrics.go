@@ -69,6 +69,11 @@ type Metrics struct {
 	// Number of times we received a duplicate block part
 	DuplicateBlockPart metrics.Counter
 
+	// Number of compact blocks received, by whether all the parts of the block
+	// were reconstructed from them ("complete") or some had to be requested
+	// ("partial").
+	CompactBlocks metrics.Counter `metrics_labels:"result"`
+
 	// Number of times we received a duplicate vote
 	DuplicateVote metrics.Counter
 
@@ -2,6 +2,8 @@ package consensus
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -117,6 +119,32 @@ func MsgToWrappedProto(msg Message) (cmtcons.Message, error) {
 
 		pb.Sum = &cmtcons.Message_VoteSetBits{VoteSetBits: vsb}
 
+	case *CompactBlockMessage:
+		txs := make([]cmtcons.CompactBlockTx, 0, len(msg.Txs))
+		for _, index := range slices.Sorted(maps.Keys(msg.Txs)) {
+			txs = append(txs, cmtcons.CompactBlockTx{Index: index, Tx: msg.Txs[index]})
+		}
+		pb.Sum = &cmtcons.Message_CompactBlock{CompactBlock: &cmtcons.CompactBlock{
+			Height:             msg.Height,
+			Round:              msg.Round,
+			BlockPartSetHeader: msg.BlockPartSetHeader.ToProto(),
+			Block:              msg.Block,
+			TxHashes:           msg.TxHashes,
+			TxSizes:            msg.TxSizes,
+			Txs:                txs,
+			PartHashes:         msg.PartHashes,
+		}}
+
+	case *MissingBlockPartsMessage:
+		mbp := &cmtcons.MissingBlockParts{
+			Height: msg.Height,
+			Round:  msg.Round,
+		}
+		if parts := msg.Parts.ToProto(); parts != nil {
+			mbp.Parts = *parts
+		}
+		pb.Sum = &cmtcons.Message_MissingBlockParts{MissingBlockParts: mbp}
+
 	default:
 		return pb, ErrConsensusMessageNotRecognized{msg}
 	}
@@ -238,6 +266,36 @@ func MsgFromProto(p proto.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *cmtcons.CompactBlock:
+		psh, err := types.PartSetHeaderFromProto(&msg.BlockPartSetHeader)
+		if err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "BlockPartSetHeader", Err: err}
+		}
+		txs := make(map[uint32]types.Tx, len(msg.Txs))
+		for _, tx := range msg.Txs {
+			if _, ok := txs[tx.Index]; ok {
+				return nil, fmt.Errorf("duplicate transaction index %d", tx.Index)
+			}
+			txs[tx.Index] = tx.Tx
+		}
+		pb = &CompactBlockMessage{
+			Height:             msg.Height,
+			Round:              msg.Round,
+			BlockPartSetHeader: *psh,
+			Block:              msg.Block,
+			TxHashes:           msg.TxHashes,
+			TxSizes:            msg.TxSizes,
+			Txs:                txs,
+			PartHashes:         msg.PartHashes,
+		}
+	case *cmtcons.MissingBlockParts:
+		parts := new(bits.BitArray)
+		parts.FromProto(&msg.Parts)
+		pb = &MissingBlockPartsMessage{
+			Height: msg.Height,
+			Round:  msg.Round,
+			Parts:  parts,
+		}
 	default:
 		return nil, ErrConsensusMessageNotRecognized{msg}
 	}
@@ -70,6 +70,8 @@ func TestMsgToProto(t *testing.T) {
 	)
 	pbVote := vote.ToProto()
 
+	txHash := types.Tx("test").Hash()
+
 	testsCases := []struct {
 		testName string
 		msg      Message
@@ -186,6 +188,42 @@ func TestMsgToProto(t *testing.T) {
 
 			false,
 		},
+		{
+			"successful CompactBlock", &CompactBlockMessage{
+				Height:             1,
+				Round:              1,
+				BlockPartSetHeader: psh,
+				Block:              &cmtproto.Block{},
+				TxHashes:           [][]byte{txHash, txHash},
+				TxSizes:            []uint32{4, 4},
+				Txs:                map[uint32]types.Tx{1: types.Tx("test")},
+				PartHashes:         [][]byte{psh.Hash},
+			}, &cmtcons.CompactBlock{
+				Height:             1,
+				Round:              1,
+				BlockPartSetHeader: pbPsh,
+				Block:              &cmtproto.Block{},
+				TxHashes:           [][]byte{txHash, txHash},
+				TxSizes:            []uint32{4, 4},
+				Txs:                []cmtcons.CompactBlockTx{{Index: 1, Tx: []byte("test")}},
+				PartHashes:         [][]byte{psh.Hash},
+			},
+
+			false,
+		},
+		{
+			"successful MissingBlockParts", &MissingBlockPartsMessage{
+				Height: 1,
+				Round:  1,
+				Parts:  bits,
+			}, &cmtcons.MissingBlockParts{
+				Height: 1,
+				Round:  1,
+				Parts:  *pbBits,
+			},
+
+			false,
+		},
 		{"failure", nil, &cmtcons.Message{}, true},
 	}
 	for _, tt := range testsCases {
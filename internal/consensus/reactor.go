@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"reflect"
@@ -9,6 +10,8 @@ import (
 	"time"
 
 	cmtcons "github.com/cometbft/cometbft/api/cometbft/consensus/v2"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/v2/crypto/tmhash"
 	"github.com/cometbft/cometbft/v2/internal/bits"
 	cstypes "github.com/cometbft/cometbft/v2/internal/consensus/types"
 	cmtevents "github.com/cometbft/cometbft/v2/internal/events"
@@ -16,6 +19,7 @@ import (
 	cmtjson "github.com/cometbft/cometbft/v2/libs/json"
 	"github.com/cometbft/cometbft/v2/libs/log"
 	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+	mempl "github.com/cometbft/cometbft/v2/mempool"
 	"github.com/cometbft/cometbft/v2/p2p"
 	tcpconn "github.com/cometbft/cometbft/v2/p2p/transport/tcp/conn"
 	sm "github.com/cometbft/cometbft/v2/state"
@@ -29,6 +33,10 @@ const (
 	DataChannel        = byte(0x21)
 	VoteChannel        = byte(0x22)
 	VoteSetBitsChannel = byte(0x23)
+	// CompactBlockChannel is only advertised by the nodes which gossip compact
+	// blocks. The compact blocks themselves are sent on the DataChannel, after
+	// the proposal.
+	CompactBlockChannel = byte(0x24)
 
 	maxMsgSize = 1048576 // 1MB; NOTE/TODO: keep in sync with types.PartSet sizes.
 
@@ -51,6 +59,16 @@ type Reactor struct {
 	rs            cstypes.RoundState // copy of consensus state
 	initialHeight atomic.Int64
 
+	txs           txFetcher
+	compactMtx    cmtsync.Mutex
+	compactHeight int64
+	compactRound  int32
+	compactHeader types.PartSetHeader
+	compactBlock  *CompactBlockMessage // of the proposal above, made once; nil if too big
+
+	// Compact blocks received, to be reconstructed by compactBlockRoutine.
+	compactBlockCh chan compactBlockInfo
+
 	Metrics *Metrics
 }
 
@@ -63,7 +81,10 @@ func NewReactor(consensusState *State, waitSync bool, options ...ReactorOption)
 		waitSync:      atomic.Bool{},
 		rs:            consensusState.GetRoundState(),
 		initialHeight: atomic.Int64{},
-		Metrics:       NopMetrics(),
+		txs:           &mempl.NopMempool{},
+		// Same capacity as the send queue of the peers.
+		compactBlockCh: make(chan compactBlockInfo, 10),
+		Metrics:        NopMetrics(),
 	}
 	conR.initialHeight.Store(consensusState.state.InitialHeight)
 	conR.BaseReactor = *p2p.NewBaseReactor("Consensus", conR)
@@ -88,6 +109,10 @@ func (conR *Reactor) OnStart() error {
 	// start routine that computes peer statistics for evaluating peer quality
 	go conR.peerStatsRoutine()
 
+	if conR.conS.config.CompactBlocks {
+		go conR.compactBlockRoutine()
+	}
+
 	conR.subscribeToBroadcastEvents()
 
 	if !conR.WaitSync() {
@@ -153,9 +178,9 @@ conR:
 }
 
 // StreamDescriptors implements Reactor.
-func (*Reactor) StreamDescriptors() []p2p.StreamDescriptor {
+func (conR *Reactor) StreamDescriptors() []p2p.StreamDescriptor {
 	// TODO optimize
-	return []p2p.StreamDescriptor{
+	descs := []p2p.StreamDescriptor{
 		tcpconn.StreamDescriptor{
 			ID:                  StateChannel,
 			Priority:            6,
@@ -189,6 +214,17 @@ func (*Reactor) StreamDescriptors() []p2p.StreamDescriptor {
 			MessageTypeI:        &cmtcons.Message{},
 		},
 	}
+	if conR.conS.config.CompactBlocks {
+		descs = append(descs, tcpconn.StreamDescriptor{
+			ID:                  CompactBlockChannel,
+			Priority:            10,
+			SendQueueCapacity:   10,
+			RecvBufferCapacity:  1024,
+			RecvMessageCapacity: maxMsgSize,
+			MessageTypeI:        &cmtcons.Message{},
+		})
+	}
+	return descs
 }
 
 // InitPeer implements Reactor by creating a state for the peer.
@@ -339,6 +375,27 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			ps.SetHasProposalBlockPart(msg.Height, msg.Round, int(msg.Part.Index))
 			conR.Metrics.BlockParts.With("peer_id", e.Src.ID()).Add(1)
 			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID(), time.Time{}}
+		case *CompactBlockMessage:
+			// Reconstructing the parts is expensive; don't block the peer.
+			select {
+			case conR.compactBlockCh <- compactBlockInfo{msg, e.Src}:
+			default:
+				conR.Logger.Debug("Too many compact blocks to handle; requesting the block parts instead",
+					"peer", e.Src, "msg", msg)
+				conR.requestMissingParts(msg, e.Src, allParts(msg))
+			}
+		default:
+			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+		}
+
+	case CompactBlockChannel:
+		if conR.WaitSync() {
+			conR.Logger.Info("Ignoring message received during sync", "msg", msg)
+			return
+		}
+		switch msg := msg.(type) {
+		case *MissingBlockPartsMessage:
+			ps.ApplyMissingBlockPartsMessage(msg)
 		default:
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
@@ -398,6 +455,81 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 	}
 }
 
+// compactBlockInfo is a compact block received from a peer.
+type compactBlockInfo struct {
+	msg *CompactBlockMessage
+	src p2p.Peer
+}
+
+// compactBlockRoutine handles the compact blocks received, off the goroutine
+// of the peer which sent them.
+func (conR *Reactor) compactBlockRoutine() {
+	for {
+		select {
+		case info := <-conR.compactBlockCh:
+			conR.handleCompactBlock(info.msg, info.src)
+		case <-conR.Quit():
+			return
+		}
+	}
+}
+
+// handleCompactBlock reconstructs the parts of the proposed block from the
+// compact block received from src and the mempool, adds them to the consensus
+// state, and requests the parts which could not be reconstructed from src.
+func (conR *Reactor) handleCompactBlock(msg *CompactBlockMessage, src p2p.Peer) {
+	var (
+		parts   []*types.Part
+		missing *bits.BitArray
+		err     error
+	)
+	if rs := conR.getRoundState(); rs.Height == msg.Height && rs.Round == msg.Round {
+		parts, missing, err = msg.reconstructParts(conR.txs)
+		if err != nil {
+			conR.Logger.Error("Peer sent us invalid compact block", "peer", src, "msg", msg, "err", err)
+			conR.Switch.StopPeerForError(src, err)
+			return
+		}
+	} else {
+		// The parts would be of no use at our height and round.
+		missing = allParts(msg)
+	}
+
+	for _, part := range parts {
+		select {
+		case conR.conS.peerMsgQueue <- msgInfo{&BlockPartMessage{msg.Height, msg.Round, part}, src.ID(), time.Time{}}:
+		case <-conR.Quit():
+			return
+		}
+	}
+	if missing.IsEmpty() {
+		conR.Metrics.CompactBlocks.With("result", "complete").Add(1)
+		return
+	}
+	conR.Metrics.CompactBlocks.With("result", "partial").Add(1)
+	conR.requestMissingParts(msg, src, missing)
+}
+
+// allParts returns a bit array with all the parts of the compact block set.
+func allParts(msg *CompactBlockMessage) *bits.BitArray {
+	return bits.NewBitArrayFromFn(int(msg.BlockPartSetHeader.Total), func(int) bool { return true })
+}
+
+// requestMissingParts requests from src the given parts of the block of the
+// compact block it sent.
+func (conR *Reactor) requestMissingParts(msg *CompactBlockMessage, src p2p.Peer, missing *bits.BitArray) {
+	conR.Logger.Debug("Requesting the block parts missing from the compact block",
+		"peer", src, "height", msg.Height, "round", msg.Round, "parts", missing)
+	_ = src.Send(p2p.Envelope{
+		ChannelID: CompactBlockChannel,
+		Message: &cmtcons.MissingBlockParts{
+			Height: msg.Height,
+			Round:  msg.Round,
+			Parts:  *missing.ToProto(),
+		},
+	})
+}
+
 // SetEventBus sets event bus.
 func (conR *Reactor) SetEventBus(b *types.EventBus) {
 	conR.eventBus = b
@@ -597,6 +729,7 @@ func (conR *Reactor) gossipDataRoutine(peer p2p.Peer, ps *PeerState) {
 		logger.Info("Peer does not implement DataChannel.")
 		return
 	}
+	compactBlocks := conR.conS.config.CompactBlocks && peer.HasChannel(CompactBlockChannel)
 	rng := cmtrand.NewStdlibRand()
 
 OUTER_LOOP:
@@ -617,6 +750,19 @@ OUTER_LOOP:
 		rs := conR.getRoundState()
 		prs := ps.GetRoundState()
 
+		// --------------------
+		// Send the compact block instead of the parts?
+		// (If the peer gossips compact blocks, has the proposal and no part yet)
+		// --------------------
+
+		if compactBlocks {
+			if msg := conR.pickCompactBlockToSend(&rs, prs); msg != nil {
+				if ps.SendCompactBlockSetHasParts(msg) {
+					continue OUTER_LOOP
+				}
+			}
+		}
+
 		// --------------------
 		// Send block part?
 		// (Note these can match on hash so round doesn't matter)
@@ -895,6 +1041,36 @@ func pickPartForCatchup(
 	return part
 }
 
+// pickCompactBlockToSend returns the compact block of our proposal block if the
+// peer has the proposal but none of its parts, and hasn't been sent it yet.
+func (conR *Reactor) pickCompactBlockToSend(rs *cstypes.RoundState, prs *cstypes.PeerRoundState) *CompactBlockMessage {
+	if rs.Height != prs.Height || rs.Round != prs.Round || !prs.Proposal || prs.CompactBlock {
+		return nil
+	}
+	if rs.ProposalBlock == nil || !rs.ProposalBlockParts.IsComplete() || rs.ProposalBlockParts.IsLocked() ||
+		!rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) || !prs.ProposalBlockParts.IsEmpty() {
+		return nil
+	}
+
+	conR.compactMtx.Lock()
+	defer conR.compactMtx.Unlock()
+	header := rs.ProposalBlockParts.Header()
+	if conR.compactHeight != rs.Height || conR.compactRound != rs.Round || !conR.compactHeader.Equals(header) {
+		cb, err := makeCompactBlock(rs.Height, rs.Round, rs.ProposalBlock, rs.ProposalBlockParts, conR.txs)
+		switch {
+		case errors.Is(err, errCompactBlockTooBig):
+			// Gossip the parts of the block instead.
+			conR.Logger.Debug("Proposal block too big for a compact block", "height", rs.Height, "round", rs.Round)
+		case err != nil:
+			conR.Logger.Error("Could not make compact block", "height", rs.Height, "round", rs.Round, "err", err)
+			return nil
+		}
+		conR.compactHeight, conR.compactRound, conR.compactHeader = rs.Height, rs.Round, header
+		conR.compactBlock = cb
+	}
+	return conR.compactBlock
+}
+
 func pickVoteToSend(
 	logger log.Logger,
 	conS *State,
@@ -935,7 +1111,11 @@ func pickVoteToSend(
 			ec = conS.blockStore.LoadBlockExtendedCommit(prs.Height)
 		} else {
 			c := conS.blockStore.LoadBlockCommit(prs.Height)
-			if c == nil {
+			if c != nil && c.HasAggregatedSignature() {
+				// Precommits cannot be picked from an aggregated signature.
+				c = conS.blockStore.LoadSeenCommit(prs.Height)
+			}
+			if c == nil || c.HasAggregatedSignature() {
 				return nil
 			}
 			ec = c.WrappedExtendedCommit()
@@ -1080,6 +1260,12 @@ func ReactorMetrics(metrics *Metrics) ReactorOption {
 	return func(conR *Reactor) { conR.Metrics = metrics }
 }
 
+// ReactorMempool sets the mempool in which the transactions of the compact
+// blocks are looked up.
+func ReactorMempool(mempool mempl.Mempool) ReactorOption {
+	return func(conR *Reactor) { conR.txs = mempool }
+}
+
 // -----------------------------------------------------------------------------
 
 // PeerState contains the known state of a peer, including its connection and
@@ -1244,6 +1430,35 @@ func (ps *PeerState) SendPartSetHasPart(part *types.Part, prs *cstypes.PeerRound
 	return true
 }
 
+// SendCompactBlockSetHasParts sends the compact block to the peer.
+// Returns true and marks the peer as having all the parts of the block, until
+// it requests the ones it could not reconstruct, if the compact block was sent.
+func (ps *PeerState) SendCompactBlockSetHasParts(msg *CompactBlockMessage) bool {
+	ps.logger.Debug("Sending compact block", "height", msg.Height, "round", msg.Round)
+	pb, err := MsgToWrappedProto(msg)
+	if err != nil {
+		ps.logger.Error("Could not convert compact block to proto", "error", err)
+		return false
+	}
+	if err := ps.peer.Send(p2p.Envelope{
+		ChannelID: DataChannel,
+		Message:   pb.GetCompactBlock(),
+	}); err != nil {
+		ps.logger.Debug("Sending compact block failed")
+		return false
+	}
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	if ps.PRS.Height != msg.Height || ps.PRS.Round != msg.Round ||
+		!ps.PRS.ProposalBlockPartSetHeader.Equals(msg.BlockPartSetHeader) {
+		return true
+	}
+	ps.PRS.CompactBlock = true
+	ps.PRS.ProposalBlockParts = bits.NewBitArrayFromFn(int(msg.BlockPartSetHeader.Total), func(int) bool { return true })
+	return true
+}
+
 // SendProposalSetHasProposal sends the Proposal (and ProposalPOL if there is one) to the peer.
 // If successful, it marks the peer as having the proposal.
 func (ps *PeerState) SendProposalSetHasProposal(
@@ -1529,6 +1744,7 @@ func (ps *PeerState) ApplyNewRoundStepMessage(msg *NewRoundStepMessage) {
 	ps.PRS.StartTime = startTime
 	if psHeight != msg.Height || psRound != msg.Round {
 		ps.PRS.Proposal = false
+		ps.PRS.CompactBlock = false
 		ps.PRS.ProposalBlockPartSetHeader = types.PartSetHeader{}
 		ps.PRS.ProposalBlockParts = nil
 		ps.PRS.ProposalPOLRound = -1
@@ -1617,6 +1833,26 @@ func (ps *PeerState) ApplyHasProposalBlockPartMessage(msg *HasProposalBlockPartM
 	ps.setHasProposalBlockPart(msg.Height, msg.Round, int(msg.Index))
 }
 
+// ApplyMissingBlockPartsMessage updates the peer state for the parts of the
+// proposal block it could not reconstruct from the compact block it was sent.
+func (ps *PeerState) ApplyMissingBlockPartsMessage(msg *MissingBlockPartsMessage) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.PRS.Height != msg.Height || ps.PRS.Round != msg.Round {
+		return
+	}
+	if ps.PRS.ProposalBlockParts.Size() != msg.Parts.Size() {
+		return
+	}
+
+	for i := 0; i < msg.Parts.Size(); i++ {
+		if msg.Parts.GetIndex(i) {
+			ps.PRS.ProposalBlockParts.SetIndex(i, false)
+		}
+	}
+}
+
 // ApplyVoteSetBitsMessage updates the peer state for the bit-array of votes
 // it claims to have for the corresponding BlockID.
 // `ourVotes` is a BitArray of votes we have for msg.BlockID
@@ -1677,6 +1913,8 @@ func init() {
 	cmtjson.RegisterType(&HasProposalBlockPartMessage{}, "tendermint/HasProposalBlockPart")
 	cmtjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
 	cmtjson.RegisterType(&VoteSetBitsMessage{}, "tendermint/VoteSetBits")
+	cmtjson.RegisterType(&CompactBlockMessage{}, "tendermint/CompactBlock")
+	cmtjson.RegisterType(&MissingBlockPartsMessage{}, "tendermint/MissingBlockParts")
 }
 
 // -------------------------------------
@@ -2009,10 +2247,119 @@ func (m *HasProposalBlockPartMessage) String() string {
 	return fmt.Sprintf("[HasProposalBlockPart PI:%v HR:{%v/%02d}]", m.Index, m.Height, m.Round)
 }
 
+// -------------------------------------
+
+// CompactBlockMessage is sent instead of the parts of the proposed block to
+// the peers which gossip compact blocks. The transactions of the block are
+// identified by their hashes, except for the ones the peer is unlikely to have
+// in its mempool.
+type CompactBlockMessage struct {
+	Height             int64
+	Round              int32
+	BlockPartSetHeader types.PartSetHeader
+	Block              *cmtproto.Block // without its transactions
+	TxHashes           [][]byte
+	TxSizes            []uint32
+	Txs                map[uint32]types.Tx // the transactions included, by index
+	PartHashes         [][]byte
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if err := m.BlockPartSetHeader.ValidateBasic(); err != nil {
+		return cmterrors.ErrWrongField{Field: "BlockPartSetHeader", Err: err}
+	}
+	if m.BlockPartSetHeader.Total > types.MaxBlockPartsCount {
+		return fmt.Errorf("too many block parts: %d, max: %d", m.BlockPartSetHeader.Total, types.MaxBlockPartsCount)
+	}
+	if m.Block == nil {
+		return cmterrors.ErrRequiredField{Field: "Block"}
+	}
+	if len(m.Block.Data.Txs) > 0 {
+		return cmterrors.ErrInvalidField{Field: "Block", Reason: "includes transactions"}
+	}
+	if len(m.TxSizes) != len(m.TxHashes) {
+		return fmt.Errorf("%d transaction sizes for %d transactions", len(m.TxSizes), len(m.TxHashes))
+	}
+	var size int64
+	for i, hash := range m.TxHashes {
+		if len(hash) != tmhash.Size {
+			return fmt.Errorf("wrong transaction #%d hash size: %d", i, len(hash))
+		}
+		size += int64(m.TxSizes[i])
+	}
+	if size > int64(m.BlockPartSetHeader.Total)*int64(types.BlockPartSizeBytes) {
+		return fmt.Errorf("transactions of %d bytes do not fit in %d block parts", size, m.BlockPartSetHeader.Total)
+	}
+	for index, tx := range m.Txs {
+		if int(index) >= len(m.TxHashes) {
+			return fmt.Errorf("transaction index %d out of range", index)
+		}
+		if len(tx) != int(m.TxSizes[index]) {
+			return fmt.Errorf("wrong transaction #%d size: %d", index, len(tx))
+		}
+	}
+	if len(m.PartHashes) != int(m.BlockPartSetHeader.Total) {
+		return fmt.Errorf("%d part hashes for %d block parts", len(m.PartHashes), m.BlockPartSetHeader.Total)
+	}
+	for i, hash := range m.PartHashes {
+		if len(hash) != tmhash.Size {
+			return fmt.Errorf("wrong part #%d hash size: %d", i, len(hash))
+		}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockMessage) String() string {
+	return fmt.Sprintf("[CompactBlock H:%v R:%v BP:%v Txs:%v/%v]",
+		m.Height, m.Round, m.BlockPartSetHeader, len(m.Txs), len(m.TxHashes))
+}
+
+// -------------------------------------
+
+// MissingBlockPartsMessage is sent in response to a CompactBlockMessage, to
+// request the parts of the block which could not be reconstructed.
+type MissingBlockPartsMessage struct {
+	Height int64
+	Round  int32
+	Parts  *bits.BitArray
+}
+
+// ValidateBasic performs basic validation.
+func (m *MissingBlockPartsMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if m.Parts.Size() == 0 {
+		return cmterrors.ErrRequiredField{Field: "Parts"}
+	}
+	if m.Parts.Size() > int(types.MaxBlockPartsCount) {
+		return fmt.Errorf("parts bit array is too big: %d, max: %d", m.Parts.Size(), types.MaxBlockPartsCount)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *MissingBlockPartsMessage) String() string {
+	return fmt.Sprintf("[MissingBlockParts H:%v R:%v P:%v]", m.Height, m.Round, m.Parts)
+}
+
 var (
 	_ types.Wrapper = &cmtcons.BlockPart{}
+	_ types.Wrapper = &cmtcons.CompactBlock{}
 	_ types.Wrapper = &cmtcons.HasVote{}
 	_ types.Wrapper = &cmtcons.HasProposalBlockPart{}
+	_ types.Wrapper = &cmtcons.MissingBlockParts{}
 	_ types.Wrapper = &cmtcons.NewRoundStep{}
 	_ types.Wrapper = &cmtcons.NewValidBlock{}
 	_ types.Wrapper = &cmtcons.Proposal{}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/cometbft/cometbft/v2/libs/bytes"
 	"github.com/cometbft/cometbft/v2/libs/json"
 	"github.com/cometbft/cometbft/v2/libs/log"
+	"github.com/cometbft/cometbft/v2/libs/metrics"
 	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
 	mempl "github.com/cometbft/cometbft/v2/mempool"
 	"github.com/cometbft/cometbft/v2/p2p"
@@ -44,7 +46,7 @@ import (
 
 var defaultTestTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
 
-func startConsensusNet(t *testing.T, css []*State, n int) (
+func startConsensusNet(t *testing.T, css []*State, n int, options ...ReactorOption) (
 	[]*Reactor,
 	[]types.Subscription,
 	[]*types.EventBus,
@@ -56,7 +58,8 @@ func startConsensusNet(t *testing.T, css []*State, n int) (
 	for i := 0; i < n; i++ {
 		// logger, err := cmtflags.ParseLogLevel("consensus:info,*:error", logger, "info")
 		// if err != nil {	t.Fatal(err)}
-		reactors[i] = NewReactor(css[i], true) // so we dont start the consensus states
+		opts := append([]ReactorOption{ReactorMempool(assertMempool(css[i].txNotifier))}, options...)
+		reactors[i] = NewReactor(css[i], true, opts...) // so we dont start the consensus states
 		reactors[i].SetLogger(css[i].Logger)
 
 		// eventBus is already started with the cs
@@ -247,6 +250,167 @@ func TestReactorCreatesBlockWhenEmptyBlocksFalse(t *testing.T) {
 	})
 }
 
+// compactBlocksCounter counts the compact blocks received, whatever the result.
+type compactBlocksCounter struct {
+	n atomic.Int64
+}
+
+func (c *compactBlocksCounter) With(...string) metrics.Counter { return c }
+
+func (c *compactBlocksCounter) Add(delta float64) { c.n.Add(int64(delta)) }
+
+// Ensure a testnet gossiping compact blocks commits the transactions in every
+// mempool, as well as the ones in the mempool of the proposer only.
+func TestReactorCompactBlocks(t *testing.T) {
+	n := 4
+	css, cleanup := randConsensusNet(t, n, "consensus_reactor_test", newMockTickerFunc(true), newKVStore,
+		func(c *cfg.Config) {
+			c.Consensus.CompactBlocks = true
+		})
+	defer cleanup()
+	counter := &compactBlocksCounter{}
+	metrics := NopMetrics()
+	metrics.CompactBlocks = counter
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, n, ReactorMetrics(metrics))
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	activeVals := make(map[string]struct{})
+	for i := 0; i < n; i++ {
+		pubKey, err := css[i].privValidator.GetPubKey()
+		require.NoError(t, err)
+		activeVals[string(pubKey.Address())] = struct{}{}
+	}
+
+	// wait till everyone makes block 1
+	timeoutWaitGroup(n, func(j int) {
+		<-blocksSubs[j].Out()
+	})
+
+	// the first tx is in every mempool, the second one in a single mempool
+	tx1, tx2 := kvstore.NewTxFromID(1), kvstore.NewTxFromID(2)
+	for i := 0; i < n; i++ {
+		reqRes, err := assertMempool(css[i].txNotifier).CheckTx(tx1, "")
+		require.NoError(t, err)
+		require.False(t, reqRes.Response.GetCheckTx().IsErr())
+	}
+	reqRes, err := assertMempool(css[0].txNotifier).CheckTx(tx2, "")
+	require.NoError(t, err)
+	require.False(t, reqRes.Response.GetCheckTx().IsErr())
+
+	waitForAndValidateBlockWithTx(t, n, activeVals, blocksSubs, css, tx1, tx2)
+	assert.Positive(t, counter.n.Load())
+}
+
+// txsByHash is a txFetcher of the given transactions.
+type txsByHash map[string]types.Tx
+
+func (txs txsByHash) GetTxByHash(hash []byte) types.Tx {
+	return txs[string(hash)]
+}
+
+func newTxsByHash(txs ...types.Tx) txsByHash {
+	m := make(txsByHash, len(txs))
+	for _, tx := range txs {
+		m[string(tx.Hash())] = tx
+	}
+	return m
+}
+
+func TestCompactBlockMessageReconstructParts(t *testing.T) {
+	txs := make([]types.Tx, 8)
+	for i := range txs {
+		txs[i] = cmtrand.Bytes(int(types.BlockPartSizeBytes) / 3)
+	}
+	block := types.MakeBlock(1, txs, &types.Commit{}, nil)
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	require.Greater(t, parts.Total(), uint32(2))
+
+	testCases := []struct {
+		name        string
+		senderTxs   txsByHash
+		receiverTxs txsByHash
+		missing     []int
+	}{
+		{"all txs in the mempool", newTxsByHash(txs...), newTxsByHash(txs...), nil},
+		{"txs sent along", newTxsByHash(), newTxsByHash(), nil},
+		{"first tx unknown", newTxsByHash(txs...), newTxsByHash(txs[1:]...), []int{0}},
+		{"last tx unknown", newTxsByHash(txs...), newTxsByHash(txs[:7]...), []int{int(parts.Total()) - 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := makeCompactBlock(1, 0, block, parts, tc.senderTxs)
+			require.NoError(t, err)
+			require.NoError(t, msg.ValidateBasic())
+
+			rebuilt, missing, err := msg.reconstructParts(tc.receiverTxs)
+			require.NoError(t, err)
+			for _, index := range tc.missing {
+				assert.True(t, missing.GetIndex(index), "part %d should be missing", index)
+			}
+			assert.Len(t, rebuilt, int(parts.Total())-len(tc.missing))
+			for _, part := range rebuilt {
+				assert.Equal(t, parts.GetPart(int(part.Index)), part)
+			}
+		})
+	}
+
+	t.Run("part hashes mismatch", func(t *testing.T) {
+		msg, err := makeCompactBlock(1, 0, block, parts, newTxsByHash(txs...))
+		require.NoError(t, err)
+		msg.PartHashes[0] = tmhash.Sum([]byte("part"))
+		_, _, err = msg.reconstructParts(newTxsByHash(txs...))
+		require.Error(t, err)
+	})
+
+	t.Run("tx hash mismatch", func(t *testing.T) {
+		msg, err := makeCompactBlock(1, 0, block, parts, newTxsByHash())
+		require.NoError(t, err)
+		msg.Txs[0] = types.Tx(cmtrand.Bytes(len(txs[0])))
+		_, _, err = msg.reconstructParts(newTxsByHash())
+		require.Error(t, err)
+	})
+}
+
+func TestMakeCompactBlockTooBig(t *testing.T) {
+	// Over 1MB of transactions.
+	txs := make([]types.Tx, 3)
+	for i := range txs {
+		txs[i] = cmtrand.Bytes(maxMsgSize / 2)
+	}
+	block := types.MakeBlock(1, txs, &types.Commit{}, nil)
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+
+	// Without the txs in the mempool, they do not fit in the compact block.
+	_, err = makeCompactBlock(1, 0, block, parts, newTxsByHash())
+	require.ErrorIs(t, err, errCompactBlockTooBig)
+	_, err = makeCompactBlock(1, 0, block, parts, newTxsByHash(txs[0]))
+	require.ErrorIs(t, err, errCompactBlockTooBig)
+
+	// With them, the compact block only includes their hashes.
+	msg, err := makeCompactBlock(1, 0, block, parts, newTxsByHash(txs...))
+	require.NoError(t, err)
+	require.Empty(t, msg.Txs)
+	pb, err := MsgToWrappedProto(msg)
+	require.NoError(t, err)
+	require.LessOrEqual(t, pb.Size(), maxMsgSize)
+
+	// The reactor falls back to gossiping the parts of the block.
+	conR := &Reactor{txs: newTxsByHash()}
+	conR.Logger = log.TestingLogger()
+	rs := &cstypes.RoundState{Height: 1, Round: 0, ProposalBlock: block, ProposalBlockParts: parts}
+	prs := &cstypes.PeerRoundState{
+		Height:                     1,
+		Round:                      0,
+		Proposal:                   true,
+		ProposalBlockPartSetHeader: parts.Header(),
+		ProposalBlockParts:         bits.NewBitArray(int(parts.Total())),
+	}
+	require.Nil(t, conR.pickCompactBlockToSend(rs, prs))
+	require.Equal(t, parts.Header(), conR.compactHeader)
+}
+
 func TestReactorReceiveDoesNotPanicIfAddPeerHasntBeenCalledYet(t *testing.T) {
 	n := 1
 	css, cleanup := randConsensusNet(t, n, "consensus_reactor_test", newMockTickerFunc(true), newKVStore)
@@ -1092,6 +1256,73 @@ func TestVoteSetBitsMessageValidateBasic(t *testing.T) {
 	}
 }
 
+func TestCompactBlockMessageValidateBasic(t *testing.T) {
+	txs := []types.Tx{types.Tx("tx1"), types.Tx("tx2")}
+	block := types.MakeBlock(1, txs, &types.Commit{}, nil)
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		testName   string
+		malleateFn func(*CompactBlockMessage)
+		expectErr  bool
+	}{
+		{"Valid Message", func(*CompactBlockMessage) {}, false},
+		{"Invalid Height", func(msg *CompactBlockMessage) { msg.Height = 0 }, true},
+		{"Invalid Round", func(msg *CompactBlockMessage) { msg.Round = -1 }, true},
+		{"Invalid BlockPartSetHeader", func(msg *CompactBlockMessage) {
+			msg.BlockPartSetHeader.Hash = []byte{1}
+		}, true},
+		{"Missing Block", func(msg *CompactBlockMessage) { msg.Block = nil }, true},
+		{"Block with txs", func(msg *CompactBlockMessage) { msg.Block.Data.Txs = [][]byte{txs[0]} }, true},
+		{"Missing tx size", func(msg *CompactBlockMessage) { msg.TxSizes = msg.TxSizes[:1] }, true},
+		{"Invalid tx hash", func(msg *CompactBlockMessage) { msg.TxHashes[0] = []byte{1} }, true},
+		{"Txs too big", func(msg *CompactBlockMessage) { msg.TxSizes[0] = types.BlockPartSizeBytes + 1 }, true},
+		{"Tx index out of range", func(msg *CompactBlockMessage) { msg.Txs[2] = txs[1] }, true},
+		{"Wrong tx size", func(msg *CompactBlockMessage) { msg.Txs[0] = types.Tx("tx") }, true},
+		{"Missing part hash", func(msg *CompactBlockMessage) { msg.PartHashes = nil }, true},
+		{"Invalid part hash", func(msg *CompactBlockMessage) { msg.PartHashes[0] = []byte{1} }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			message, err := makeCompactBlock(1, 0, block, parts, newTxsByHash(txs[1]))
+			require.NoError(t, err)
+			tc.malleateFn(message)
+
+			assert.Equal(t, tc.expectErr, message.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
+func TestMissingBlockPartsMessageValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		messageHeight int64
+		messageRound  int32
+		messageParts  *bits.BitArray
+		expectErr     bool
+	}{
+		{"Valid Message", 1, 0, bits.NewBitArray(1), false},
+		{"Invalid Height", 0, 0, bits.NewBitArray(1), true},
+		{"Invalid Round", 1, -1, bits.NewBitArray(1), true},
+		{"Missing Parts", 1, 0, nil, true},
+		{"Too Many Parts", 1, 0, bits.NewBitArray(int(types.MaxBlockPartsCount) + 1), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			message := MissingBlockPartsMessage{
+				Height: tc.messageHeight,
+				Round:  tc.messageRound,
+				Parts:  tc.messageParts,
+			}
+
+			assert.Equal(t, tc.expectErr, message.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
 func TestMarshalJSONPeerState(t *testing.T) {
 	ps := NewPeerState(nil)
 	data, err := json.Marshal(ps)
@@ -1103,6 +1334,7 @@ func TestMarshalJSONPeerState(t *testing.T) {
 			"step": 0,
 			"start_time": "0001-01-01T00:00:00Z",
 			"proposal": false,
+			"compact_block": false,
 			"proposal_block_part_set_header":
 				{"total":0, "hash":""},
 			"proposal_block_parts": null,
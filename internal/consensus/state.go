@@ -641,6 +641,17 @@ func (cs *State) votesFromSeenCommit(state sm.State) (*types.VoteSet, error) {
 			commit.Height, state.LastBlockHeight)
 	}
 	vs := commit.ToVoteSet(state.ChainID, state.LastValidators)
+	if commit.HasAggregatedSignature() {
+		// The precommits whose signature is aggregated, e.g. in a commit
+		// received while block syncing, cannot be added to the vote set, and
+		// will be received from peers. The aggregated signature must still
+		// prove that +2/3 of the validators committed the block.
+		err := state.LastValidators.VerifyCommit(state.ChainID, commit.BlockID, commit.Height, commit)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCommitQuorumNotMet, err)
+		}
+		return vs, nil
+	}
 	if !vs.HasTwoThirdsMajority() {
 		return nil, ErrCommitQuorumNotMet
 	}
@@ -1431,7 +1442,7 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		if cs.LockedRound == -1 {
 			if cs.ValidRound != -1 && cs.ProposalBlock.HashesTo(cs.ValidBlock.Hash()) {
 				logger.Debug("Prevote step: ProposalBlock matches our valid block; prevoting the proposal")
-				cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+				cs.prevoteProposal()
 				return
 			}
 
@@ -1497,13 +1508,13 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 			}
 
 			logger.Debug("Prevote step: ProposalBlock is valid and there is no locked block; prevoting the proposal")
-			cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+			cs.prevoteProposal()
 			return
 		}
 
 		if cs.ProposalBlock.HashesTo(cs.LockedBlock.Hash()) {
 			logger.Debug("Prevote step: ProposalBlock is valid (POLRound is -1) and matches our locked block; prevoting the proposal")
-			cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+			cs.prevoteProposal()
 			return
 		}
 
@@ -1544,12 +1555,12 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		if cs.LockedRound < cs.Proposal.POLRound {
 			logger.Debug("Prevote step: ProposalBlock is valid and received a 2/3" +
 				"majority in a round later than the locked round; prevoting the proposal")
-			cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+			cs.prevoteProposal()
 			return
 		}
 		if cs.ProposalBlock.HashesTo(cs.LockedBlock.Hash()) {
 			logger.Debug("Prevote step: ProposalBlock is valid and matches our locked block; prevoting the proposal")
-			cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+			cs.prevoteProposal()
 			return
 		}
 		// If v_r = lockedRound_p we expect v to match lockedValue_p. If it is not the case,
@@ -1560,7 +1571,7 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 			logger.Info("Prevote step: ProposalBlock is valid and received a 2/3" +
 				"majority at our locked round, while not matching our locked value;" +
 				"this can only happen when 1/3 or more validators are double signing; prevoting the proposal")
-			cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+			cs.prevoteProposal()
 			return
 		}
 	}
@@ -1570,6 +1581,18 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 	cs.signAddVote(types.PrevoteType, nil, types.PartSetHeader{}, nil)
 }
 
+// prevoteProposal prevotes the proposal block. If optimistic execution is
+// enabled, it first starts executing the block: the block is valid, as it was
+// accepted by ProcessProposal, in this round or in a previous one, or prevoted
+// by a 2/3 majority, of which at least one correct validator accepted it.
+func (cs *State) prevoteProposal() {
+	if cs.config.OptimisticExecution {
+		// Start executing the block while voting on it.
+		cs.blockExec.ExecuteBlockOptimistically(cs.state, cs.ProposalBlock)
+	}
+	cs.signAddVote(types.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header(), nil)
+}
+
 // Enter: any +2/3 prevotes at next round.
 func (cs *State) enterPrevoteWait(height int64, round int32) {
 	logger := cs.Logger.With("height", height, "round", round)
@@ -12,10 +12,12 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	dbm "github.com/cometbft/cometbft-db"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	"github.com/cometbft/cometbft/v2/abci/example/kvstore"
 	abci "github.com/cometbft/cometbft/v2/abci/types"
 	abcimocks "github.com/cometbft/cometbft/v2/abci/types/mocks"
+	"github.com/cometbft/cometbft/v2/crypto/bls12381"
 	"github.com/cometbft/cometbft/v2/crypto/tmhash"
 	cstypes "github.com/cometbft/cometbft/v2/internal/consensus/types"
 	cmtrand "github.com/cometbft/cometbft/v2/internal/rand"
@@ -24,8 +26,12 @@ import (
 	"github.com/cometbft/cometbft/v2/libs/log"
 	"github.com/cometbft/cometbft/v2/libs/protoio"
 	cmtpubsub "github.com/cometbft/cometbft/v2/libs/pubsub"
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
 	p2pmock "github.com/cometbft/cometbft/v2/p2p/mock"
+	sm "github.com/cometbft/cometbft/v2/state"
+	"github.com/cometbft/cometbft/v2/store"
 	"github.com/cometbft/cometbft/v2/types"
+	cmttime "github.com/cometbft/cometbft/v2/types/time"
 )
 
 /*
@@ -379,6 +385,132 @@ func TestStateFullRound1(t *testing.T) {
 	validateLastPrecommit(t, cs, vss[0], propBlockHash)
 }
 
+// finalizeCountingApp counts the FinalizeBlock calls it receives per height.
+type finalizeCountingApp struct {
+	abci.Application
+
+	mtx       cmtsync.Mutex
+	finalized map[int64]int
+	lastHash  []byte // of the last block finalized
+}
+
+func (app *finalizeCountingApp) FinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+	app.mtx.Lock()
+	app.finalized[req.Height]++
+	app.lastHash = req.Hash
+	app.mtx.Unlock()
+	return app.Application.FinalizeBlock(ctx, req)
+}
+
+// The proposal is executed optimistically once accepted, before it is
+// committed, and only once.
+func TestStateFullRoundOptimisticExecution(t *testing.T) {
+	app := &finalizeCountingApp{
+		Application: kvstore.NewInMemoryApplication(),
+		finalized:   make(map[int64]int),
+	}
+	cs1, vss := randStateWithApp(2, app)
+	cs1.config.OptimisticExecution = true
+	vs2 := vss[1]
+	height, round, chainID := cs1.Height, cs1.Round, cs1.state.ChainID
+	finalized := func() int {
+		app.mtx.Lock()
+		defer app.mtx.Unlock()
+		return app.finalized[height]
+	}
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs1, height, round)
+	ensurePrevote(voteCh, height, round)
+	rs := cs1.GetRoundState()
+	blockID := types.BlockID{Hash: rs.ProposalBlock.Hash(), PartSetHeader: rs.ProposalBlockParts.Header()}
+	signAddVotes(cs1, types.PrevoteType, chainID, blockID, false, vs2)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+
+	// The block cannot be committed without the precommit of vs2, but it is
+	// already finalized.
+	require.Eventually(t, func() bool { return finalized() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Less(t, cs1.GetRoundState().Step, cstypes.RoundStepCommit)
+
+	signAddVotes(cs1, types.PrecommitType, chainID, blockID, true, vs2)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewBlock(newBlockCh, height)
+	require.Equal(t, 1, finalized())
+}
+
+// A proposal with a POL round is executed optimistically when prevoted, even
+// though ProcessProposal is not called for it.
+func TestStateOptimisticExecutionPOL(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	app := &finalizeCountingApp{
+		Application: kvstore.NewInMemoryApplication(),
+		finalized:   make(map[int64]int),
+	}
+	cs1, vss := randStateWithApp(4, app)
+	cs1.config.OptimisticExecution = true
+	vs2, vs3, vs4 := vss[1], vss[2], vss[3]
+	height, round, chainID := cs1.Height, cs1.Round, cs1.state.ChainID
+	lastHash := func() []byte {
+		app.mtx.Lock()
+		defer app.mtx.Unlock()
+		return app.lastHash
+	}
+
+	timeoutWaitCh := subscribe(cs1.eventBus, types.EventQueryTimeoutWait)
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	pv1, err := cs1.privValidator.GetPubKey()
+	require.NoError(t, err)
+	voteCh := subscribeToVoter(cs1, pv1.Address())
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+
+	/*
+		Round 0:
+		cs1 proposes block A, accepts it, prevotes it and executes it.
+		The other validators prevote block D, which cs1 does not have, and
+		precommit nil.
+	*/
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	blockA := cs1.GetRoundState().ProposalBlock
+	ensurePrevote(voteCh, height, round)
+	require.Eventually(t, func() bool { return bytes.Equal(lastHash(), blockA.Hash()) }, 5*time.Second, 10*time.Millisecond)
+
+	cs2 := newState(cs1.state, vs2, kvstore.NewInMemoryApplication())
+	_, blockD := decideProposal(ctx, t, cs2, vs2, vs2.Height, vs2.Round)
+	partsD, err := blockD.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockIDD := types.BlockID{Hash: blockD.Hash(), PartSetHeader: partsD.Header()}
+	require.NotEqual(t, blockA.Hash(), blockIDD.Hash)
+
+	signAddVotes(cs1, types.PrevoteType, chainID, blockIDD, false, vs2, vs3, vs4)
+	ensurePrecommit(voteCh, height, round)
+	validatePrecommit(t, cs1, round, -1, vss[0], nil, nil)
+	signAddVotes(cs1, types.PrecommitType, chainID, types.BlockID{}, true, vs2, vs3, vs4)
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+
+	/*
+		Round 1:
+		vs2 proposes D with POL round 0: cs1 prevotes it without calling
+		ProcessProposal, and executes it.
+	*/
+	incrementRound(vs2, vs3, vs4)
+	round++
+	ensureNewRound(newRoundCh, height, round)
+	propR1 := types.NewProposal(height, round, 0, blockIDD, blockD.Header.Time)
+	signProposal(t, propR1, chainID, vs2)
+	require.NoError(t, cs1.SetProposalAndBlock(propR1, partsD, ""))
+	ensureNewProposal(proposalCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vss[0], blockIDD.Hash)
+	require.Eventually(t, func() bool { return bytes.Equal(lastHash(), blockIDD.Hash) }, 5*time.Second, 10*time.Millisecond)
+}
+
 // nil is proposed, so prevote and precommit nil.
 func TestStateFullRoundNil(t *testing.T) {
 	cs, _ := randState(1)
@@ -3332,3 +3464,63 @@ func TestReadSerializedBlockFromBlockParts(t *testing.T) {
 		}
 	}
 }
+
+// Test that the last commit is reconstructed from a seen commit with an
+// aggregated signature only if the signature proves +2/3 committed the block.
+func TestStateVotesFromAggregatedSeenCommit(t *testing.T) {
+	if !bls12381.Enabled {
+		t.Skip("bls12381 is disabled")
+	}
+	const height = 10
+	chainID := test.DefaultTestChainID
+	vals := make([]*types.Validator, 4)
+	privVals := make([]types.PrivValidator, 4)
+	for i := range privVals {
+		privKey, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		privVals[i] = types.NewMockPVWithParams(privKey, false, false)
+		vals[i] = types.NewValidator(privKey.PubKey(), 10)
+	}
+	valSet := types.NewValidatorSet(vals)
+
+	blockID := types.BlockID{Hash: cmtrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{Total: 1, Hash: cmtrand.Bytes(tmhash.Size)}}
+	voteSet := types.NewVoteSet(chainID, height, 0, types.PrecommitType, valSet)
+	now := cmttime.Now()
+	for i, privVal := range privVals {
+		pubKey, err := privVal.GetPubKey()
+		require.NoError(t, err)
+		idx, _ := valSet.GetByAddress(pubKey.Address())
+		vote := &types.Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   idx,
+			Height:           height,
+			Type:             types.PrecommitType,
+			BlockID:          blockID,
+			// Precommits with distinct timestamps are all aggregated.
+			Timestamp: now.Add(time.Duration(i) * time.Millisecond),
+		}
+		_, err = types.SignAndCheckVote(vote, privVal, chainID, false)
+		require.NoError(t, err)
+		_, err = voteSet.AddVote(vote)
+		require.NoError(t, err)
+	}
+	commit, err := voteSet.MakeExtendedCommit(types.DefaultFeatureParams()).ToCommit().AggregateSignatures()
+	require.NoError(t, err)
+
+	state := sm.State{ChainID: chainID, LastBlockHeight: height, LastValidators: valSet}
+	votesFromSeenCommit := func(commit *types.Commit) (*types.VoteSet, error) {
+		blockStore := store.NewBlockStore(dbm.NewMemDB())
+		require.NoError(t, blockStore.SaveSeenCommit(height, commit))
+		cs := &State{blockStore: blockStore}
+		return cs.votesFromSeenCommit(state)
+	}
+
+	votes, err := votesFromSeenCommit(commit)
+	require.NoError(t, err)
+	assert.False(t, votes.HasTwoThirdsMajority())
+
+	tampered := commit.Clone()
+	tampered.Signatures[0].Timestamp = tampered.Signatures[0].Timestamp.Add(time.Second)
+	_, err = votesFromSeenCommit(tampered)
+	require.ErrorIs(t, err, ErrCommitQuorumNotMet)
+}
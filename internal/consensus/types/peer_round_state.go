@@ -21,7 +21,9 @@ type PeerRoundState struct {
 	StartTime time.Time `json:"start_time"`
 
 	// True if peer has proposal for this round
-	Proposal                   bool                `json:"proposal"`
+	Proposal bool `json:"proposal"`
+	// True if peer was sent the compact block of the proposal for this round
+	CompactBlock               bool                `json:"compact_block"`
 	ProposalBlockPartSetHeader types.PartSetHeader `json:"proposal_block_part_set_header"`
 	// This bit array is length(# of block parts)
 	ProposalBlockParts *bits.BitArray `json:"proposal_block_parts"`
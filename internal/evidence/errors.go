@@ -11,6 +11,7 @@ import (
 var (
 	ErrEvidenceAlreadyCommitted = errors.New("evidence was already committed")
 	ErrDuplicateEvidence        = errors.New("duplicate evidence")
+	ErrPendingEvidenceNotFound  = errors.New("pending evidence not found")
 )
 
 type (
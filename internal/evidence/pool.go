@@ -2,7 +2,10 @@ package evidence
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,6 +17,7 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	"github.com/cometbft/cometbft/v2/internal/clist"
+	"github.com/cometbft/cometbft/v2/internal/keylayout"
 	"github.com/cometbft/cometbft/v2/libs/log"
 	sm "github.com/cometbft/cometbft/v2/state"
 	"github.com/cometbft/cometbft/v2/types"
@@ -151,6 +155,27 @@ func (evpool *Pool) PendingEvidence(maxBytes int64) ([]types.Evidence, int64) {
 	return evidence, size
 }
 
+// RemovePendingEvidence removes the pending evidence with the given hash, so
+// that it is neither proposed nor broadcasted to peers anymore. It returns
+// ErrPendingEvidenceNotFound if there is no such evidence. The evidence is
+// added back if it is received again.
+func (evpool *Pool) RemovePendingEvidence(hash []byte) error {
+	evList, _, err := evpool.listEvidence(evpool.dbKeyLayout.PrefixToBytesPending(), -1)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve pending evidence: %w", err)
+	}
+	for _, ev := range evList {
+		if !bytes.Equal(ev.Hash(), hash) {
+			continue
+		}
+		evpool.removePendingEvidence(ev)
+		evpool.removeEvidenceFromList(map[string]struct{}{evMapKey(ev): {}})
+		evpool.logger.Info("Removed pending evidence", "evidence", ev)
+		return nil
+	}
+	return ErrPendingEvidenceNotFound
+}
+
 // Update takes both the new state and the evidence committed at that height and performs
 // the following operations:
 //  1. Take any conflicting votes from consensus and use the state's LastBlockTime to form
@@ -635,12 +660,12 @@ func (v1LegacyLayout) PrefixToBytesPending() []byte {
 
 // CalcKeyCommitted implements EvidenceKeyLayout.
 func (v1LegacyLayout) CalcKeyCommitted(evidence types.Evidence) []byte {
-	return append([]byte{baseKeyCommitted}, keySuffix(evidence)...)
+	return append([]byte{baseKeyCommitted}, keySuffix(evidence.Height(), evidence.Hash())...)
 }
 
 // CalcKeyPending implements EvidenceKeyLayout.
 func (v1LegacyLayout) CalcKeyPending(evidence types.Evidence) []byte {
-	return append([]byte{baseKeyPending}, keySuffix(evidence)...)
+	return append([]byte{baseKeyPending}, keySuffix(evidence.Height(), evidence.Hash())...)
 }
 
 var _ KeyLayout = (*v1LegacyLayout)(nil)
@@ -667,16 +692,16 @@ func (v2Layout) PrefixToBytesPending() []byte {
 
 // CalcKeyCommitted implements EvidenceKeyLayout.
 func (v2Layout) CalcKeyCommitted(evidence types.Evidence) []byte {
-	key, err := orderedcode.Append(nil, prefixCommitted, evidence.Height(), string(evidence.Hash()))
-	if err != nil {
-		panic(err)
-	}
-	return key
+	return v2Key(prefixCommitted, evidence.Height(), evidence.Hash())
 }
 
 // CalcKeyPending implements EvidenceKeyLayout.
 func (v2Layout) CalcKeyPending(evidence types.Evidence) []byte {
-	key, err := orderedcode.Append(nil, prefixPending, evidence.Height(), string(evidence.Hash()))
+	return v2Key(prefixPending, evidence.Height(), evidence.Hash())
+}
+
+func v2Key(prefix, height int64, hash []byte) []byte {
+	key, err := orderedcode.Append(nil, prefix, height, string(hash))
 	if err != nil {
 		panic(err)
 	}
@@ -691,8 +716,8 @@ func bE(h int64) string {
 	return fmt.Sprintf("%0.16X", h)
 }
 
-func keySuffix(evidence types.Evidence) []byte {
-	return []byte(fmt.Sprintf("%s/%X", bE(evidence.Height()), evidence.Hash()))
+func keySuffix(height int64, hash []byte) []byte {
+	return []byte(fmt.Sprintf("%s/%X", bE(height), hash))
 }
 
 // ---------------------
@@ -709,3 +734,53 @@ const (
 	baseKeyCommitted = byte(0x00)
 	baseKeyPending   = byte(0x01)
 )
+
+// KeyLayoutConverter returns the function rewriting the keys of an evidence
+// database from the fromVersion key layout to the toVersion one, for
+// keylayout.Migrate.
+func KeyLayoutConverter(fromVersion, toVersion string) (keylayout.ConvertFunc, error) {
+	switch {
+	case fromVersion == "v1" && toVersion == "v2":
+		return func(key []byte) ([]byte, bool, error) {
+			if len(key) == 0 || (key[0] != baseKeyCommitted && key[0] != baseKeyPending) {
+				return nil, false, nil
+			}
+			heightStr, hashStr, ok := strings.Cut(string(key[1:]), "/")
+			if !ok {
+				return nil, false, nil
+			}
+			height, err := strconv.ParseInt(heightStr, 16, 64)
+			if err != nil {
+				return nil, false, nil
+			}
+			hash, err := hex.DecodeString(hashStr)
+			if err != nil {
+				return nil, false, nil
+			}
+			if key[0] == baseKeyCommitted {
+				return v2Key(prefixCommitted, height, hash), true, nil
+			}
+			return v2Key(prefixPending, height, hash), true, nil
+		}, nil
+	case fromVersion == "v2" && toVersion == "v1":
+		return func(key []byte) ([]byte, bool, error) {
+			var (
+				prefix, height int64
+				hash           string
+			)
+			rest, err := orderedcode.Parse(string(key), &prefix, &height, &hash)
+			if err != nil || rest != "" {
+				return nil, false, nil
+			}
+			switch prefix {
+			case prefixCommitted:
+				return append([]byte{baseKeyCommitted}, keySuffix(height, []byte(hash))...), true, nil
+			case prefixPending:
+				return append([]byte{baseKeyPending}, keySuffix(height, []byte(hash))...), true, nil
+			}
+			return nil, false, nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert the evidence keys from %q to %q", fromVersion, toVersion)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/v2/internal/evidence"
 	"github.com/cometbft/cometbft/v2/internal/evidence/mocks"
+	"github.com/cometbft/cometbft/v2/internal/keylayout"
 	"github.com/cometbft/cometbft/v2/internal/test"
 	"github.com/cometbft/cometbft/v2/libs/log"
 	sm "github.com/cometbft/cometbft/v2/state"
@@ -231,6 +232,39 @@ func TestEvidencePoolUpdate(t *testing.T) {
 	}
 }
 
+func TestRemovePendingEvidence(t *testing.T) {
+	height := int64(21)
+	pool, val := defaultTestPool(t, height)
+
+	var evList []types.Evidence
+	for i := int64(1); i <= 3; i++ {
+		evHeight := height - i
+		ev, err := types.NewMockDuplicateVoteEvidenceWithValidator(evHeight,
+			defaultEvidenceTime.Add(time.Duration(evHeight)*time.Minute), val, evidenceChainID)
+		require.NoError(t, err)
+		require.NoError(t, pool.AddEvidence(ev))
+		evList = append(evList, ev)
+	}
+	require.Equal(t, uint32(3), pool.Size())
+
+	require.NoError(t, pool.RemovePendingEvidence(evList[1].Hash()))
+	assert.Equal(t, uint32(2), pool.Size())
+	pending, _ := pool.PendingEvidence(-1)
+	assert.ElementsMatch(t, []types.Evidence{evList[0], evList[2]}, pending)
+	for e := pool.EvidenceFront(); e != nil; e = e.Next() {
+		assert.NotEqual(t, evList[1].Hash(), e.Value.(types.Evidence).Hash())
+	}
+
+	// the evidence is no longer pending
+	err := pool.RemovePendingEvidence(evList[1].Hash())
+	require.ErrorIs(t, err, evidence.ErrPendingEvidenceNotFound)
+	assert.Equal(t, uint32(2), pool.Size())
+
+	// and can be added again
+	require.NoError(t, pool.AddEvidence(evList[1]))
+	assert.Equal(t, uint32(3), pool.Size())
+}
+
 func TestVerifyPendingEvidencePasses(t *testing.T) {
 	var height int64 = 1
 	pool, val := defaultTestPool(t, height)
@@ -484,3 +518,46 @@ func createState(height int64, valSet *types.ValidatorSet) sm.State {
 		ConsensusParams: *types.DefaultConsensusParams(),
 	}
 }
+
+func TestKeyLayoutMigration(t *testing.T) {
+	height := int64(21)
+	val := types.NewMockPV()
+	valAddress := val.PrivKey.PubKey().Address()
+	evidenceDB := dbm.NewMemDB()
+	stateStore := initializeValidatorState(val, height)
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	blockStore, err := initializeBlockStore(dbm.NewMemDB(), state, valAddress)
+	require.NoError(t, err)
+	pool, err := evidence.NewPool(evidenceDB, stateStore, blockStore)
+	require.NoError(t, err)
+
+	pendingEv, err := types.NewMockDuplicateVoteEvidenceWithValidator(height-1, defaultEvidenceTime.Add(20*time.Minute),
+		val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.AddEvidence(pendingEv))
+	committedEv, err := types.NewMockDuplicateVoteEvidenceWithValidator(height, defaultEvidenceTime.Add(21*time.Minute),
+		val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pool.CheckEvidence(types.EvidenceList{committedEv}))
+	state = pool.State()
+	state.LastBlockHeight = height + 1
+	state.LastBlockTime = defaultEvidenceTime.Add(22 * time.Minute)
+	pool.Update(state, types.EvidenceList{committedEv})
+
+	for _, versions := range [][2]string{{"v1", "v2"}, {"v2", "v1"}} {
+		convert, err := evidence.KeyLayoutConverter(versions[0], versions[1])
+		require.NoError(t, err)
+		migrated, err := keylayout.Migrate(evidenceDB, versions[1], convert, nil)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, migrated)
+
+		newPool, err := evidence.NewPool(evidenceDB, stateStore, blockStore)
+		require.NoError(t, err)
+		evList, _ := newPool.PendingEvidence(defaultEvidenceMaxBytes)
+		require.Equal(t, []types.Evidence{pendingEv}, evList)
+		err = newPool.CheckEvidence(types.EvidenceList{committedEv})
+		require.Error(t, err)
+		require.Equal(t, evidence.ErrEvidenceAlreadyCommitted.Error(), err.(*types.ErrInvalidEvidence).Reason.Error())
+	}
+}
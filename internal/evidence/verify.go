@@ -118,7 +118,12 @@ func VerifyLightClientAttack(
 	// In the case of lunatic attack there will be a different commonHeader height. Therefore the node perform a single
 	// verification jump between the common header and the conflicting one
 	if commonHeader.Height != e.ConflictingBlock.Height {
-		err := commonVals.VerifyCommitLightTrustingAllSignatures(trustedHeader.ChainID, e.ConflictingBlock.Commit, light.DefaultTrustLevel)
+		var err error
+		if e.ConflictingBlock.Commit.HasAggregatedSignature() {
+			err = verifyAggregatedCommitLightTrusting(e, trustedHeader.ChainID, commonVals)
+		} else {
+			err = commonVals.VerifyCommitLightTrustingAllSignatures(trustedHeader.ChainID, e.ConflictingBlock.Commit, light.DefaultTrustLevel)
+		}
 		if err != nil {
 			return ErrConflictingBlock{fmt.Errorf("skipping verification of conflicting block failed: %w", err)}
 		}
@@ -261,6 +266,26 @@ func validateABCIEvidence(
 	return nil
 }
 
+// verifyAggregatedCommitLightTrusting verifies that the common validators
+// signed the commit of the conflicting block, whose signatures are aggregated.
+//
+// The aggregated signature can only be verified with the keys of all its
+// signers, which commonVals may not include. It is thus verified with the
+// validator set of the conflicting block first, which caches it. All the
+// signatures of the commit are verified against that set afterwards anyway.
+func verifyAggregatedCommitLightTrusting(
+	e *types.LightClientAttackEvidence,
+	chainID string,
+	commonVals *types.ValidatorSet,
+) error {
+	verifiedSignatureCache := types.NewSignatureCache()
+	if err := e.ConflictingBlock.ValidatorSet.VerifyCommitLightWithCache(chainID, e.ConflictingBlock.Commit.BlockID,
+		e.ConflictingBlock.Height, e.ConflictingBlock.Commit, verifiedSignatureCache); err != nil {
+		return err
+	}
+	return commonVals.VerifyCommitLightTrustingWithCache(chainID, e.ConflictingBlock.Commit, light.DefaultTrustLevel, verifiedSignatureCache)
+}
+
 func getSignedHeader(blockStore BlockStore, height int64) (*types.SignedHeader, error) {
 	blockMeta := blockStore.LoadBlockMeta(height)
 	if blockMeta == nil {
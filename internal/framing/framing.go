@@ -0,0 +1,79 @@
+// Package framing implements the framing of the records of append-only logs
+// and streams, such as the sign log of the privval package, block store
+// exports and ABCI recordings. It is the framing of the consensus WAL: each
+// record is preceded by the CRC-32C checksum (4 bytes) and the length (4
+// bytes) of its data, both big-endian.
+package framing
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// HeaderSize is the size of the header preceding the data of a record.
+const HeaderSize = 8
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+var (
+	// ErrInvalidLength is returned when the length of a record is zero or
+	// greater than the maximum size.
+	ErrInvalidLength = errors.New("invalid record length")
+	// ErrChecksumMismatch is returned when the data of a record does not
+	// match its checksum.
+	ErrChecksumMismatch = errors.New("record checksum mismatch")
+)
+
+// Encode returns the record holding data. It returns ErrInvalidLength if data
+// is empty or greater than maxSize bytes.
+func Encode(data []byte, maxSize int) ([]byte, error) {
+	if len(data) == 0 || len(data) > maxSize {
+		return nil, fmt.Errorf("%w: %d bytes, max: %d bytes", ErrInvalidLength, len(data), maxSize)
+	}
+	record := make([]byte, HeaderSize+len(data))
+	binary.BigEndian.PutUint32(record[0:4], crc32.Checksum(data, crc32c))
+	binary.BigEndian.PutUint32(record[4:8], uint32(len(data)))
+	copy(record[HeaderSize:], data)
+	return record, nil
+}
+
+// Write writes the record holding data to w. See Encode.
+func Write(w io.Writer, data []byte, maxSize int) error {
+	record, err := Encode(data, maxSize)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(record)
+	return err
+}
+
+// Read reads a record from r and returns its data. It returns io.EOF if r
+// has no more data, and io.ErrUnexpectedEOF if r ends in the middle of a
+// record, e.g. because of a crash during its write. A length of zero or
+// greater than maxSize bytes is reported as ErrInvalidLength, which guards
+// against allocating huge buffers for corrupted lengths.
+func Read(r io.Reader, maxSize int) ([]byte, error) {
+	header := make([]byte, HeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	crc := binary.BigEndian.Uint32(header[0:4])
+	length := binary.BigEndian.Uint32(header[4:8])
+	if length == 0 || uint64(length) > uint64(maxSize) {
+		return nil, fmt.Errorf("%w: %d bytes, max: %d bytes", ErrInvalidLength, length, maxSize)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if actual := crc32.Checksum(data, crc32c); actual != crc {
+		return nil, fmt.Errorf("%w: read: %d, actual: %d", ErrChecksumMismatch, crc, actual)
+	}
+	return data, nil
+}
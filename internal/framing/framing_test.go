@@ -0,0 +1,55 @@
+package framing
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadWrite(t *testing.T) {
+	var buf bytes.Buffer
+	records := [][]byte{[]byte("a"), []byte("hello"), bytes.Repeat([]byte{0xff}, 1024)}
+	for _, data := range records {
+		require.NoError(t, Write(&buf, data, 1024))
+	}
+	for _, data := range records {
+		read, err := Read(&buf, 1024)
+		require.NoError(t, err)
+		assert.Equal(t, data, read)
+	}
+	_, err := Read(&buf, 1024)
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestEncodeInvalidLength(t *testing.T) {
+	_, err := Encode(nil, 10)
+	require.ErrorIs(t, err, ErrInvalidLength)
+	_, err = Encode(make([]byte, 11), 10)
+	require.ErrorIs(t, err, ErrInvalidLength)
+}
+
+func TestReadInvalid(t *testing.T) {
+	record, err := Encode([]byte("hello"), 10)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		record  []byte
+		maxSize int
+		err     error
+	}{
+		"truncated header": {record[:HeaderSize/2], 10, io.ErrUnexpectedEOF},
+		"truncated data":   {record[:len(record)-1], 10, io.ErrUnexpectedEOF},
+		"zeroed":           {make([]byte, len(record)), 10, ErrInvalidLength},
+		"too big":          {record, 4, ErrInvalidLength},
+		"corrupted data":   {append(record[:len(record)-1:len(record)-1], 'x'), 10, ErrChecksumMismatch},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Read(bytes.NewReader(tc.record), tc.maxSize)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
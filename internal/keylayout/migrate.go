@@ -0,0 +1,161 @@
+// Package keylayout rewrites the keys of the databases of a node from a key
+// layout to another, see StorageConfig.ExperimentalKeyLayout.
+package keylayout
+
+import (
+	"errors"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+)
+
+// VersionKey is the key under which the stores record the key layout of their
+// database.
+var VersionKey = []byte("version")
+
+// migrationBatchSize is the number of keys rewritten per batch.
+const migrationBatchSize = 1000
+
+// ConvertFunc returns the key of the target layout corresponding to key, and
+// true, if key is a key of the source layout. It returns false for the keys
+// that do not depend on the layout, and for the keys of the target layout.
+type ConvertFunc func(key []byte) ([]byte, bool, error)
+
+// Count returns the number of keys of db that convert rewrites.
+func Count(db dbm.DB, convert ConvertFunc) (int64, error) {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var count int64
+	for ; iter.Valid(); iter.Next() {
+		if _, ok, err := convert(iter.Key()); err != nil {
+			return count, err
+		} else if ok {
+			count++
+		}
+	}
+	return count, iter.Error()
+}
+
+// Migrate rewrites, in place, the keys of db converted by convert, then
+// records toVersion as the key layout of db. progress, if not nil, is called
+// with the number of keys rewritten after each batch.
+//
+// Each key is rewritten along with the deletion of the original key in the
+// same batch, so an interrupted migration is resumed by calling Migrate again.
+// The keys of both layouts must not overlap, and the database must not be
+// used by a node during the migration.
+func Migrate(db dbm.DB, toVersion string, convert ConvertFunc, progress func(migrated int64)) (int64, error) {
+	var (
+		migrated int64
+		start    []byte
+	)
+	for {
+		n, next, err := migrateBatch(db, start, convert)
+		if err != nil {
+			return migrated, err
+		}
+		migrated += int64(n)
+		if progress != nil && n > 0 {
+			progress(migrated)
+		}
+		if next == nil {
+			break
+		}
+		start = next
+	}
+
+	if err := Verify(db, convert); err != nil {
+		return migrated, err
+	}
+	return migrated, db.SetSync(VersionKey, []byte(toVersion))
+}
+
+// migrateBatch rewrites up to migrationBatchSize keys from start, and returns
+// the key to continue from, or nil once all the keys were read.
+func migrateBatch(db dbm.DB, start []byte, convert ConvertFunc) (int, []byte, error) {
+	iter, err := db.Iterator(start, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	n := 0
+	for ; iter.Valid() && n < migrationBatchSize; iter.Next() {
+		newKey, ok, err := convert(iter.Key())
+		if err != nil {
+			iter.Close()
+			return 0, nil, err
+		}
+		if !ok {
+			continue
+		}
+		if err := batch.Set(newKey, iter.Value()); err != nil {
+			iter.Close()
+			return 0, nil, err
+		}
+		if err := batch.Delete(iter.Key()); err != nil {
+			iter.Close()
+			return 0, nil, err
+		}
+		n++
+	}
+	var next []byte
+	if iter.Valid() {
+		next = append([]byte{}, iter.Key()...)
+	}
+	if err := iter.Error(); err != nil {
+		iter.Close()
+		return 0, nil, err
+	}
+	// The batch is written once the iterator is released, as some backends
+	// do not support writes during an iteration.
+	if err := iter.Close(); err != nil {
+		return 0, nil, err
+	}
+	if n == 0 {
+		return 0, next, nil
+	}
+	return n, next, batch.WriteSync()
+}
+
+// Verify returns an error if db still has keys that convert rewrites.
+func Verify(db dbm.DB, convert ConvertFunc) error {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if _, ok, err := convert(iter.Key()); err != nil {
+			return err
+		} else if ok {
+			return fmt.Errorf("key %X was not migrated", iter.Key())
+		}
+	}
+	return iter.Error()
+}
+
+// ErrUnknownVersion is returned for key layout versions other than v1 and v2.
+var ErrUnknownVersion = errors.New("unknown key layout version, expected v1 or v2")
+
+// Version returns the key layout recorded in db, or "v1" if none is.
+func Version(db dbm.DB) (string, error) {
+	version, err := db.Get(VersionKey)
+	if err != nil {
+		return "", err
+	}
+	switch string(version) {
+	case "", "v1":
+		return "v1", nil
+	case "v2":
+		return "v2", nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownVersion, version)
+	}
+}
@@ -0,0 +1,74 @@
+package keylayout
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+)
+
+// convertOldKey rewrites the keys prefixed with "old:" to "new:".
+func convertOldKey(key []byte) ([]byte, bool, error) {
+	rest, ok := bytes.CutPrefix(key, []byte("old:"))
+	if !ok {
+		return nil, false, nil
+	}
+	return append([]byte("new:"), rest...), true, nil
+}
+
+func TestMigrate(t *testing.T) {
+	db := dbm.NewMemDB()
+	numKeys := 2*migrationBatchSize + 10
+	for i := 0; i < numKeys; i++ {
+		require.NoError(t, db.Set([]byte(fmt.Sprintf("old:%05d", i)), []byte{byte(i)}))
+	}
+	require.NoError(t, db.Set([]byte("other"), []byte("value")))
+
+	// An interrupted migration leaves keys of both layouts.
+	n, _, err := migrateBatch(db, nil, convertOldKey)
+	require.NoError(t, err)
+	require.Equal(t, migrationBatchSize, n)
+	require.Error(t, Verify(db, convertOldKey))
+	count, err := Count(db, convertOldKey)
+	require.NoError(t, err)
+	require.EqualValues(t, numKeys-migrationBatchSize, count)
+
+	var progress []int64
+	migrated, err := Migrate(db, "v2", convertOldKey, func(n int64) { progress = append(progress, n) })
+	require.NoError(t, err)
+	assert.EqualValues(t, numKeys-migrationBatchSize, migrated)
+	assert.Equal(t, []int64{migrationBatchSize, int64(numKeys - migrationBatchSize)}, progress)
+	require.NoError(t, Verify(db, convertOldKey))
+
+	for i := 0; i < numKeys; i++ {
+		value, err := db.Get([]byte(fmt.Sprintf("new:%05d", i)))
+		require.NoError(t, err)
+		require.Equal(t, []byte{byte(i)}, value)
+	}
+	value, err := db.Get([]byte("other"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	version, err := Version(db)
+	require.NoError(t, err)
+	require.Equal(t, "v2", version)
+
+	// Migrating again is a no-op.
+	migrated, err = Migrate(db, "v2", convertOldKey, nil)
+	require.NoError(t, err)
+	require.Zero(t, migrated)
+}
+
+func TestVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	version, err := Version(db)
+	require.NoError(t, err)
+	require.Equal(t, "v1", version)
+
+	require.NoError(t, db.Set(VersionKey, []byte("v3")))
+	_, err = Version(db)
+	require.ErrorIs(t, err, ErrUnknownVersion)
+}
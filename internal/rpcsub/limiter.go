@@ -0,0 +1,89 @@
+package rpcsub
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/peer"
+
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+)
+
+// ErrMaxClients is returned by Limiter.Acquire when the maximum number of
+// clients with subscriptions is reached.
+type ErrMaxClients struct {
+	Max int
+}
+
+func (e ErrMaxClients) Error() string {
+	return fmt.Sprintf("maximum number of subscription clients reached: %d", e.Max)
+}
+
+// ErrMaxPerClient is returned by Limiter.Acquire when a client has reached the
+// maximum number of subscriptions.
+type ErrMaxPerClient struct {
+	Max int
+}
+
+func (e ErrMaxPerClient) Error() string {
+	return fmt.Sprintf("maximum number of subscriptions per client reached: %d", e.Max)
+}
+
+// Limiter limits the number of clients with subscriptions, and the number of
+// subscriptions of each client. Clients are identified by their address.
+type Limiter struct {
+	maxClients   int
+	maxPerClient int
+
+	mtx     cmtsync.Mutex
+	clients map[string]int // number of subscriptions, by client
+}
+
+// NewLimiter returns a limiter allowing up to maxClients clients with
+// subscriptions, with up to maxPerClient subscriptions each.
+func NewLimiter(maxClients, maxPerClient int) *Limiter {
+	return &Limiter{
+		maxClients:   maxClients,
+		maxPerClient: maxPerClient,
+		clients:      make(map[string]int),
+	}
+}
+
+// Acquire reserves a subscription for client, or returns ErrMaxClients or
+// ErrMaxPerClient if a limit is reached. The subscription must be released
+// with Release once it ends.
+func (l *Limiter) Acquire(client string) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	n, ok := l.clients[client]
+	switch {
+	case !ok && len(l.clients) >= l.maxClients:
+		return ErrMaxClients{Max: l.maxClients}
+	case n >= l.maxPerClient:
+		return ErrMaxPerClient{Max: l.maxPerClient}
+	}
+	l.clients[client] = n + 1
+	return nil
+}
+
+// Release releases a subscription reserved by Acquire.
+func (l *Limiter) Release(client string) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if n := l.clients[client]; n > 1 {
+		l.clients[client] = n - 1
+	} else {
+		delete(l.clients, client)
+	}
+}
+
+// PeerAddr returns the address of the gRPC client of ctx, to identify it, or
+// an empty string if it is unknown.
+func PeerAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
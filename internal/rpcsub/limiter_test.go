@@ -0,0 +1,25 @@
+package rpcsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	l := NewLimiter(2, 2)
+
+	require.NoError(t, l.Acquire("a"))
+	require.NoError(t, l.Acquire("a"))
+	require.ErrorAs(t, l.Acquire("a"), &ErrMaxPerClient{})
+
+	require.NoError(t, l.Acquire("b"))
+	require.ErrorAs(t, l.Acquire("c"), &ErrMaxClients{})
+
+	// Once all its subscriptions are released, a client no longer counts.
+	l.Release("a")
+	require.NoError(t, l.Acquire("a"))
+	l.Release("b")
+	require.NoError(t, l.Acquire("c"))
+	require.ErrorAs(t, l.Acquire("b"), &ErrMaxClients{})
+}
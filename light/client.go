@@ -125,6 +125,25 @@ func MaxBlockLag(d time.Duration) Option {
 	}
 }
 
+// PrefetchConcurrency option sets the maximum number of light blocks fetched
+// concurrently and ahead of time during skipping verification: the light
+// blocks at the heights the light client may need next from the primary, and
+// the new light block from the witnesses. It reduces the number of round-trips
+// needed to verify a light block far from the trusted one.
+// Default: 0, the light blocks are fetched when they are needed.
+func PrefetchConcurrency(n uint16) Option {
+	return func(c *Client) {
+		c.prefetchConcurrency = n
+	}
+}
+
+// WithMetrics sets the metrics.
+func WithMetrics(metrics *Metrics) Option {
+	return func(c *Client) {
+		c.metrics = metrics
+	}
+}
+
 // Client represents a light client, connected to a single chain, which gets
 // light blocks from a primary provider, verifies them either sequentially or by
 // skipping some and stores them in a trusted store (usually, a local FS).
@@ -138,6 +157,8 @@ type Client struct {
 	maxRetryAttempts uint16 // see MaxRetryAttempts option
 	maxClockDrift    time.Duration
 	maxBlockLag      time.Duration
+	// See PrefetchConcurrency option
+	prefetchConcurrency uint16
 
 	// Mutex for locking during changes of the light clients providers
 	providerMutex cmtsync.Mutex
@@ -158,7 +179,8 @@ type Client struct {
 
 	quit chan struct{}
 
-	logger log.Logger
+	logger  log.Logger
+	metrics *Metrics
 }
 
 // NewClient returns a new light client. It returns an error if it fails to
@@ -232,6 +254,7 @@ func NewClientFromTrustedStore(
 		confirmationFn:   func(_ string) bool { return true },
 		quit:             make(chan struct{}),
 		logger:           log.NewNopLogger(),
+		metrics:          NopMetrics(),
 	}
 
 	for _, o := range options {
@@ -616,6 +639,8 @@ func (c *Client) verifySequential(
 		interimBlock  *types.LightBlock
 		err           error
 		trace         = []*types.LightBlock{trustedBlock}
+		// light blocks fetched ahead of time from a provider.RangeProvider
+		prefetched []*types.LightBlock
 	)
 
 	for height := trustedBlock.Height + 1; height <= newLightBlock.Height; height++ {
@@ -623,7 +648,7 @@ func (c *Client) verifySequential(
 		if height == newLightBlock.Height { // last light block
 			interimBlock = newLightBlock
 		} else { // intermediate light blocks
-			interimBlock, err = c.lightBlockFromPrimary(ctx, height)
+			interimBlock, prefetched, err = c.interimLightBlockFromPrimary(ctx, height, newLightBlock.Height-1, prefetched)
 			if err != nil {
 				return ErrVerificationFailed{From: verifiedBlock.Height, To: height, Reason: err}
 			}
@@ -669,6 +694,7 @@ func (c *Client) verifySequential(
 
 				// attempt to verify header again
 				height--
+				prefetched = nil
 
 				continue
 			default:
@@ -688,7 +714,7 @@ func (c *Client) verifySequential(
 	//
 	// CORRECTNESS ASSUMPTION: there's at least 1 correct full node
 	// (primary or one of the witnesses).
-	return c.detectDivergence(ctx, trace, now)
+	return c.detectDivergence(ctx, nil, trace, now)
 }
 
 // see VerifyHeader
@@ -698,9 +724,13 @@ func (c *Client) verifySequential(
 // requested from source is kept such that when a verification is made, and the
 // light client tries again to verify the new light block in the middle, the light
 // client does not need to ask for all the same light blocks again.
+//
+// The light blocks at the pivot heights requested next if the verifications
+// keep failing are prefetched by pf.
 func (c *Client) verifySkipping(
 	ctx context.Context,
 	source provider.Provider,
+	pf *lightBlockPrefetcher,
 	trustedBlock *types.LightBlock,
 	newLightBlock *types.LightBlock,
 	now time.Time,
@@ -737,13 +767,15 @@ func (c *Client) verifySkipping(
 			depth = 0
 			// add verifiedBlock to the trace
 			trace = append(trace, verifiedBlock)
+			// the next pivots are below the lowest light block in the cache
+			c.prefetchPivots(pf, source, verifiedBlock.Height, blockCache[len(blockCache)-1].Height)
 
 		case ErrNewValSetCantBeTrusted:
 			// do add another header to the end of the cache
 			if depth == len(blockCache)-1 {
-				pivotHeight := verifiedBlock.Height + (blockCache[depth].Height-verifiedBlock.
-					Height)*verifySkippingNumerator/verifySkippingDenominator
-				interimBlock, providerErr := source.LightBlock(ctx, pivotHeight)
+				pivotHeight := skippingPivot(verifiedBlock.Height, blockCache[depth].Height)
+				c.prefetchPivots(pf, source, verifiedBlock.Height, blockCache[depth].Height)
+				interimBlock, providerErr := pf.lightBlock(ctx, source, pivotHeight)
 				switch providerErr {
 				case nil:
 					blockCache = append(blockCache, interimBlock)
@@ -767,6 +799,28 @@ func (c *Client) verifySkipping(
 	}
 }
 
+// skippingPivot returns the height of the light block verifySkipping requests
+// when the light block at height cannot be verified from the trusted one.
+func skippingPivot(trustedHeight, height int64) int64 {
+	return trustedHeight + (height-trustedHeight)*verifySkippingNumerator/verifySkippingDenominator
+}
+
+// prefetchPivots prefetches from source the light blocks at the pivot heights
+// verifySkipping requests if none of the light blocks below height can be
+// verified from the trusted one, up to the prefetch concurrency.
+func (c *Client) prefetchPivots(pf *lightBlockPrefetcher, source provider.Provider, trustedHeight, height int64) {
+	if pf == nil {
+		return
+	}
+	for i := uint16(0); i < c.prefetchConcurrency; i++ {
+		height = skippingPivot(trustedHeight, height)
+		if height <= trustedHeight {
+			return
+		}
+		pf.prefetch(source, height)
+	}
+}
+
 // verifySkippingAgainstPrimary does verifySkipping plus it compares new header with
 // witnesses and replaces primary if it sends the light client an invalid header.
 func (c *Client) verifySkippingAgainstPrimary(
@@ -775,7 +829,16 @@ func (c *Client) verifySkippingAgainstPrimary(
 	newLightBlock *types.LightBlock,
 	now time.Time,
 ) error {
-	trace, err := c.verifySkipping(ctx, c.primary, trustedBlock, newLightBlock, now)
+	pf := c.newPrefetcher(ctx)
+	defer pf.close()
+	// the witnesses are asked for the new light block once it is verified
+	c.providerMutex.Lock()
+	for _, witness := range c.witnesses {
+		pf.prefetch(witness, newLightBlock.Height)
+	}
+	c.providerMutex.Unlock()
+
+	trace, err := c.verifySkipping(ctx, c.primary, pf, trustedBlock, newLightBlock, now)
 
 	switch errors.Unwrap(err).(type) {
 	case ErrInvalidHeader:
@@ -812,7 +875,7 @@ func (c *Client) verifySkippingAgainstPrimary(
 		//
 		// CORRECTNESS ASSUMPTION: there's at least 1 correct full node
 		// (primary or one of the witnesses).
-		if cmpErr := c.detectDivergence(ctx, trace, now); cmpErr != nil {
+		if cmpErr := c.detectDivergence(ctx, pf, trace, now); cmpErr != nil {
 			return cmpErr
 		}
 	default:
@@ -863,6 +926,15 @@ func (c *Client) Witnesses() []provider.Provider {
 	return c.witnesses
 }
 
+// AttackEvidence returns the evidence of the light client attacks detected,
+// against the primary or a witness, ordered by common height. The evidence is
+// kept in the trusted store, even after Cleanup.
+//
+// Safe for concurrent use by multiple goroutines.
+func (c *Client) AttackEvidence() ([]*types.LightClientAttackEvidence, error) {
+	return c.trustedStore.LightClientAttackEvidence()
+}
+
 // Cleanup removes all the data (headers and validator sets) stored. Note: the
 // client must be stopped at this point.
 func (c *Client) Cleanup() error {
@@ -1005,6 +1077,43 @@ func (c *Client) lightBlockFromPrimary(ctx context.Context, height int64) (*type
 	}
 }
 
+// interimLightBlockFromPrimary returns the light block at height, taking it
+// from prefetched if possible. Otherwise, if the primary is a
+// provider.RangeProvider, the light blocks up to toHeight are fetched at once,
+// and the ones following height are returned to be used for the next heights.
+func (c *Client) interimLightBlockFromPrimary(
+	ctx context.Context,
+	height, toHeight int64,
+	prefetched []*types.LightBlock,
+) (*types.LightBlock, []*types.LightBlock, error) {
+	if len(prefetched) > 0 && prefetched[0].Height == height {
+		return prefetched[0], prefetched[1:], nil
+	}
+
+	var (
+		lbs []*types.LightBlock
+		err error
+	)
+	c.providerMutex.Lock()
+	rangePrimary, ok := c.primary.(provider.RangeProvider)
+	if ok {
+		lbs, err = rangePrimary.LightBlocks(ctx, height, toHeight)
+	}
+	c.providerMutex.Unlock()
+	if ok {
+		if err == nil {
+			return lbs[0], lbs[1:], nil
+		}
+		// Fall back to fetching the light block alone, replacing the primary
+		// if needed.
+		c.logger.Debug("failed to fetch light blocks from primary", "err", err,
+			"fromHeight", height, "toHeight", toHeight, "primary", rangePrimary)
+	}
+
+	l, err := c.lightBlockFromPrimary(ctx, height)
+	return l, nil, err
+}
+
 // NOTE: requires a providerMutex lock.
 func (c *Client) removeWitnesses(indexes []int) error {
 	// check that we will still have witnesses remaining
@@ -1131,7 +1240,7 @@ func (c *Client) compareFirstLightBlockWithWitnesses(ctx context.Context, l *typ
 
 	errc := make(chan error, len(c.witnesses))
 	for i, witness := range c.witnesses {
-		go c.compareNewLightBlockWithWitness(compareCtx, errc, l, witness, i)
+		go c.compareNewLightBlockWithWitness(compareCtx, nil, errc, l, witness, i)
 	}
 
 	witnessesToRemove := make([]int, 0, len(c.witnesses))
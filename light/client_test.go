@@ -2,7 +2,9 @@ package light_test
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -254,6 +256,52 @@ func TestClient_SequentialVerification(t *testing.T) {
 	}
 }
 
+// rangeMock is a mock provider returning at most 5 light blocks at once.
+type rangeMock struct {
+	*mockp.Mock
+	lightBlocksCalls int
+}
+
+func (p *rangeMock) LightBlocks(ctx context.Context, fromHeight, toHeight int64) ([]*types.LightBlock, error) {
+	p.lightBlocksCalls++
+	var lbs []*types.LightBlock
+	for height := fromHeight; height <= toHeight && height < fromHeight+5; height++ {
+		lb, err := p.LightBlock(ctx, height)
+		if err != nil {
+			return nil, err
+		}
+		lbs = append(lbs, lb)
+	}
+	return lbs, nil
+}
+
+func TestClient_SequentialVerificationRangeProvider(t *testing.T) {
+	chainID, headers, vals := genMockNode(20, 3, 0, bTime)
+	primary := &rangeMock{Mock: mockp.New(chainID, headers, vals)}
+
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		light.TrustOptions{
+			Period: 4 * time.Hour,
+			Height: 1,
+			Hash:   headers[1].Hash(),
+		},
+		primary,
+		[]provider.Provider{mockp.New(chainID, headers, vals)},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.SequentialVerification(),
+		light.Logger(log.TestingLogger()),
+	)
+	require.NoError(t, err)
+
+	l, err := c.VerifyLightBlockAtHeight(ctx, 20, bTime.Add(30*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, headers[20].Hash(), l.Hash())
+	// The interim light blocks 2 to 19 are fetched 5 at a time.
+	assert.Equal(t, 4, primary.lightBlocksCalls)
+}
+
 func TestClient_SkippingVerification(t *testing.T) {
 	// required for 2nd test case
 	newKeys := genPrivKeys(4)
@@ -377,6 +425,59 @@ func TestClient_SkippingVerification(t *testing.T) {
 	}
 }
 
+// slowProvider is a provider taking 20ms to respond, which records the maximum
+// number of requests it handled at once.
+type slowProvider struct {
+	provider.Provider
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (p *slowProvider) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	n := p.inFlight.Add(1)
+	defer p.inFlight.Add(-1)
+	for m := p.maxInFlight.Load(); n > m && !p.maxInFlight.CompareAndSwap(m, n); m = p.maxInFlight.Load() {
+	}
+	time.Sleep(20 * time.Millisecond)
+	return p.Provider.LightBlock(ctx, height)
+}
+
+func TestClient_SkippingVerificationPrefetch(t *testing.T) {
+	chainID, headers, vals := genMockNode(100, 10, 1, bTime)
+
+	for _, concurrency := range []uint16{0, 4} {
+		t.Run(fmt.Sprintf("concurrency=%d", concurrency), func(t *testing.T) {
+			primary := &slowProvider{Provider: mockp.New(chainID, headers, vals)}
+			witness := &slowProvider{Provider: mockp.New(chainID, headers, vals)}
+			c, err := light.NewClient(
+				ctx,
+				chainID,
+				light.TrustOptions{
+					Period: 24 * time.Hour,
+					Height: 1,
+					Hash:   headers[1].Hash(),
+				},
+				primary,
+				[]provider.Provider{witness},
+				dbs.New(dbm.NewMemDB(), chainID),
+				light.PrefetchConcurrency(concurrency),
+				light.Logger(log.TestingLogger()),
+			)
+			require.NoError(t, err)
+
+			l, err := c.VerifyLightBlockAtHeight(ctx, 100, bTime.Add(110*time.Minute))
+			require.NoError(t, err)
+			assert.Equal(t, headers[100].Hash(), l.Hash())
+			if concurrency == 0 {
+				assert.EqualValues(t, 1, primary.maxInFlight.Load())
+			} else {
+				// the pivots were fetched concurrently
+				assert.Greater(t, primary.maxInFlight.Load(), int32(1))
+			}
+		})
+	}
+}
+
 // start from a large light block to make sure that the pivot height doesn't select a height outside
 // the appropriate range.
 func TestClientLargeBisectionVerification(t *testing.T) {
@@ -24,7 +24,12 @@ import (
 //
 // If there are no conflicting headers, the light client deems the verified target header
 // trusted and saves it to the trusted store.
-func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.LightBlock, now time.Time) error {
+func (c *Client) detectDivergence(
+	ctx context.Context,
+	pf *lightBlockPrefetcher,
+	primaryTrace []*types.LightBlock,
+	now time.Time,
+) error {
 	if primaryTrace == nil || len(primaryTrace) < 2 {
 		return ErrNilOrSinglePrimaryTrace
 	}
@@ -48,7 +53,7 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 	// and compare it with the header from the primary
 	errc := make(chan error, len(c.witnesses))
 	for i, witness := range c.witnesses {
-		go c.compareNewLightBlockWithWitness(ctx, errc, lastVerifiedBlock, witness, i)
+		go c.compareNewLightBlockWithWitness(ctx, pf, errc, lastVerifiedBlock, witness, i)
 	}
 
 	// handle errors from the header comparisons as they come in
@@ -65,7 +70,7 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 			//
 			// We combine these actions together, verifying the witnesses headers and outputting the trace
 			// which captures the bifurcation point and if successful provides the information to create valid evidence.
-			err := c.handleConflictingHeaders(ctx, primaryTrace, e.Block, e.WitnessIndex, now)
+			err := c.handleConflictingHeaders(ctx, pf, primaryTrace, e.Block, e.WitnessIndex, now)
 			if err != nil {
 				// return information of the attack
 				return err
@@ -117,12 +122,12 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 //	Note: In the case of an invalid header we remove the witness
 //
 // 3: nil -> the hashes of the two headers match
-func (c *Client) compareNewLightBlockWithWitness(ctx context.Context, errc chan error, l *types.LightBlock,
-	witness provider.Provider, witnessIndex int,
+func (c *Client) compareNewLightBlockWithWitness(ctx context.Context, pf *lightBlockPrefetcher, errc chan error,
+	l *types.LightBlock, witness provider.Provider, witnessIndex int,
 ) {
 	h := l.SignedHeader
 
-	lightBlock, err := witness.LightBlock(ctx, h.Height)
+	lightBlock, err := pf.lightBlock(ctx, witness, h.Height)
 	switch err {
 	// no error means we move on to checking the hash of the two headers
 	case nil:
@@ -215,6 +220,15 @@ func (c *Client) compareNewLightBlockWithWitness(ctx context.Context, errc chan
 	errc <- nil
 }
 
+// recordEvidence persists evidence in the trusted store, so that it can be
+// reported later even if the providers ignore it.
+func (c *Client) recordEvidence(ev *types.LightClientAttackEvidence) {
+	c.metrics.AttacksDetected.Add(1)
+	if err := c.trustedStore.SaveLightClientAttackEvidence(ev); err != nil {
+		c.logger.Error("Failed to save evidence", "ev", ev, "err", err)
+	}
+}
+
 // sendEvidence sends evidence to a provider on a best effort basis.
 func (c *Client) sendEvidence(ctx context.Context, ev *types.LightClientAttackEvidence, receiver provider.Provider) {
 	err := receiver.ReportEvidence(ctx, ev)
@@ -227,6 +241,7 @@ func (c *Client) sendEvidence(ctx context.Context, ev *types.LightClientAttackEv
 // two headers of the same height but with different hashes.
 func (c *Client) handleConflictingHeaders(
 	ctx context.Context,
+	pf *lightBlockPrefetcher,
 	primaryTrace []*types.LightBlock,
 	challengingBlock *types.LightBlock,
 	witnessIndex int,
@@ -235,6 +250,7 @@ func (c *Client) handleConflictingHeaders(
 	supportingWitness := c.witnesses[witnessIndex]
 	witnessTrace, primaryBlock, err := c.examineConflictingHeaderAgainstTrace(
 		ctx,
+		pf,
 		primaryTrace,
 		challengingBlock,
 		supportingWitness,
@@ -251,6 +267,7 @@ func (c *Client) handleConflictingHeaders(
 	evidenceAgainstPrimary := newLightClientAttackEvidence(primaryBlock, trustedBlock, commonBlock)
 	c.logger.Error("ATTEMPTED ATTACK DETECTED. Sending evidence against primary by witness", "ev", evidenceAgainstPrimary,
 		"primary", c.primary, "witness", supportingWitness)
+	c.recordEvidence(evidenceAgainstPrimary)
 	c.sendEvidence(ctx, evidenceAgainstPrimary, supportingWitness)
 
 	if primaryBlock.Commit.Round != witnessTrace[len(witnessTrace)-1].Commit.Round {
@@ -264,6 +281,7 @@ func (c *Client) handleConflictingHeaders(
 	// respond but this is okay as we will halt anyway.
 	primaryTrace, witnessBlock, err := c.examineConflictingHeaderAgainstTrace(
 		ctx,
+		pf,
 		witnessTrace,
 		primaryBlock,
 		c.primary,
@@ -279,6 +297,7 @@ func (c *Client) handleConflictingHeaders(
 	evidenceAgainstWitness := newLightClientAttackEvidence(witnessBlock, trustedBlock, commonBlock)
 	c.logger.Error("Sending evidence against witness by primary", "ev", evidenceAgainstWitness,
 		"primary", c.primary, "witness", supportingWitness)
+	c.recordEvidence(evidenceAgainstWitness)
 	c.sendEvidence(ctx, evidenceAgainstWitness, c.primary)
 	// We return the error and don't process anymore witnesses
 	return ErrLightClientAttack
@@ -300,6 +319,7 @@ func (c *Client) handleConflictingHeaders(
 //  3. The
 func (c *Client) examineConflictingHeaderAgainstTrace(
 	ctx context.Context,
+	pf *lightBlockPrefetcher,
 	trace []*types.LightBlock,
 	targetBlock *types.LightBlock,
 	source provider.Provider, now time.Time,
@@ -314,6 +334,14 @@ func (c *Client) examineConflictingHeaderAgainstTrace(
 		return nil, nil, ErrTargetBlockHeightLessThanTrusted{Target: targetBlock.Height, Trusted: trace[0].Height}
 	}
 
+	// the blocks of the trace are all requested from the source, unless the divergence is found before
+	for _, traceBlock := range trace {
+		if traceBlock.Height >= targetBlock.Height {
+			break
+		}
+		pf.prefetch(source, traceBlock.Height)
+	}
+
 	for idx, traceBlock := range trace {
 		// this case only happens in a forward lunatic attack. We treat the block with the
 		// height directly after the targetBlock as the divergent block
@@ -329,7 +357,7 @@ func (c *Client) examineConflictingHeaderAgainstTrace(
 			// before sending back the divergent block and trace we need to ensure we have verified
 			// the final gap between the previouslyVerifiedBlock and the targetBlock
 			if previouslyVerifiedBlock.Height != targetBlock.Height {
-				sourceTrace, err = c.verifySkipping(ctx, source, previouslyVerifiedBlock, targetBlock, now)
+				sourceTrace, err = c.verifySkipping(ctx, source, pf, previouslyVerifiedBlock, targetBlock, now)
 				if err != nil {
 					return nil, nil, ErrVerifySkipping{Err: err}
 				}
@@ -341,7 +369,7 @@ func (c *Client) examineConflictingHeaderAgainstTrace(
 		if traceBlock.Height == targetBlock.Height {
 			sourceBlock = targetBlock
 		} else {
-			sourceBlock, err = source.LightBlock(ctx, traceBlock.Height)
+			sourceBlock, err = pf.lightBlock(ctx, source, traceBlock.Height)
 			if err != nil {
 				return nil, nil, ErrExamineTrace{Err: err}
 			}
@@ -359,7 +387,7 @@ func (c *Client) examineConflictingHeaderAgainstTrace(
 
 		// we check that the source provider can verify a block at the same height of the
 		// intermediate height
-		sourceTrace, err = c.verifySkipping(ctx, source, previouslyVerifiedBlock, sourceBlock, now)
+		sourceTrace, err = c.verifySkipping(ctx, source, pf, previouslyVerifiedBlock, sourceBlock, now)
 		if err != nil {
 			return nil, nil, ErrVerifySkipping{Err: err}
 		}
@@ -86,12 +86,22 @@ func TestLightClientAttackEvidence_Lunatic(t *testing.T) {
 		CommonHeight: 4,
 	}
 	assert.True(t, primary.HasEvidence(evAgainstWitness))
+
+	// Check the evidence was recorded.
+	evList, err := c.AttackEvidence()
+	require.NoError(t, err)
+	evHashes := make([][]byte, 0, len(evList))
+	for _, ev := range evList {
+		evHashes = append(evHashes, ev.Hash())
+	}
+	assert.ElementsMatch(t, [][]byte{evAgainstPrimary.Hash(), evAgainstWitness.Hash()}, evHashes)
 }
 
 func TestLightClientAttackEvidence_Equivocation(t *testing.T) {
 	verificationOptions := map[string]light.Option{
-		"sequential": light.SequentialVerification(),
-		"skipping":   light.SkippingVerification(light.DefaultTrustLevel),
+		"sequential":             light.SequentialVerification(),
+		"skipping":               light.SkippingVerification(light.DefaultTrustLevel),
+		"skipping (prefetching)": light.PrefetchConcurrency(4),
 	}
 
 	for s, verificationOption := range verificationOptions {
@@ -5,6 +5,7 @@ import (
 
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/v2/crypto"
+	"github.com/cometbft/cometbft/v2/crypto/bls12381"
 	"github.com/cometbft/cometbft/v2/crypto/ed25519"
 	"github.com/cometbft/cometbft/v2/crypto/tmhash"
 	"github.com/cometbft/cometbft/v2/types"
@@ -30,6 +31,20 @@ func genPrivKeys(n int) privKeys {
 	return res
 }
 
+// genBls12381PrivKeys produces an array of BLS12-381 private keys to generate
+// commits.
+func genBls12381PrivKeys(n int) privKeys {
+	res := make(privKeys, n)
+	for i := range res {
+		privKey, err := bls12381.GenPrivKey()
+		if err != nil {
+			panic(err)
+		}
+		res[i] = privKey
+	}
+	return res
+}
+
 // // Change replaces the key at index i.
 // func (pkz privKeys) Change(i int) privKeys {
 // 	res := make(privKeys, len(pkz))
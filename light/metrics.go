@@ -0,0 +1,20 @@
+package light
+
+import (
+	"github.com/cometbft/cometbft/v2/libs/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "light"
+)
+
+//go:generate go run ../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of light client attacks detected, counting each evidence created
+	// against the primary or a witness.
+	AttacksDetected metrics.Counter
+}
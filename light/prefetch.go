@@ -0,0 +1,118 @@
+package light
+
+import (
+	"context"
+	"reflect"
+
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+	"github.com/cometbft/cometbft/v2/light/provider"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// lightBlockPrefetcher fetches light blocks from providers ahead of time, at
+// most concurrency at once, and keeps them until they are requested. It is
+// used for a single verification, and closed once it is done.
+//
+// A nil *lightBlockPrefetcher fetches the light blocks when they are requested.
+type lightBlockPrefetcher struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	sem    chan struct{}
+
+	mtx     cmtsync.Mutex
+	fetches map[prefetchKey]*lightBlockFetch
+}
+
+type prefetchKey struct {
+	source provider.Provider
+	height int64
+}
+
+type lightBlockFetch struct {
+	done       chan struct{}
+	lightBlock *types.LightBlock
+	err        error
+}
+
+// newPrefetcher returns a prefetcher for a verification, or nil if
+// prefetching is disabled.
+func (c *Client) newPrefetcher(ctx context.Context) *lightBlockPrefetcher {
+	if c.prefetchConcurrency == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &lightBlockPrefetcher{
+		ctx:     ctx,
+		cancel:  cancel,
+		sem:     make(chan struct{}, c.prefetchConcurrency),
+		fetches: make(map[prefetchKey]*lightBlockFetch),
+	}
+}
+
+// prefetch starts fetching the light block at height from source, unless it
+// is already.
+func (pf *lightBlockPrefetcher) prefetch(source provider.Provider, height int64) {
+	// The providers are keys of the cache, which requires them to be
+	// comparable.
+	if pf == nil || !reflect.TypeOf(source).Comparable() {
+		return
+	}
+	key := prefetchKey{source: source, height: height}
+
+	pf.mtx.Lock()
+	defer pf.mtx.Unlock()
+	if _, ok := pf.fetches[key]; ok {
+		return
+	}
+	fetch := &lightBlockFetch{done: make(chan struct{})}
+	pf.fetches[key] = fetch
+
+	go func() {
+		defer close(fetch.done)
+		select {
+		case pf.sem <- struct{}{}:
+		case <-pf.ctx.Done():
+			fetch.err = pf.ctx.Err()
+			return
+		}
+		fetch.lightBlock, fetch.err = source.LightBlock(pf.ctx, height)
+		<-pf.sem
+	}()
+}
+
+// lightBlock returns the light block at height from source, waiting for it if
+// it is being prefetched. If prefetching it failed, it is fetched again, so
+// that the errors are the ones of source.LightBlock.
+func (pf *lightBlockPrefetcher) lightBlock(
+	ctx context.Context,
+	source provider.Provider,
+	height int64,
+) (*types.LightBlock, error) {
+	if pf == nil || !reflect.TypeOf(source).Comparable() {
+		return source.LightBlock(ctx, height)
+	}
+	key := prefetchKey{source: source, height: height}
+
+	pf.mtx.Lock()
+	fetch, ok := pf.fetches[key]
+	pf.mtx.Unlock()
+	if ok {
+		select {
+		case <-fetch.done:
+			if fetch.err == nil {
+				return fetch.lightBlock, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return source.LightBlock(ctx, height)
+}
+
+// close stops the fetches in progress.
+func (pf *lightBlockPrefetcher) close() {
+	if pf != nil {
+		pf.cancel()
+	}
+}
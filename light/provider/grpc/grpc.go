@@ -0,0 +1,168 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/v2/light/provider"
+	grpcclient "github.com/cometbft/cometbft/v2/rpc/grpc/client"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+const timeout = 5 * time.Second
+
+// ErrReportEvidenceUnsupported is returned by ReportEvidence, as the gRPC API
+// of the nodes does not accept evidence.
+var ErrReportEvidenceUnsupported = errors.New("reporting evidence is not supported over gRPC")
+
+// grpc provider uses the BlockService gRPC API to obtain the necessary
+// information.
+type grpc struct {
+	chainID string
+	remote  string
+	client  grpcclient.BlockServiceClient
+}
+
+var _ provider.RangeProvider = (*grpc)(nil)
+
+// New creates a gRPC provider connecting to the given address without
+// transport security. The 5s timeout is used for all requests.
+func New(chainID, remote string) (provider.RangeProvider, error) {
+	client, err := grpcclient.New(context.Background(), remote, grpcclient.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpc{
+		chainID: chainID,
+		remote:  remote,
+		client:  client,
+	}, nil
+}
+
+// NewWithClient allows you to provide a custom client, for example to connect
+// with transport security, see grpcclient.WithGRPCDialOption.
+func NewWithClient(chainID string, client grpcclient.BlockServiceClient) provider.RangeProvider {
+	return &grpc{
+		chainID: chainID,
+		client:  client,
+	}
+}
+
+// ChainID returns a chainID this provider was configured with.
+func (p *grpc) ChainID() string {
+	return p.chainID
+}
+
+func (p *grpc) String() string {
+	return fmt.Sprintf("grpc{%s}", p.remote)
+}
+
+// LightBlock fetches a LightBlock at the given height and checks the
+// chainID matches.
+func (p *grpc) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	if height < 0 {
+		return nil, provider.ErrBadLightBlock{Reason: provider.ErrNegativeHeight{Height: height}}
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	lb, err := p.client.GetLightBlock(reqCtx, height)
+	if err != nil {
+		return nil, parseError(ctx, err)
+	}
+
+	if height != 0 && lb.Height != height {
+		return nil, provider.ErrBadLightBlock{
+			Reason: fmt.Errorf("height %d responded doesn't match height %d requested", lb.Height, height),
+		}
+	}
+	if err := lb.ValidateBasic(p.chainID); err != nil {
+		return nil, provider.ErrBadLightBlock{Reason: err}
+	}
+
+	return lb, nil
+}
+
+// LightBlocks streams the LightBlocks from fromHeight up to toHeight and
+// checks their chainID matches. The node ends the stream after at most 100
+// LightBlocks.
+func (p *grpc) LightBlocks(ctx context.Context, fromHeight, toHeight int64) ([]*types.LightBlock, error) {
+	if fromHeight <= 0 {
+		return nil, fmt.Errorf("expected from height > 0, got height %d", fromHeight)
+	}
+	if fromHeight > toHeight {
+		return nil, fmt.Errorf("from height %d is higher than to height %d", fromHeight, toHeight)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	resultCh, err := p.client.GetLightBlocks(reqCtx, fromHeight, toHeight)
+	if err != nil {
+		return nil, parseError(ctx, err)
+	}
+
+	var lbs []*types.LightBlock
+	for res := range resultCh {
+		if res.Error != nil {
+			return nil, parseError(ctx, res.Error)
+		}
+		lb := res.LightBlock
+		if height := fromHeight + int64(len(lbs)); lb.Height != height || height > toHeight {
+			return nil, provider.ErrBadLightBlock{
+				Reason: fmt.Errorf("height %d responded doesn't match height %d requested", lb.Height, height),
+			}
+		}
+		if err := lb.ValidateBasic(p.chainID); err != nil {
+			return nil, provider.ErrBadLightBlock{Reason: err}
+		}
+		lbs = append(lbs, lb)
+	}
+	// The channel is closed without error if the context is done.
+	if err := reqCtx.Err(); err != nil {
+		return nil, parseError(ctx, err)
+	}
+	if len(lbs) == 0 {
+		return nil, provider.ErrBadLightBlock{Reason: errors.New("no light block responded")}
+	}
+
+	return lbs, nil
+}
+
+// ReportEvidence returns ErrReportEvidenceUnsupported.
+func (*grpc) ReportEvidence(context.Context, types.Evidence) error {
+	return ErrReportEvidenceUnsupported
+}
+
+// parseError converts the errors of the client into the errors of the provider
+// package. ctx is the context of the caller.
+func parseError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return provider.ErrNoResponse
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		// The light block could not be converted from its Protobuf
+		// representation.
+		return provider.ErrBadLightBlock{Reason: err}
+	}
+	switch st.Code() {
+	case codes.OutOfRange:
+		return provider.ErrHeightTooHigh
+	case codes.NotFound:
+		return provider.ErrLightBlockNotFound
+	case codes.DeadlineExceeded, codes.Unavailable:
+		return provider.ErrNoResponse
+	default:
+		return err
+	}
+}
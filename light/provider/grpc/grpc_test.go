@@ -0,0 +1,93 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/v2/abci/example/kvstore"
+	"github.com/cometbft/cometbft/v2/light/provider"
+	lightgrpc "github.com/cometbft/cometbft/v2/light/provider/grpc"
+	rpchttp "github.com/cometbft/cometbft/v2/rpc/client/http"
+	rpctest "github.com/cometbft/cometbft/v2/rpc/test"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+func TestNewProvider(t *testing.T) {
+	c, err := lightgrpc.New("chain-test", "192.168.0.1:26670")
+	require.NoError(t, err)
+	require.Equal(t, "grpc{192.168.0.1:26670}", fmt.Sprintf("%s", c))
+}
+
+func TestProvider(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	app.RetainBlocks = 10
+	// slow the blocks down so that the heights queried are not pruned meanwhile
+	app.SetNextBlockDelay(100 * time.Millisecond)
+	node := rpctest.StartCometBFT(app, rpctest.RecreateConfig)
+	defer rpctest.StopCometBFT(node)
+
+	cfg := rpctest.GetConfig()
+	genDoc, err := types.GenesisDocFromFile(cfg.GenesisFile())
+	require.NoError(t, err)
+	chainID := genDoc.ChainID
+
+	c, err := rpchttp.New(cfg.RPC.ListenAddress)
+	require.NoError(t, err)
+	p, err := lightgrpc.New(chainID, strings.TrimPrefix(cfg.GRPC.ListenAddress, "tcp://"))
+	require.NoError(t, err)
+
+	// let it produce some blocks
+	require.Eventually(t, func() bool {
+		status, err := c.Status(context.Background())
+		return err == nil && status.SyncInfo.LatestBlockHeight >= 15
+	}, 30*time.Second, 100*time.Millisecond)
+
+	// let's get the highest block
+	lb, err := p.LightBlock(context.Background(), 0)
+	require.NoError(t, err)
+	require.NotNil(t, lb)
+	assert.GreaterOrEqual(t, lb.Height, int64(15))
+	require.NoError(t, lb.ValidateBasic(chainID))
+
+	// historical queries
+	lower := lb.Height - 3
+	lb, err = p.LightBlock(context.Background(), lower)
+	require.NoError(t, err)
+	assert.Equal(t, lower, lb.Height)
+
+	// consecutive light blocks, up to the latest height
+	lbs, err := p.LightBlocks(context.Background(), lower-2, lower+2)
+	require.NoError(t, err)
+	require.Len(t, lbs, 5)
+	for i, lb := range lbs {
+		assert.Equal(t, lower-2+int64(i), lb.Height)
+		require.NoError(t, lb.ValidateBasic(chainID))
+	}
+	assert.Equal(t, lbs[3].LastBlockID.Hash.Bytes(), lbs[2].Hash().Bytes())
+
+	// light blocks of another chain are rejected
+	otherChain, err := lightgrpc.New("other-chain", strings.TrimPrefix(cfg.GRPC.ListenAddress, "tcp://"))
+	require.NoError(t, err)
+	_, err = otherChain.LightBlock(context.Background(), lower)
+	var badLightBlock provider.ErrBadLightBlock
+	assert.ErrorAs(t, err, &badLightBlock)
+
+	// fetching missing heights (both future and pruned) should return appropriate errors
+	_, err = p.LightBlock(context.Background(), lower+100000)
+	assert.Equal(t, provider.ErrHeightTooHigh, err)
+	_, err = p.LightBlocks(context.Background(), lower+100000, lower+100001)
+	assert.Equal(t, provider.ErrHeightTooHigh, err)
+
+	require.Eventually(t, func() bool {
+		_, err := p.LightBlock(context.Background(), 1)
+		return err == provider.ErrLightBlockNotFound
+	}, 5*time.Second, 100*time.Millisecond)
+	_, err = p.LightBlocks(context.Background(), 1, lower)
+	assert.Equal(t, provider.ErrLightBlockNotFound, err)
+}
@@ -27,3 +27,18 @@ type Provider interface {
 	// ReportEvidence reports an evidence of misbehavior.
 	ReportEvidence(ctx context.Context, ev types.Evidence) error
 }
+
+// RangeProvider is a Provider able to fetch the LightBlocks of consecutive
+// heights at once, which the light client uses for sequential verification.
+type RangeProvider interface {
+	Provider
+
+	// LightBlocks returns the LightBlocks from fromHeight up to toHeight, in
+	// ascending order. Fewer LightBlocks may be returned, but at least the one
+	// at fromHeight.
+	//
+	// fromHeight must be > 0 and <= toHeight.
+	//
+	// It fails like LightBlock.
+	LightBlocks(ctx context.Context, fromHeight, toHeight int64) ([]*types.LightBlock, error)
+}
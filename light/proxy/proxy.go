@@ -5,6 +5,8 @@ import (
 	"net"
 	"net/http"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
 	"github.com/cometbft/cometbft/v2/libs/log"
 	cmtpubsub "github.com/cometbft/cometbft/v2/libs/pubsub"
 	"github.com/cometbft/cometbft/v2/light"
@@ -20,6 +22,9 @@ type Proxy struct {
 	Client   *lrpc.Client
 	Logger   log.Logger
 	Listener net.Listener
+
+	// ServeMetrics enables serving the Prometheus metrics at /metrics.
+	ServeMetrics bool
 }
 
 // NewProxy creates the struct used to run an HTTP server for serving light
@@ -105,6 +110,10 @@ func (p *Proxy) listen() (net.Listener, *http.ServeMux, error) {
 	mux.HandleFunc("/websocket", wm.WebsocketHandler)
 	mux.HandleFunc("/v1/websocket", wm.WebsocketHandler)
 
+	if p.ServeMetrics {
+		mux.Handle("/metrics", promhttp.Handler())
+	}
+
 	// 3) Start a client.
 	if !p.Client.IsRunning() {
 		if err := p.Client.Start(); err != nil {
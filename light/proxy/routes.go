@@ -38,6 +38,7 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"consensus_state":      rpcserver.NewRPCFunc(makeConsensusStateFunc(c), ""),
 		"consensus_params":     rpcserver.NewRPCFunc(makeConsensusParamsFunc(c), "height", rpcserver.Cacheable("height")),
 		"unconfirmed_tx":       rpcserver.NewRPCFunc(makeUnconfirmedTxFunc(c), "hash"),
+		"tx_status":            rpcserver.NewRPCFunc(makeTxStatusFunc(c), "hash"),
 		"unconfirmed_txs":      rpcserver.NewRPCFunc(makeUnconfirmedTxsFunc(c), "limit"),
 		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), ""),
 
@@ -52,6 +53,11 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 
 		// evidence API
 		"broadcast_evidence": rpcserver.NewRPCFunc(makeBroadcastEvidenceFunc(c), "evidence"),
+		"pending_evidence":   rpcserver.NewRPCFunc(makePendingEvidenceFunc(c), "limit"),
+		"committed_evidence": rpcserver.NewRPCFunc(makeCommittedEvidenceFunc(c), "min_height,max_height"),
+
+		// light client API
+		"light_client_attacks": rpcserver.NewRPCFunc(makeLightClientAttacksFunc(c), ""),
 	}
 }
 
@@ -240,6 +246,14 @@ func makeUnconfirmedTxFunc(c *lrpc.Client) rpcUnconfirmedTxFunc {
 	}
 }
 
+type rpcTxStatusFunc func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultTxStatus, error)
+
+func makeTxStatusFunc(c *lrpc.Client) rpcTxStatusFunc {
+	return func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+		return c.TxStatus(ctx.Context(), hash)
+	}
+}
+
 type rpcUnconfirmedTxsFunc func(ctx *rpctypes.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error)
 
 func makeUnconfirmedTxsFunc(c *lrpc.Client) rpcUnconfirmedTxsFunc {
@@ -309,3 +323,27 @@ func makeBroadcastEvidenceFunc(c *lrpc.Client) rpcBroadcastEvidenceFunc {
 		return c.BroadcastEvidence(ctx.Context(), ev)
 	}
 }
+
+type rpcPendingEvidenceFunc func(ctx *rpctypes.Context, limit *int) (*ctypes.ResultPendingEvidence, error)
+
+func makePendingEvidenceFunc(c *lrpc.Client) rpcPendingEvidenceFunc {
+	return func(ctx *rpctypes.Context, limit *int) (*ctypes.ResultPendingEvidence, error) {
+		return c.PendingEvidence(ctx.Context(), limit)
+	}
+}
+
+type rpcCommittedEvidenceFunc func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultCommittedEvidence, error)
+
+func makeCommittedEvidenceFunc(c *lrpc.Client) rpcCommittedEvidenceFunc {
+	return func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultCommittedEvidence, error) {
+		return c.CommittedEvidence(ctx.Context(), minHeight, maxHeight)
+	}
+}
+
+type rpcLightClientAttacksFunc func(ctx *rpctypes.Context) (*lrpc.ResultLightClientAttacks, error)
+
+func makeLightClientAttacksFunc(c *lrpc.Client) rpcLightClientAttacksFunc {
+	return func(ctx *rpctypes.Context) (*lrpc.ResultLightClientAttacks, error) {
+		return c.LightClientAttacks(ctx.Context())
+	}
+}
@@ -31,6 +31,7 @@ type LightClient interface {
 	Update(ctx context.Context, now time.Time) (*types.LightBlock, error)
 	VerifyLightBlockAtHeight(ctx context.Context, height int64, now time.Time) (*types.LightBlock, error)
 	TrustedLightBlock(height int64) (*types.LightBlock, error)
+	AttackEvidence() ([]*types.LightClientAttackEvidence, error)
 }
 
 var _ rpcclient.Client = (*Client)(nil)
@@ -204,6 +205,10 @@ func (c *Client) UnconfirmedTx(ctx context.Context, hash []byte) (*ctypes.Result
 	return c.next.UnconfirmedTx(ctx, hash)
 }
 
+func (c *Client) TxStatus(ctx context.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	return c.next.TxStatus(ctx, hash)
+}
+
 func (c *Client) UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error) {
 	return c.next.UnconfirmedTxs(ctx, limit)
 }
@@ -541,6 +546,33 @@ func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*cty
 	return c.next.BroadcastEvidence(ctx, ev)
 }
 
+func (c *Client) PendingEvidence(ctx context.Context, limit *int) (*ctypes.ResultPendingEvidence, error) {
+	return c.next.PendingEvidence(ctx, limit)
+}
+
+func (c *Client) CommittedEvidence(
+	ctx context.Context,
+	minHeight, maxHeight int64,
+) (*ctypes.ResultCommittedEvidence, error) {
+	return c.next.CommittedEvidence(ctx, minHeight, maxHeight)
+}
+
+// ResultLightClientAttacks is the result of LightClientAttacks.
+type ResultLightClientAttacks struct {
+	Evidence []*types.LightClientAttackEvidence `json:"evidence"`
+}
+
+// LightClientAttacks returns the evidence of the light client attacks
+// detected by the light client, whether or not the providers it was sent to
+// acted on it.
+func (c *Client) LightClientAttacks(context.Context) (*ResultLightClientAttacks, error) {
+	evList, err := c.lc.AttackEvidence()
+	if err != nil {
+		return nil, err
+	}
+	return &ResultLightClientAttacks{Evidence: evList}, nil
+}
+
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int,
 ) (out <-chan ctypes.ResultEvent, err error) {
@@ -17,6 +17,36 @@ type LightClient struct {
 	mock.Mock
 }
 
+// AttackEvidence provides a mock function with no fields
+func (_m *LightClient) AttackEvidence() ([]*types.LightClientAttackEvidence, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttackEvidence")
+	}
+
+	var r0 []*types.LightClientAttackEvidence
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*types.LightClientAttackEvidence, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*types.LightClientAttackEvidence); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.LightClientAttackEvidence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ChainID provides a mock function with no fields
 func (_m *LightClient) ChainID() string {
 	ret := _m.Called()
@@ -339,6 +339,54 @@ func (s *dbs) Size() uint16 {
 	return s.size
 }
 
+// SaveLightClientAttackEvidence persists the evidence of a light client attack.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) SaveLightClientAttackEvidence(ev *types.LightClientAttackEvidence) error {
+	evpb, err := ev.ToProto()
+	if err != nil {
+		return cmterrors.ErrMsgToProto{MessageName: "LightClientAttackEvidence", Err: err}
+	}
+	evBz, err := evpb.Marshal()
+	if err != nil {
+		return store.ErrMarshalEvidence{Err: err}
+	}
+
+	if err := s.db.SetSync(s.dbKeyLayout.EvidenceKey(ev.Height(), ev.Hash(), s.prefix), evBz); err != nil {
+		return store.ErrStore{Err: err}
+	}
+	return nil
+}
+
+// LightClientAttackEvidence returns the evidence of the light client attacks
+// persisted.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) LightClientAttackEvidence() ([]*types.LightClientAttackEvidence, error) {
+	itr, err := dbm.IteratePrefix(s.db, s.dbKeyLayout.EvidenceKeyPrefix(s.prefix))
+	if err != nil {
+		return nil, store.ErrStore{Err: err}
+	}
+	defer itr.Close()
+
+	var evList []*types.LightClientAttackEvidence
+	for ; itr.Valid(); itr.Next() {
+		var evpb cmtproto.LightClientAttackEvidence
+		if err := evpb.Unmarshal(itr.Value()); err != nil {
+			return nil, store.ErrUnmarshal{Err: err}
+		}
+		ev, err := types.LightClientAttackEvidenceFromProto(&evpb)
+		if err != nil {
+			return nil, store.ErrProtoConversion{Err: err}
+		}
+		evList = append(evList, ev)
+	}
+	if err := itr.Error(); err != nil {
+		return nil, store.ErrStore{Err: err}
+	}
+	return evList, nil
+}
+
 func (s *dbs) lbKey(height int64) []byte {
 	return s.dbKeyLayout.LBKey(height, s.prefix)
 }
@@ -15,6 +15,8 @@ type LightStoreKeyLayout interface {
 	ParseLBKey(key []byte, storePrefix string) (height int64, err error)
 	LBKey(height int64, prefix string) []byte
 	SizeKey(prefix string) []byte
+	EvidenceKey(height int64, hash []byte, prefix string) []byte
+	EvidenceKeyPrefix(prefix string) []byte
 }
 
 type v1LegacyLayout struct{}
@@ -58,6 +60,18 @@ func (v1LegacyLayout) SizeKey(_ string) []byte {
 	return []byte("size")
 }
 
+// EvidenceKey implements LightStoreKeyLayout.
+func (l v1LegacyLayout) EvidenceKey(height int64, hash []byte, prefix string) []byte {
+	key := l.EvidenceKeyPrefix(prefix)
+	key = fmt.Appendf(key, "%020d/%X", height, hash)
+	return key
+}
+
+// EvidenceKeyPrefix implements LightStoreKeyLayout.
+func (v1LegacyLayout) EvidenceKeyPrefix(prefix string) []byte {
+	return []byte("ev/" + prefix + "/")
+}
+
 var _ LightStoreKeyLayout = v1LegacyLayout{}
 
 var keyPattern = regexp.MustCompile(`^(lb)/([^/]*)/([0-9]+)$`)
@@ -80,6 +94,7 @@ const (
 	// prefixes must be unique across all db's.
 	prefixLightBlock = int64(11)
 	prefixSize       = int64(12)
+	prefixEvidence   = int64(13)
 )
 
 type v2Layout struct{}
@@ -124,4 +139,22 @@ func (v2Layout) SizeKey(prefix string) []byte {
 	return key
 }
 
+// EvidenceKey implements LightStoreKeyLayout.
+func (v2Layout) EvidenceKey(height int64, hash []byte, prefix string) []byte {
+	key, err := orderedcode.Append(nil, prefix, prefixEvidence, height, string(hash))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// EvidenceKeyPrefix implements LightStoreKeyLayout.
+func (v2Layout) EvidenceKeyPrefix(prefix string) []byte {
+	key, err := orderedcode.Append(nil, prefix, prefixEvidence)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
 var _ LightStoreKeyLayout = v2Layout{}
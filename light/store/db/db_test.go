@@ -284,6 +284,41 @@ func Test_Concurrency(t *testing.T) {
 	wg.Wait()
 }
 
+func Test_LightClientAttackEvidence(t *testing.T) {
+	for _, version := range []string{"v1", "v2"} {
+		t.Run(version, func(t *testing.T) {
+			dbStore := NewWithDBVersion(dbm.NewMemDB(), "Test_LightClientAttackEvidence", version)
+
+			// Empty store
+			evList, err := dbStore.LightClientAttackEvidence()
+			require.NoError(t, err)
+			assert.Empty(t, evList)
+
+			ev1 := randLightClientAttackEvidence(t, 5, 3)
+			ev2 := randLightClientAttackEvidence(t, 3, 2)
+			require.NoError(t, dbStore.SaveLightClientAttackEvidence(ev1))
+			require.NoError(t, dbStore.SaveLightClientAttackEvidence(ev2))
+			// Saving the same evidence twice is a no-op.
+			require.NoError(t, dbStore.SaveLightClientAttackEvidence(ev1))
+
+			// Light blocks are stored alongside, and pruning them keeps the evidence.
+			require.NoError(t, dbStore.SaveLightBlock(randLightBlock(1)))
+			require.NoError(t, dbStore.SaveLightBlock(randLightBlock(2)))
+			require.NoError(t, dbStore.Prune(1))
+
+			// The evidence is ordered by height.
+			evList, err = dbStore.LightClientAttackEvidence()
+			require.NoError(t, err)
+			require.Len(t, evList, 2)
+			assert.Equal(t, ev2.Hash(), evList[0].Hash())
+			assert.Equal(t, ev1.Hash(), evList[1].Hash())
+			assert.Equal(t, ev1.TotalVotingPower, evList[1].TotalVotingPower)
+
+			assert.EqualValues(t, 1, dbStore.Size())
+		})
+	}
+}
+
 func randLightBlock(height int64) *types.LightBlock {
 	vals, _ := types.RandValidatorSet(2, 1)
 	return &types.LightBlock{
@@ -309,3 +344,30 @@ func randLightBlock(height int64) *types.LightBlock {
 		ValidatorSet: vals,
 	}
 }
+
+func randLightClientAttackEvidence(t *testing.T, height, commonHeight int64) *types.LightClientAttackEvidence {
+	t.Helper()
+	const chainID = "test-chain"
+	vals, privVals := types.RandValidatorSet(2, 1)
+	lb := randLightBlock(height)
+	lb.ChainID = chainID
+	lb.ValidatorsHash = vals.Hash()
+	lb.ValidatorSet = vals
+
+	blockID := types.BlockID{
+		Hash:          lb.Header.Hash(),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: crypto.CRandBytes(tmhash.Size)},
+	}
+	voteSet := types.NewVoteSet(chainID, height, 1, types.PrecommitType, vals)
+	extCommit, err := types.MakeExtCommit(blockID, height, 1, voteSet, privVals, cmttime.Now(), false)
+	require.NoError(t, err)
+	lb.Commit = extCommit.ToCommit()
+
+	return &types.LightClientAttackEvidence{
+		ConflictingBlock:    lb,
+		CommonHeight:        commonHeight,
+		ByzantineValidators: vals.Validators,
+		TotalVotingPower:    vals.TotalVotingPower(),
+		Timestamp:           lb.Time,
+	}
+}
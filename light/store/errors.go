@@ -21,6 +21,18 @@ func (e ErrMarshalBlock) Unwrap() error {
 	return e.Err
 }
 
+type ErrMarshalEvidence struct {
+	Err error
+}
+
+func (e ErrMarshalEvidence) Error() string {
+	return fmt.Sprintf("marshaling LightClientAttackEvidence: %v", e.Err)
+}
+
+func (e ErrMarshalEvidence) Unwrap() error {
+	return e.Err
+}
+
 type ErrUnmarshal struct {
 	Err error
 }
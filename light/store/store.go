@@ -45,4 +45,13 @@ type Store interface {
 
 	// Size returns a number of currently existing header & validator set pairs.
 	Size() uint16
+
+	// SaveLightClientAttackEvidence persists the evidence of a light client
+	// attack. Unlike light blocks, evidence is neither pruned nor counted in
+	// Size.
+	SaveLightClientAttackEvidence(ev *types.LightClientAttackEvidence) error
+
+	// LightClientAttackEvidence returns the evidence of the light client
+	// attacks persisted, ordered by common height.
+	LightClientAttackEvidence() ([]*types.LightClientAttackEvidence, error)
 }
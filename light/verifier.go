@@ -53,15 +53,21 @@ func VerifyNonAdjacent(
 	}
 
 	verifiedSignatureCache := types.NewSignatureCache()
-	// Ensure that +`trustLevel` (default 1/3) or more of last trusted validators signed correctly.
-	err := trustedVals.VerifyCommitLightTrustingWithCache(trustedHeader.ChainID, untrustedHeader.Commit, trustLevel, verifiedSignatureCache)
-	if err != nil {
-		switch e := err.(type) {
-		case types.ErrNotEnoughVotingPowerSigned:
-			return ErrNewValSetCantBeTrusted{e}
-		default:
-			return e
+
+	// An aggregated signature can only be verified with the keys of all its
+	// signers, which the trusted validators may not include. It is thus
+	// verified with untrustedVals first, which caches it.
+	if untrustedHeader.Commit.HasAggregatedSignature() {
+		if err := untrustedVals.VerifyCommitLightWithCache(trustedHeader.ChainID, untrustedHeader.Commit.BlockID,
+			untrustedHeader.Height, untrustedHeader.Commit, verifiedSignatureCache); err != nil {
+			return ErrInvalidHeader{err}
 		}
+		return verifyCommitLightTrusting(trustedHeader.ChainID, trustedVals, untrustedHeader.Commit, trustLevel, verifiedSignatureCache)
+	}
+
+	// Ensure that +`trustLevel` (default 1/3) or more of last trusted validators signed correctly.
+	if err := verifyCommitLightTrusting(trustedHeader.ChainID, trustedVals, untrustedHeader.Commit, trustLevel, verifiedSignatureCache); err != nil {
+		return err
 	}
 
 	// Ensure that +2/3 of new validators signed correctly.
@@ -77,6 +83,27 @@ func VerifyNonAdjacent(
 	return nil
 }
 
+// verifyCommitLightTrusting ensures that +`trustLevel` (default 1/3) or more
+// of trustedVals signed commit correctly.
+func verifyCommitLightTrusting(
+	chainID string,
+	trustedVals *types.ValidatorSet,
+	commit *types.Commit,
+	trustLevel cmtmath.Fraction,
+	verifiedSignatureCache types.SignatureCache,
+) error {
+	err := trustedVals.VerifyCommitLightTrustingWithCache(chainID, commit, trustLevel, verifiedSignatureCache)
+	if err != nil {
+		switch e := err.(type) {
+		case types.ErrNotEnoughVotingPowerSigned:
+			return ErrNewValSetCantBeTrusted{e}
+		default:
+			return e
+		}
+	}
+	return nil
+}
+
 // VerifyAdjacent verifies directly adjacent untrustedHeader against
 // trustedHeader. It ensures that:
 //
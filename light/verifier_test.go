@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cometbft/cometbft/v2/crypto/bls12381"
 	cmtmath "github.com/cometbft/cometbft/v2/libs/math"
 	"github.com/cometbft/cometbft/v2/light"
 	"github.com/cometbft/cometbft/v2/types"
@@ -287,6 +288,48 @@ func TestVerifyNonAdjacentHeaders(t *testing.T) {
 	}
 }
 
+func TestVerifyNonAdjacentHeaders_AggregatedSignature(t *testing.T) {
+	if !bls12381.Enabled {
+		t.Skip("bls12381 is disabled")
+	}
+	const chainID = "TestVerifyNonAdjacentHeaders_AggregatedSignature"
+
+	var (
+		keys     = genBls12381PrivKeys(4)
+		vals     = keys.ToValidators(20, 10)
+		bTime, _ = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		header   = keys.GenSignedHeader(chainID, 1, bTime, nil, vals, vals,
+			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys))
+
+		// Half of the trusted validators are replaced.
+		newKeys = append(keys[2:], genBls12381PrivKeys(2)...)
+		newVals = newKeys.ToValidators(20, 10)
+	)
+
+	newHeader := newKeys.GenSignedHeader(chainID, 3, bTime.Add(1*time.Hour), nil, newVals, newVals,
+		hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(newKeys))
+	commit, err := newHeader.Commit.AggregateSignatures()
+	require.NoError(t, err)
+	newHeader.Commit = commit
+
+	err = light.VerifyNonAdjacent(header, vals, newHeader, newVals, 3*time.Hour,
+		bTime.Add(2*time.Hour), maxClockDrift, light.DefaultTrustLevel)
+	require.NoError(t, err)
+
+	// Not enough of the trusted validators signed.
+	err = light.VerifyNonAdjacent(header, vals, newHeader, newVals, 3*time.Hour,
+		bTime.Add(2*time.Hour), maxClockDrift, cmtmath.Fraction{Numerator: 2, Denominator: 3})
+	require.ErrorAs(t, err, &light.ErrNewValSetCantBeTrusted{})
+
+	// The aggregated signature does not match the commit.
+	tampered := *newHeader
+	tampered.Commit = commit.Clone()
+	tampered.Commit.Signatures[0].Timestamp = tampered.Commit.Signatures[0].Timestamp.Add(time.Second)
+	err = light.VerifyNonAdjacent(header, vals, &tampered, newVals, 3*time.Hour,
+		bTime.Add(2*time.Hour), maxClockDrift, light.DefaultTrustLevel)
+	require.ErrorAs(t, err, &light.ErrInvalidHeader{})
+}
+
 func TestVerifyReturnsErrorIfTrustLevelIsInvalid(t *testing.T) {
 	const (
 		chainID    = "TestVerifyReturnsErrorIfTrustLevelIsInvalid"
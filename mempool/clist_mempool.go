@@ -3,6 +3,7 @@ package mempool
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"sync/atomic"
@@ -59,6 +60,7 @@ type CListMempool struct {
 	laneBytes map[LaneID]int64                // number of bytes per lane (for metrics)
 	txsBytes  int64                           // total size of mempool, in bytes
 	numTxs    int64                           // total number of txs in the mempool
+	index     txIndex                         // additional ordering of the txs, if not nil
 
 	addTxChMtx    cmtsync.RWMutex  // Protects the fields below
 	addTxCh       chan struct{}    // Blocks until the next TX is added
@@ -73,6 +75,18 @@ type CListMempool struct {
 	// This reduces the pressure on the proxyApp.
 	cache TxCache
 
+	// Decides in which lane a new tx goes and whether there is room for it.
+	admission admissionPolicy
+	// Serializes the admission of new txs, from making room for them until they
+	// are added, so that concurrent admissions don't exceed the limits.
+	admissionMtx cmtsync.Mutex
+
+	// Persists the txs in the mempool, if not nil.
+	journal *TxJournal
+
+	// Records the lifecycle of the txs, if not nil.
+	tracer *TxTracer
+
 	logger  log.Logger
 	metrics *Metrics
 }
@@ -89,6 +103,54 @@ type LaneID string
 // LanePriority represents the priority of a lane.
 type LanePriority uint32
 
+// admissionPolicy decides whether, and where, a new transaction is added to the
+// mempool.
+type admissionPolicy interface {
+	// checkFull is called before sending a new transaction to CheckTx.
+	checkFull(txSize int) error
+	// laneFor returns the lane for a transaction accepted by CheckTx.
+	laneFor(res *abci.CheckTxResponse) LaneID
+	// makeRoom is called once CheckTx has accepted a transaction, right before
+	// adding it to the mempool. It returns an error if there is no room for the
+	// transaction in the lane. It may remove other entries to free up space.
+	makeRoom(tx types.Tx, res *abci.CheckTxResponse, lane LaneID) error
+}
+
+// txIndex keeps the entries of the mempool in an order other than arrival. Its
+// methods are called with txsMtx held.
+type txIndex interface {
+	insert(memTx *mempoolTx)
+	remove(memTx *mempoolTx)
+	reset()
+}
+
+// laneAdmission is the default admission policy: transactions go to the lane
+// chosen by the application and the mempool capacity is partitioned evenly
+// across all lanes.
+type laneAdmission struct {
+	mem *CListMempool
+}
+
+func (a laneAdmission) checkFull(txSize int) error {
+	return a.mem.isFull(txSize)
+}
+
+// If the app returned a non-empty lane, use it; otherwise use the default lane.
+func (a laneAdmission) laneFor(res *abci.CheckTxResponse) LaneID {
+	lane := a.mem.defaultLane
+	if res.LaneId != "" {
+		if _, ok := a.mem.lanes[lane]; !ok {
+			panic(ErrLaneNotFound{laneID: lane})
+		}
+		lane = LaneID(res.LaneId)
+	}
+	return lane
+}
+
+func (a laneAdmission) makeRoom(tx types.Tx, _ *abci.CheckTxResponse, lane LaneID) error {
+	return a.mem.isLaneFull(len(tx), lane)
+}
+
 // lane corresponds to a transaction class as defined by the application.
 // A lane is identified by a unique string name (LaneID) and has a priority level (LanePriority).
 // Different lanes can have the same priority.
@@ -142,6 +204,7 @@ func NewCListMempool(
 	})
 
 	mp.recheck = newRecheck(mp)
+	mp.admission = laneAdmission{mem: mp}
 
 	if cfg.CacheSize > 0 {
 		mp.cache = NewLRUTxCache(cfg.CacheSize)
@@ -194,6 +257,9 @@ func (mem *CListMempool) removeAllTxs(lane LaneID) {
 		e.DetachPrev()
 	}
 	mem.txsMap = make(map[types.TxKey]*clist.CElement)
+	if mem.index != nil {
+		mem.index.reset()
+	}
 	delete(mem.laneBytes, lane)
 	mem.txsBytes = 0
 }
@@ -250,6 +316,17 @@ func WithMetrics(metrics *Metrics) CListMempoolOption {
 	return func(mem *CListMempool) { mem.metrics = metrics }
 }
 
+// WithJournal sets a journal recording the transactions in the mempool, so
+// that they can be replayed after a restart with ReplayJournal.
+func WithJournal(journal *TxJournal) CListMempoolOption {
+	return func(mem *CListMempool) { mem.journal = journal }
+}
+
+// WithTxTracer sets a tracer recording the lifecycle of the transactions.
+func WithTxTracer(tracer *TxTracer) CListMempoolOption {
+	return func(mem *CListMempool) { mem.tracer = tracer }
+}
+
 // WithNewTxCallback sets a callback function to be executed when a new transaction is added to the mempool.
 // The callback function will receive the newly added transaction as a parameter.
 func WithNewTxCallback(cb func(types.Tx)) CListMempoolOption {
@@ -325,6 +402,18 @@ func (mem *CListMempool) Flush() {
 	mem.updateMtx.Lock()
 	defer mem.updateMtx.Unlock()
 
+	if mem.tracer != nil {
+		mem.txsMtx.RLock()
+		txKeys := make([]types.TxKey, 0, len(mem.txsMap))
+		for txKey := range mem.txsMap {
+			txKeys = append(txKeys, txKey)
+		}
+		mem.txsMtx.RUnlock()
+		for _, txKey := range txKeys {
+			mem.traceKey(txKey, types.MempoolTxEvent{Type: types.MempoolTxRemoved, Reason: RemovedFlushed})
+		}
+	}
+
 	mem.txsBytes = 0
 	mem.numTxs = 0
 	mem.cache.Reset()
@@ -332,6 +421,12 @@ func (mem *CListMempool) Flush() {
 	for lane := range mem.lanes {
 		mem.removeAllTxs(lane)
 	}
+
+	if mem.journal != nil {
+		if err := mem.journal.Clear(); err != nil {
+			mem.logger.Error("Could not clear the mempool journal", "err", err)
+		}
+	}
 }
 
 func (mem *CListMempool) Contains(txKey types.TxKey) bool {
@@ -349,28 +444,35 @@ func (mem *CListMempool) CheckTx(tx types.Tx, sender p2p.ID) (*abcicli.ReqRes, e
 	// use defer to unlock mutex because application (*local client*) might panic
 	defer mem.updateMtx.RUnlock()
 
+	mem.trace(tx, types.MempoolTxEvent{Type: types.MempoolTxReceived, Sender: string(sender)})
+
 	txSize := len(tx)
 
-	if err := mem.isFull(txSize); err != nil {
+	if err := mem.admission.checkFull(txSize); err != nil {
 		mem.metrics.RejectedTxs.Add(1)
+		mem.traceRejected(tx, err)
 		return nil, err
 	}
 
 	if txSize > mem.config.MaxTxBytes {
-		return nil, ErrTxTooLarge{
+		err := ErrTxTooLarge{
 			Max:    mem.config.MaxTxBytes,
 			Actual: txSize,
 		}
+		mem.traceRejected(tx, err)
+		return nil, err
 	}
 
 	if mem.preCheck != nil {
 		if err := mem.preCheck(tx); err != nil {
+			mem.traceRejected(tx, ErrPreCheck{Err: err})
 			return nil, ErrPreCheck{Err: err}
 		}
 	}
 
 	// NOTE: proxyAppConn may error if tx buffer is full
 	if err := mem.proxyAppConn.Error(); err != nil {
+		mem.traceRejected(tx, ErrAppConnMempool{Err: err})
 		return nil, ErrAppConnMempool{Err: err}
 	}
 
@@ -396,6 +498,12 @@ func (mem *CListMempool) CheckTx(tx types.Tx, sender p2p.ID) (*abcicli.ReqRes, e
 	if err != nil {
 		panic(fmt.Errorf("CheckTx request for tx %s failed: %w", tx.Hash(), err))
 	}
+	if err := reqRes.Error(); errors.Is(err, abcicli.ErrRequestNotSent) {
+		// The transaction was not checked, it can be submitted again.
+		mem.forceRemoveFromCache(tx)
+		mem.traceRejected(tx, ErrAppConnMempool{Err: err})
+		return nil, ErrAppConnMempool{Err: err}
+	}
 	reqRes.SetCallback(mem.handleCheckTxResponse(tx, sender))
 
 	return reqRes, nil
@@ -416,6 +524,8 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender p2p.ID) func(
 			panic(fmt.Sprint("rechecking has not finished; cannot check new tx ", tx.Hash()))
 		}
 
+		mem.trace(tx, types.MempoolTxEvent{Type: types.MempoolTxChecked, Sender: string(sender), Code: res.Code})
+
 		var postCheckErr error
 		if mem.postCheck != nil {
 			postCheckErr = mem.postCheck(tx, res)
@@ -433,25 +543,23 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender p2p.ID) func(
 			mem.metrics.FailedTxs.Add(1)
 
 			if postCheckErr != nil {
+				mem.traceRejected(tx, postCheckErr)
 				return postCheckErr
 			}
-			return ErrInvalidTx{Code: res.Code, Data: res.Data, Log: res.Log, Codespace: res.Codespace, Hash: tx.Hash()}
-		}
-
-		// If the app returned a non-empty lane, use it; otherwise use the default lane.
-		lane := mem.defaultLane
-		if res.LaneId != "" {
-			if _, ok := mem.lanes[lane]; !ok {
-				panic(ErrLaneNotFound{laneID: lane})
-			}
-			lane = LaneID(res.LaneId)
+			err := ErrInvalidTx{Code: res.Code, Data: res.Data, Log: res.Log, Codespace: res.Codespace, Hash: tx.Hash()}
+			mem.traceRejected(tx, err)
+			return err
 		}
 
-		if err := mem.isLaneFull(len(tx), lane); err != nil {
+		lane := mem.admission.laneFor(res)
+		mem.admissionMtx.Lock()
+		if err := mem.admission.makeRoom(tx, res, lane); err != nil {
+			mem.admissionMtx.Unlock()
 			mem.forceRemoveFromCache(tx) // lane might have space later
 			// use debug level to avoid spamming logs when traffic is high
 			mem.logger.Debug(err.Error())
 			mem.metrics.RejectedTxs.Add(1)
+			mem.traceRejected(tx, err)
 			return err
 		}
 
@@ -459,16 +567,20 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender p2p.ID) func(
 		// cache overflows. See https://github.com/cometbft/cometbft/v2/pull/890.
 		txKey := tx.Key()
 		if mem.Contains(txKey) {
+			mem.admissionMtx.Unlock()
 			mem.metrics.RejectedTxs.Add(1)
 			if err := mem.addSender(txKey, sender); err != nil {
 				mem.logger.Error("Could not add sender to tx", "tx", tx.Hash(), "sender", sender, "err", err)
 			}
 			mem.logger.Debug("Reject tx", "tx", log.NewLazyHash(tx), "height", mem.height.Load(), "err", ErrTxInMempool)
+			mem.traceRejected(tx, ErrTxInMempool)
 			return ErrTxInMempool
 		}
 
 		// Add tx to mempool and notify that new txs are available.
-		mem.addTx(tx, res.GasWanted, sender, lane)
+		mem.addTx(tx, res.GasWanted, res.Priority, sender, lane)
+		mem.admissionMtx.Unlock()
+		mem.trace(tx, types.MempoolTxEvent{Type: types.MempoolTxAdded, Sender: string(sender), Lane: string(lane)})
 		mem.notifyTxsAvailable()
 
 		if mem.onNewTx != nil {
@@ -482,8 +594,8 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender p2p.ID) func(
 }
 
 // Called from:
-//   - handleCheckTxResponse (lock not held) if tx is valid
-func (mem *CListMempool) addTx(tx types.Tx, gasWanted int64, sender p2p.ID, lane LaneID) {
+//   - handleCheckTxResponse (admissionMtx held) if tx is valid
+func (mem *CListMempool) addTx(tx types.Tx, gasWanted, priority int64, sender p2p.ID, lane LaneID) {
 	mem.txsMtx.Lock()
 	defer mem.txsMtx.Unlock()
 
@@ -504,6 +616,7 @@ func (mem *CListMempool) addTx(tx types.Tx, gasWanted int64, sender p2p.ID, lane
 		tx:        tx,
 		height:    mem.height.Load(),
 		gasWanted: gasWanted,
+		priority:  priority,
 		lane:      lane,
 		seq:       mem.addTxSeq,
 	}
@@ -512,6 +625,9 @@ func (mem *CListMempool) addTx(tx types.Tx, gasWanted int64, sender p2p.ID, lane
 
 	// Update auxiliary variables.
 	mem.txsMap[tx.Key()] = e
+	if mem.index != nil {
+		mem.index.insert(memTx)
+	}
 	mem.txsBytes += int64(len(tx))
 	mem.numTxs++
 	mem.laneBytes[lane] += int64(len(tx))
@@ -520,6 +636,12 @@ func (mem *CListMempool) addTx(tx types.Tx, gasWanted int64, sender p2p.ID, lane
 	close(mem.addTxCh)
 	mem.addTxCh = make(chan struct{})
 
+	if mem.journal != nil {
+		if err := mem.journal.add(tx, lane); err != nil {
+			mem.logger.Error("Could not add tx to the mempool journal", "tx", log.NewLazyHash(tx), "err", err)
+		}
+	}
+
 	// Update metrics.
 	mem.metrics.TxSizeBytes.Observe(float64(len(tx)))
 
@@ -533,10 +655,25 @@ func (mem *CListMempool) addTx(tx types.Tx, gasWanted int64, sender p2p.ID, lane
 }
 
 // RemoveTxByKey removes a transaction from the mempool by its TxKey index.
+func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
+	return mem.removeTx(txKey, RemovedByKey)
+}
+
+// removeTx removes a transaction from the mempool and records the reason in
+// its trace.
 // Called from:
 //   - Update (updateMtx held) if tx was committed
 //   - handleRecheckTxResponse (updateMtx not held) if tx was invalidated
-func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
+//   - PriorityMempool.makeRoom (updateMtx not held) if tx was evicted
+func (mem *CListMempool) removeTx(txKey types.TxKey, reason string) error {
+	if err := mem.deleteTx(txKey); err != nil {
+		return err
+	}
+	mem.traceKey(txKey, types.MempoolTxEvent{Type: types.MempoolTxRemoved, Reason: reason})
+	return nil
+}
+
+func (mem *CListMempool) deleteTx(txKey types.TxKey) error {
 	mem.txsMtx.Lock()
 	defer mem.txsMtx.Unlock()
 
@@ -556,10 +693,19 @@ func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
 
 	// Update auxiliary variables.
 	delete(mem.txsMap, txKey)
+	if mem.index != nil {
+		mem.index.remove(memTx)
+	}
 	mem.txsBytes -= int64(len(memTx.tx))
 	mem.numTxs--
 	mem.laneBytes[memTx.lane] -= int64(len(memTx.tx))
 
+	if mem.journal != nil {
+		if err := mem.journal.remove(txKey); err != nil {
+			mem.logger.Error("Could not remove tx from the mempool journal", "tx", log.NewLazyHash(memTx.tx), "err", err)
+		}
+	}
+
 	mem.logger.Debug(
 		"Removed transaction",
 		"tx", log.NewLazyHash(memTx.tx),
@@ -634,6 +780,7 @@ func (mem *CListMempool) handleRecheckTxResponse(tx types.Tx) func(res *abci.Res
 			// Reached the end of the list and didn't find a matching tx; rechecking has finished.
 			return nil
 		}
+		mem.trace(tx, types.MempoolTxEvent{Type: types.MempoolTxRechecked, Code: res.Code})
 
 		var postCheckErr error
 		if mem.postCheck != nil {
@@ -644,7 +791,7 @@ func (mem *CListMempool) handleRecheckTxResponse(tx types.Tx) func(res *abci.Res
 		if (res.Code != abci.CodeTypeOK) || postCheckErr != nil {
 			// Tx became invalidated due to newly committed block.
 			mem.logger.Debug("Tx is no longer valid", "tx", log.NewLazyHash(tx), "res", res, "postCheckErr", postCheckErr)
-			if err := mem.RemoveTxByKey(tx.Key()); err != nil {
+			if err := mem.removeTx(tx.Key(), RemovedRecheck); err != nil {
 				mem.logger.Debug("Transaction could not be removed from mempool", "err", err)
 				return err
 			}
@@ -664,10 +811,34 @@ func (mem *CListMempool) handleRecheckTxResponse(tx types.Tx) func(res *abci.Res
 			return ErrInvalidTx{Code: res.Code, Data: res.Data, Log: res.Log, Codespace: res.Codespace, Hash: tx.Hash()}
 		}
 
+		mem.updatePriority(tx.Key(), res.Priority)
 		return nil
 	}
 }
 
+// updatePriority sets the priority of the entry of a rechecked transaction,
+// keeping the index in order.
+func (mem *CListMempool) updatePriority(txKey types.TxKey, priority int64) {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	elem, ok := mem.txsMap[txKey]
+	if !ok {
+		return
+	}
+	memTx := elem.Value.(*mempoolTx)
+	if memTx.priority == priority {
+		return
+	}
+	if mem.index != nil {
+		mem.index.remove(memTx)
+	}
+	memTx.priority = priority
+	if mem.index != nil {
+		mem.index.insert(memTx)
+	}
+}
+
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) TxsAvailable() <-chan struct{} {
 	return mem.txsAvailable
@@ -802,7 +973,8 @@ func (mem *CListMempool) Update(
 		// Mempool after:
 		//   100
 		// https://github.com/tendermint/tendermint/issues/3322.
-		if err := mem.RemoveTxByKey(tx.Key()); err != nil {
+		mem.trace(tx, types.MempoolTxEvent{Type: types.MempoolTxIncluded, Code: txResults[i].Code})
+		if err := mem.removeTx(tx.Key(), RemovedCommitted); err != nil {
 			mem.logger.Debug("Committed transaction not in local mempool (not an error)",
 				"tx", log.NewLazyHash(tx),
 				"error", err.Error())
@@ -827,6 +999,24 @@ func (mem *CListMempool) Update(
 	return nil
 }
 
+// trace records an event of the lifecycle of tx, if tracing is enabled.
+func (mem *CListMempool) trace(tx types.Tx, event types.MempoolTxEvent) {
+	if mem.tracer != nil {
+		mem.traceKey(tx.Key(), event)
+	}
+}
+
+func (mem *CListMempool) traceKey(txKey types.TxKey, event types.MempoolTxEvent) {
+	if mem.tracer != nil {
+		event.Height = mem.height.Load()
+		mem.tracer.record(txKey, event)
+	}
+}
+
+func (mem *CListMempool) traceRejected(tx types.Tx, err error) {
+	mem.trace(tx, types.MempoolTxEvent{Type: types.MempoolTxRejected, Reason: err.Error()})
+}
+
 // updateSizeMetrics updates the size-related metrics of a given lane.
 func (mem *CListMempool) updateSizeMetrics(laneID LaneID) {
 	laneTxs, laneBytes := mem.LaneSizes(laneID)
@@ -871,6 +1061,11 @@ func (mem *CListMempool) recheckTxs() {
 		if err != nil {
 			panic(fmt.Errorf("(re-)CheckTx request for tx %s failed: %w", memTx.Tx().Hash(), err))
 		}
+		if err := resReq.Error(); errors.Is(err, abcicli.ErrRequestNotSent) {
+			// Neither can the next ones: they are left as they are.
+			mem.logger.Error("Could not recheck txs", "err", err)
+			break
+		}
 		resReq.SetCallback(mem.handleRecheckTxResponse(memTx.Tx()))
 	}
 
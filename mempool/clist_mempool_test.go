@@ -419,6 +419,36 @@ func TestMempoolUpdateDoesNotPanicWhenApplicationMissedTx(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+// Test that a transaction which could not be sent to the application is
+// removed from the cache, even when invalid transactions are kept.
+func TestMempoolTxNotCheckedByApp(t *testing.T) {
+	mockClient := new(abciclimocks.Client)
+	mockClient.On("Start").Return(nil)
+	mockClient.On("SetLogger", mock.Anything)
+	mockClient.On("Error").Return(nil)
+	mockClient.On("Info", mock.Anything, mock.Anything).Return(&abci.InfoResponse{}, nil)
+
+	mp, cleanup := newMempoolWithAppMock(mockClient)
+	defer cleanup()
+	mp.config.KeepInvalidTxsInCache = true
+
+	tx := types.Tx{0x01}
+	reqRes := abciclient.NewReqRes(abci.ToCheckTxRequest(&abci.CheckTxRequest{Tx: tx, Type: abci.CHECK_TX_TYPE_CHECK}))
+	reqRes.Fail(abciclient.ErrRequestNotSent)
+	mockClient.On("CheckTxAsync", mock.Anything, mock.Anything).Return(reqRes, nil).Once()
+	_, err := mp.CheckTx(tx, "")
+	require.ErrorIs(t, err, abciclient.ErrRequestNotSent)
+	require.Zero(t, mp.Size())
+
+	// The transaction can be submitted again.
+	reqRes = newReqRes(tx, abci.CodeTypeOK, abci.CHECK_TX_TYPE_CHECK)
+	mockClient.On("CheckTxAsync", mock.Anything, mock.Anything).Return(reqRes, nil).Once()
+	_, err = mp.CheckTx(tx, "")
+	require.NoError(t, err)
+	reqRes.InvokeCallback()
+	require.Equal(t, 1, mp.Size())
+}
+
 func TestMempool_KeepInvalidTxsInCache(t *testing.T) {
 	app := kvstore.NewInMemoryApplication()
 	cc := proxy.NewLocalClientCreator(app)
@@ -0,0 +1,260 @@
+package mempool
+
+import (
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// journalKeyPrefix prefixes the keys of the entries of the journal, which are
+// followed by the big-endian sequence number of the entry, so that iterating
+// over the database returns the entries in the order they were added.
+const journalKeyPrefix = byte(0x01)
+
+// ErrCorruptJournalEntry is returned when an entry of the journal cannot be
+// decoded.
+var ErrCorruptJournalEntry = errors.New("corrupt mempool journal entry")
+
+// TxJournal persists the transactions admitted to the mempool, along with
+// their lane, and removes them once they leave the mempool, so that the
+// transactions still in the mempool when the node stops can be replayed
+// through CheckTx when it restarts (see CListMempool.ReplayJournal).
+//
+// Writes are not synced to disk: the journal survives restarts of the node,
+// not crashes of the machine.
+type TxJournal struct {
+	db dbm.DB
+
+	openSeq uint64 // sequence number of the last entry before the journal was opened
+
+	mtx  cmtsync.Mutex
+	seq  uint64                 // sequence number of the last entry
+	seqs map[types.TxKey]uint64 // sequence number of the entry of each tx
+}
+
+// journalEntry is a transaction of the journal.
+type journalEntry struct {
+	seq  uint64
+	tx   types.Tx
+	lane LaneID
+}
+
+// NewTxJournal returns a journal stored in db. The entries already in db are
+// kept until they are replayed or cleared.
+func NewTxJournal(db dbm.DB) (*TxJournal, error) {
+	j := &TxJournal{
+		db:   db,
+		seqs: make(map[types.TxKey]uint64),
+	}
+
+	iter, err := db.ReverseIterator([]byte{journalKeyPrefix}, []byte{journalKeyPrefix + 1})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	if iter.Valid() {
+		if j.seq, err = journalSeq(iter.Key()); err != nil {
+			return nil, err
+		}
+	}
+	j.openSeq = j.seq
+	return j, iter.Error()
+}
+
+// add records tx, admitted to lane.
+func (j *TxJournal) add(tx types.Tx, lane LaneID) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	j.seq++
+	if err := j.db.Set(journalKey(j.seq), encodeJournalEntry(tx, lane)); err != nil {
+		return err
+	}
+	j.seqs[tx.Key()] = j.seq
+	return nil
+}
+
+// remove deletes the entry of the transaction, if any.
+func (j *TxJournal) remove(txKey types.TxKey) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	seq, ok := j.seqs[txKey]
+	if !ok {
+		return nil
+	}
+	delete(j.seqs, txKey)
+	return j.db.Delete(journalKey(seq))
+}
+
+// entries returns the entries of the journal, in the order they were added.
+func (j *TxJournal) entries() ([]journalEntry, error) {
+	iter, err := j.db.Iterator([]byte{journalKeyPrefix}, []byte{journalKeyPrefix + 1})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var entries []journalEntry
+	for ; iter.Valid(); iter.Next() {
+		seq, err := journalSeq(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		tx, lane, err := decodeJournalEntry(iter.Value())
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", seq, err)
+		}
+		entries = append(entries, journalEntry{seq: seq, tx: tx, lane: lane})
+	}
+	return entries, iter.Error()
+}
+
+// staleEntries returns the entries of the journal that were added before it
+// was opened, in the order they were added.
+func (j *TxJournal) staleEntries() ([]journalEntry, error) {
+	entries, err := j.entries()
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(entries, func(e journalEntry) bool {
+		return e.seq > j.openSeq
+	}), nil
+}
+
+// removeEntries deletes the given entries, unless they were added again since.
+func (j *TxJournal) removeEntries(entries []journalEntry) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	batch := j.db.NewBatch()
+	defer batch.Close()
+	for _, e := range entries {
+		if seq, ok := j.seqs[e.tx.Key()]; ok && seq == e.seq {
+			delete(j.seqs, e.tx.Key())
+		}
+		if err := batch.Delete(journalKey(e.seq)); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// Clear deletes all the entries of the journal.
+func (j *TxJournal) Clear() error {
+	entries, err := j.entries()
+	if err != nil {
+		return err
+	}
+	return j.removeEntries(entries)
+}
+
+// Close closes the database of the journal.
+func (j *TxJournal) Close() error {
+	return j.db.Close()
+}
+
+// ReplayJournal submits the transactions of the journal to CheckTx, so that
+// the transactions that were in the mempool when the node stopped are admitted
+// again. It must be called once, at startup, after the handshake with the
+// application.
+//
+// The transactions of the lanes with the highest priority are replayed first,
+// and those of a lane in the order they were first admitted. Transactions
+// are replayed up to MaxTxsBytes; the rest are dropped.
+func (mem *CListMempool) ReplayJournal() error {
+	if mem.journal == nil {
+		return nil
+	}
+	entries, err := mem.journal.staleEntries()
+	if err != nil {
+		return fmt.Errorf("reading the mempool journal: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	priorities := make(map[LaneID]LanePriority, len(mem.sortedLanes))
+	for _, l := range mem.sortedLanes {
+		priorities[l.id] = l.priority
+	}
+	priority := func(lane LaneID) LanePriority {
+		if p, ok := priorities[lane]; ok {
+			return p
+		}
+		// The application no longer has this lane.
+		return priorities[mem.defaultLane]
+	}
+	replayed := slices.Clone(entries)
+	slices.SortStableFunc(replayed, func(a, b journalEntry) int {
+		return cmp.Compare(priority(b.lane), priority(a.lane))
+	})
+
+	var (
+		txsBytes int64
+		dropped  int
+	)
+	for _, e := range replayed {
+		if txsBytes+int64(len(e.tx)) > mem.config.MaxTxsBytes {
+			dropped++
+			continue
+		}
+		if _, err := mem.CheckTx(e.tx, noSender); err != nil {
+			mem.logger.Debug("Could not replay journaled tx", "tx", log.NewLazyHash(e.tx), "err", err)
+			continue
+		}
+		txsBytes += int64(len(e.tx))
+	}
+	// Wait for the CheckTx responses, which add the admitted transactions to
+	// the journal again.
+	if err := mem.FlushAppConn(); err != nil {
+		return err
+	}
+	if err := mem.journal.removeEntries(entries); err != nil {
+		return fmt.Errorf("removing the replayed entries of the mempool journal: %w", err)
+	}
+
+	mem.logger.Info("Replayed the mempool journal",
+		"journaled", len(entries),
+		"dropped", dropped,
+		"admitted", mem.Size())
+	return nil
+}
+
+func journalKey(seq uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = journalKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], seq)
+	return key
+}
+
+func journalSeq(key []byte) (uint64, error) {
+	if len(key) != 9 || key[0] != journalKeyPrefix {
+		return 0, fmt.Errorf("%w: unexpected key %X", ErrCorruptJournalEntry, key)
+	}
+	return binary.BigEndian.Uint64(key[1:]), nil
+}
+
+// encodeJournalEntry encodes the length of the lane, as a uvarint, followed
+// by the lane and the transaction.
+func encodeJournalEntry(tx types.Tx, lane LaneID) []byte {
+	bz := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(lane)+len(tx)), uint64(len(lane)))
+	bz = append(bz, lane...)
+	return append(bz, tx...)
+}
+
+func decodeJournalEntry(bz []byte) (types.Tx, LaneID, error) {
+	laneLen, n := binary.Uvarint(bz)
+	if n <= 0 || laneLen > uint64(len(bz)-n) {
+		return nil, "", ErrCorruptJournalEntry
+	}
+	lane := LaneID(bz[n : n+int(laneLen)])
+	return slices.Clone(bz[n+int(laneLen):]), lane, nil
+}
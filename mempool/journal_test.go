@@ -0,0 +1,138 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/v2/abci/example/kvstore"
+	"github.com/cometbft/cometbft/v2/internal/test"
+	"github.com/cometbft/cometbft/v2/proxy"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// newMempoolWithJournal returns a mempool with a journal stored in db, as a
+// node restarting with the journal in db would.
+func newMempoolWithJournal(t *testing.T, db dbm.DB, maxTxsBytes int64) *CListMempool {
+	t.Helper()
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.MaxTxsBytes = maxTxsBytes
+	mp, cleanup := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()), cfg)
+	t.Cleanup(cleanup)
+
+	journal, err := NewTxJournal(db)
+	require.NoError(t, err)
+	mp.journal = journal
+	return mp
+}
+
+func journalTxs(t *testing.T, journal *TxJournal) []types.Tx {
+	t.Helper()
+	entries, err := journal.entries()
+	require.NoError(t, err)
+	txs := make([]types.Tx, 0, len(entries))
+	for _, e := range entries {
+		txs = append(txs, e.tx)
+	}
+	return txs
+}
+
+func TestTxJournalReplay(t *testing.T) {
+	db := dbm.NewMemDB()
+	mp := newMempoolWithJournal(t, db, 1<<20)
+
+	txs := addTxs(t, mp, 0, 20)
+	require.NoError(t, mp.FlushAppConn())
+	require.Equal(t, 20, mp.Size())
+	assert.Equal(t, txs, journalTxs(t, mp.journal))
+
+	// The committed transactions leave the journal.
+	doUpdate(t, mp, 1, txs[:5])
+	assert.Equal(t, txs[5:], journalTxs(t, mp.journal))
+
+	// The lane of each transaction is recorded.
+	entries, err := mp.journal.entries()
+	require.NoError(t, err)
+	for i, e := range entries {
+		assert.Equal(t, kvstoreAssignLane(5+i), e.lane)
+	}
+
+	// Restart.
+	mp = newMempoolWithJournal(t, db, 1<<20)
+	require.NoError(t, mp.ReplayJournal())
+	assert.Equal(t, 15, mp.Size())
+	for _, tx := range txs[5:] {
+		assert.True(t, mp.Contains(tx.Key()))
+	}
+	// The replayed entries were replaced with the transactions admitted again.
+	assert.ElementsMatch(t, txs[5:], journalTxs(t, mp.journal))
+
+	// Replaying again does not add anything.
+	require.NoError(t, mp.ReplayJournal())
+	assert.Equal(t, 15, mp.Size())
+	assert.Len(t, journalTxs(t, mp.journal), 15)
+
+	// A transaction removed from the mempool leaves the journal.
+	require.NoError(t, mp.RemoveTxByKey(txs[5].Key()))
+	assert.NotContains(t, journalTxs(t, mp.journal), txs[5])
+
+	// Flushing the mempool clears the journal.
+	mp.Flush()
+	assert.Empty(t, journalTxs(t, mp.journal))
+}
+
+func TestTxJournalReplayMaxTxsBytes(t *testing.T) {
+	var (
+		fooTxs, otherTxs []types.Tx
+		fooBytes         int64
+	)
+	for i := 1; i <= 60; i++ {
+		tx := types.Tx(kvstore.NewTxFromID(i))
+		if kvstoreAssignLane(i) == "foo" {
+			fooTxs = append(fooTxs, tx)
+			fooBytes += int64(len(tx))
+		} else {
+			otherTxs = append(otherTxs, tx)
+		}
+	}
+	require.NotEmpty(t, fooTxs)
+
+	// The transactions of the highest-priority lane are journaled last.
+	db := dbm.NewMemDB()
+	mp := newMempoolWithJournal(t, db, 1<<20)
+	callCheckTx(t, mp, otherTxs)
+	callCheckTx(t, mp, fooTxs)
+	require.Equal(t, 60, mp.Size())
+
+	// Restart with room for the transactions of the foo lane only: the capacity
+	// of the mempool is partitioned evenly across the lanes.
+	maxTxsBytes := int64(len(kvstore.DefaultLanes())) * fooBytes
+	mp = newMempoolWithJournal(t, db, maxTxsBytes)
+	require.NoError(t, mp.ReplayJournal())
+	for _, tx := range fooTxs {
+		assert.True(t, mp.Contains(tx.Key()))
+	}
+	assert.Less(t, mp.Size(), 60)
+	assert.LessOrEqual(t, mp.SizeBytes(), maxTxsBytes)
+
+	// The transactions that were not admitted again are dropped.
+	assert.Len(t, journalTxs(t, mp.journal), mp.Size())
+}
+
+func TestTxJournalEntryEncoding(t *testing.T) {
+	for _, lane := range []LaneID{"", "default", "a-very-long-lane-name"} {
+		for _, tx := range []types.Tx{{}, types.Tx("tx"), kvstore.NewRandomTx(300)} {
+			decodedTx, decodedLane, err := decodeJournalEntry(encodeJournalEntry(tx, lane))
+			require.NoError(t, err)
+			assert.Equal(t, lane, decodedLane)
+			assert.Equal(t, []byte(tx), []byte(decodedTx))
+		}
+	}
+
+	_, _, err := decodeJournalEntry([]byte{10, 'a'})
+	require.ErrorIs(t, err, ErrCorruptJournalEntry)
+	_, _, err = decodeJournalEntry(nil)
+	require.ErrorIs(t, err, ErrCorruptJournalEntry)
+}
@@ -13,6 +13,7 @@ import (
 type mempoolTx struct {
 	height    int64    // height that this tx had been validated in
 	gasWanted int64    // amount of gas this tx states it will require
+	priority  int64    // priority assigned by the application in CheckTx
 	tx        types.Tx // validated by the application
 	lane      LaneID
 	seq       int64
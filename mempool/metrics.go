@@ -53,7 +53,8 @@ type Metrics struct {
 
 	// EvictedTxs defines the number of evicted transactions. These are valid
 	// transactions that passed CheckTx and make it into the mempool but later
-	// became invalid.
+	// became invalid, or that were removed by the priority mempool to make room
+	// for transactions with higher priority.
 	// metrics:Number of evicted transactions.
 	EvictedTxs metrics.Counter
 
@@ -0,0 +1,243 @@
+package mempool
+
+import (
+	"cmp"
+	"slices"
+
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/config"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	"github.com/cometbft/cometbft/v2/proxy"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// PriorityMempool is a mempool that orders transactions by the priority
+// returned by the application in CheckTxResponse. Reaping returns transactions
+// in decreasing order of priority (and by arrival among transactions with the
+// same priority). When the mempool is full, a new transaction is admitted only
+// if enough transactions with strictly lower priority can be evicted to make
+// room for it.
+//
+// PriorityMempool is built on top of CListMempool, with which it shares the
+// storage of transactions, rechecking, caching, gossiping (the mempool Reactor
+// and iterators see transactions in arrival order) and metrics. It does not
+// support lanes: all transactions are stored in a single lane, regardless of
+// the lane returned by the application.
+type PriorityMempool struct {
+	*CListMempool
+
+	// Entries sorted by priority, in descending order, and by arrival among
+	// entries with the same priority. Protected by txsMtx.
+	byPriority []*mempoolTx
+}
+
+var _ Mempool = &PriorityMempool{}
+
+// NewPriorityMempool returns a new priority mempool with the given
+// configuration and connection to an application.
+func NewPriorityMempool(
+	cfg *config.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	options ...CListMempoolOption,
+) *PriorityMempool {
+	mp := &PriorityMempool{
+		CListMempool: NewCListMempool(cfg, proxyAppConn, nil, height, options...),
+	}
+	mp.admission = mp
+	mp.index = mp
+	return mp
+}
+
+// checkFull implements admissionPolicy. The priority of a transaction is only
+// known after CheckTx, so the mempool is considered full only if the
+// transaction would not fit even in an empty mempool.
+func (mem *PriorityMempool) checkFull(txSize int) error {
+	if int64(txSize) > mem.config.MaxTxsBytes {
+		return ErrMempoolIsFull{
+			NumTxs:      mem.Size(),
+			MaxTxs:      mem.config.Size,
+			TxsBytes:    mem.SizeBytes(),
+			MaxTxsBytes: mem.config.MaxTxsBytes,
+		}
+	}
+
+	if mem.recheck.consideredFull() {
+		return ErrRecheckFull
+	}
+
+	return nil
+}
+
+// laneFor implements admissionPolicy. Lanes returned by the application are
+// ignored.
+func (mem *PriorityMempool) laneFor(*abci.CheckTxResponse) LaneID {
+	return mem.defaultLane
+}
+
+// makeRoom implements admissionPolicy. If the mempool is full, it evicts the
+// lowest-priority transactions, as long as their priority is strictly lower
+// than the priority of tx and evicting them frees enough space for tx.
+// Otherwise, it returns ErrMempoolIsFull and nothing is evicted.
+func (mem *PriorityMempool) makeRoom(tx types.Tx, res *abci.CheckTxResponse, _ LaneID) error {
+	if mem.recheck.consideredFull() {
+		return ErrRecheckFull
+	}
+
+	mem.txsMtx.RLock()
+	if _, ok := mem.txsMap[tx.Key()]; ok {
+		// The caller will reject the duplicate; don't evict anything for it.
+		mem.txsMtx.RUnlock()
+		return nil
+	}
+	numTxs, txsBytes := mem.numTxs, mem.txsBytes
+	fits := func(evictedTxs, evictedBytes int64) bool {
+		return numTxs-evictedTxs < int64(mem.config.Size) &&
+			txsBytes-evictedBytes+int64(len(tx)) <= mem.config.MaxTxsBytes
+	}
+
+	// Evict first the transactions with lowest priority and, among those with
+	// the same priority, the most recent ones.
+	type evictedTx struct {
+		tx       types.Tx
+		priority int64
+	}
+	var (
+		evicted                  []evictedTx
+		evictedTxs, evictedBytes int64
+	)
+	for i := len(mem.byPriority) - 1; i >= 0 && !fits(evictedTxs, evictedBytes); i-- {
+		memTx := mem.byPriority[i]
+		if memTx.priority >= res.Priority {
+			break
+		}
+		evicted = append(evicted, evictedTx{tx: memTx.tx, priority: memTx.priority})
+		evictedTxs++
+		evictedBytes += int64(len(memTx.tx))
+	}
+	mem.txsMtx.RUnlock()
+
+	if !fits(evictedTxs, evictedBytes) {
+		return ErrMempoolIsFull{
+			NumTxs:      int(numTxs),
+			MaxTxs:      mem.config.Size,
+			TxsBytes:    txsBytes,
+			MaxTxsBytes: mem.config.MaxTxsBytes,
+		}
+	}
+
+	for _, e := range evicted {
+		if err := mem.removeTx(e.tx.Key(), RemovedEvicted); err != nil {
+			// It may have been removed concurrently, e.g., by a recheck.
+			continue
+		}
+		// Allow the transaction to be resubmitted once there is room again.
+		mem.forceRemoveFromCache(e.tx)
+		mem.metrics.EvictedTxs.Add(1)
+		mem.logger.Debug(
+			"Evicted transaction to make room for one with higher priority",
+			"tx", log.NewLazyHash(e.tx),
+			"priority", e.priority,
+			"new-tx", log.NewLazyHash(tx),
+			"new-priority", res.Priority,
+		)
+	}
+
+	return nil
+}
+
+// comparePriority orders entries by priority, in descending order, and by
+// arrival among entries with the same priority.
+func comparePriority(a, b *mempoolTx) int {
+	if c := cmp.Compare(b.priority, a.priority); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.seq, b.seq)
+}
+
+// insert implements txIndex.
+func (mem *PriorityMempool) insert(memTx *mempoolTx) {
+	i, _ := slices.BinarySearchFunc(mem.byPriority, memTx, comparePriority)
+	mem.byPriority = slices.Insert(mem.byPriority, i, memTx)
+}
+
+// remove implements txIndex.
+func (mem *PriorityMempool) remove(memTx *mempoolTx) {
+	if i, ok := slices.BinarySearchFunc(mem.byPriority, memTx, comparePriority); ok {
+		mem.byPriority = slices.Delete(mem.byPriority, i, i+1)
+	}
+}
+
+// reset implements txIndex.
+func (mem *PriorityMempool) reset() {
+	mem.byPriority = nil
+}
+
+// sortedEntries returns a copy of the entries in the mempool sorted by
+// priority, in descending order, and by arrival among entries with the same
+// priority.
+func (mem *PriorityMempool) sortedEntries() []*mempoolTx {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	return slices.Clone(mem.byPriority)
+}
+
+// ReapMaxBytesMaxGas reaps transactions in decreasing order of priority, up to
+// maxBytes bytes total and with the total gasWanted not exceeding maxGas. It
+// stops at the first transaction that does not fit, so that a lower-priority
+// transaction is never included instead of a higher-priority one.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	var (
+		totalGas    int64
+		runningSize int64
+	)
+
+	entries := mem.sortedEntries()
+	txs := make([]types.Tx, 0, len(entries))
+	for _, memTx := range entries {
+		dataSize := types.ComputeProtoSizeForTxs([]types.Tx{memTx.tx})
+
+		// Check total size requirement
+		if maxBytes > -1 && runningSize+dataSize > maxBytes {
+			break
+		}
+
+		// Check total gas requirement.
+		// If maxGas is negative, skip this check.
+		newTotalGas := totalGas + memTx.gasWanted
+		if maxGas > -1 && newTotalGas > maxGas {
+			break
+		}
+
+		runningSize += dataSize
+		totalGas = newTotalGas
+		txs = append(txs, memTx.tx)
+	}
+	return txs
+}
+
+// ReapMaxTxs reaps up to max transactions in decreasing order of priority. If
+// max is negative, all transactions are returned.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxTxs(max int) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	entries := mem.sortedEntries()
+	if max < 0 || max > len(entries) {
+		max = len(entries)
+	}
+
+	txs := make([]types.Tx, 0, max)
+	for _, memTx := range entries[:max] {
+		txs = append(txs, memTx.tx)
+	}
+	return txs
+}
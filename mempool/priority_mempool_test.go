@@ -0,0 +1,223 @@
+package mempool
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/internal/test"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	"github.com/cometbft/cometbft/v2/proxy"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// priorityApp accepts all transactions and assigns them the priority encoded
+// in the first 8 bytes of the transaction. It also assigns a lane that does not
+// exist, to check that the priority mempool ignores lanes.
+type priorityApp struct {
+	abci.BaseApplication
+
+	// If set, rechecked transactions get the opposite priority.
+	negateOnRecheck bool
+}
+
+func (app *priorityApp) CheckTx(_ context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	priority := int64(binary.BigEndian.Uint64(req.Tx[:8]))
+	if app.negateOnRecheck && req.Type == abci.CHECK_TX_TYPE_RECHECK {
+		priority = -priority
+	}
+	return &abci.CheckTxResponse{
+		Code:      abci.CodeTypeOK,
+		GasWanted: 1,
+		Priority:  priority,
+		LaneId:    "unknown",
+	}, nil
+}
+
+// newPriorityTx returns a transaction of 20 bytes with the given priority and
+// unique id.
+func newPriorityTx(priority int64, id uint32) types.Tx {
+	tx := make([]byte, 20)
+	binary.BigEndian.PutUint64(tx[:8], uint64(priority))
+	binary.BigEndian.PutUint32(tx[8:12], id)
+	return tx
+}
+
+func newPriorityMempool(t *testing.T, size int, maxTxsBytes int64) *PriorityMempool {
+	t.Helper()
+	return newPriorityMempoolWithApp(t, &priorityApp{}, size, maxTxsBytes)
+}
+
+func newPriorityMempoolWithApp(t *testing.T, app *priorityApp, size int, maxTxsBytes int64) *PriorityMempool {
+	t.Helper()
+	cfg := test.ResetTestRoot("mempool_test")
+	t.Cleanup(func() { os.RemoveAll(cfg.RootDir) })
+	cfg.Mempool.Size = size
+	cfg.Mempool.MaxTxsBytes = maxTxsBytes
+
+	appConnMem, err := proxy.NewLocalClientCreator(app).NewABCIMempoolClient()
+	require.NoError(t, err)
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() { _ = appConnMem.Stop() })
+
+	mp := NewPriorityMempool(cfg.Mempool, appConnMem, 0)
+	mp.SetLogger(log.TestingLogger())
+	return mp
+}
+
+func checkPriorityTx(t *testing.T, mp *PriorityMempool, tx types.Tx) error {
+	t.Helper()
+	rr, err := mp.CheckTx(tx, "")
+	if err != nil {
+		return err
+	}
+	rr.Wait()
+	return rr.Error()
+}
+
+func TestPriorityMempoolReapOrder(t *testing.T) {
+	mp := newPriorityMempool(t, 100, 1<<20)
+
+	priorities := []int64{5, 1, 9, 5, 3, 9}
+	txs := make(types.Txs, len(priorities))
+	for i, p := range priorities {
+		txs[i] = newPriorityTx(p, uint32(i))
+		require.NoError(t, checkPriorityTx(t, mp, txs[i]))
+	}
+	require.Equal(t, len(priorities), mp.Size())
+
+	// Higher priority first; arrival order among equal priorities.
+	expected := types.Txs{txs[2], txs[5], txs[0], txs[3], txs[4], txs[1]}
+	require.Equal(t, expected, mp.ReapMaxTxs(-1))
+	require.Equal(t, expected[:2], mp.ReapMaxTxs(2))
+	require.Equal(t, expected, mp.ReapMaxBytesMaxGas(-1, -1))
+	require.Equal(t, expected[:3], mp.ReapMaxBytesMaxGas(-1, 3))
+	// Each tx takes 22 bytes when encoded.
+	require.Equal(t, expected[:4], mp.ReapMaxBytesMaxGas(4*22+10, -1))
+
+	// All txs are stored in a single lane, ignoring the lane set by the app.
+	numTxs, _ := mp.LaneSizes(mp.defaultLane)
+	require.Equal(t, len(priorities), numTxs)
+}
+
+func TestPriorityMempoolEviction(t *testing.T) {
+	mp := newPriorityMempool(t, 3, 1<<20)
+
+	tx1 := newPriorityTx(10, 1)
+	tx2 := newPriorityTx(20, 2)
+	tx3 := newPriorityTx(5, 3)
+	for _, tx := range []types.Tx{tx1, tx2, tx3} {
+		require.NoError(t, checkPriorityTx(t, mp, tx))
+	}
+	require.Equal(t, 3, mp.Size())
+
+	// A tx with a priority not higher than any other is rejected.
+	err := checkPriorityTx(t, mp, newPriorityTx(5, 4))
+	require.ErrorAs(t, err, &ErrMempoolIsFull{})
+	require.Equal(t, 3, mp.Size())
+
+	// A tx with a higher priority evicts the one with the lowest priority.
+	tx5 := newPriorityTx(15, 5)
+	require.NoError(t, checkPriorityTx(t, mp, tx5))
+	require.Equal(t, 3, mp.Size())
+	require.False(t, mp.Contains(tx3.Key()))
+	require.Equal(t, types.Txs{tx2, tx5, tx1}, mp.ReapMaxTxs(-1))
+
+	// The evicted tx was removed from the cache, so it can be resubmitted
+	// once there is room for it.
+	doUpdate(t, mp, 1, types.Txs{tx2})
+	require.NoError(t, checkPriorityTx(t, mp, tx3))
+	require.Equal(t, types.Txs{tx5, tx1, tx3}, mp.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolEvictionByBytes(t *testing.T) {
+	// Room for three 20-byte txs.
+	mp := newPriorityMempool(t, 100, 60)
+
+	for i, p := range []int64{1, 2, 3} {
+		require.NoError(t, checkPriorityTx(t, mp, newPriorityTx(p, uint32(i))))
+	}
+
+	// A bigger tx needs two txs to be evicted; it has priority higher than only
+	// one of them, so it is rejected and nothing is evicted.
+	bigTx := append(newPriorityTx(2, 10), make([]byte, 10)...)
+	err := checkPriorityTx(t, mp, bigTx)
+	require.ErrorAs(t, err, &ErrMempoolIsFull{})
+	require.Equal(t, 3, mp.Size())
+
+	// With a higher priority, the two lowest-priority txs are evicted.
+	bigTx = append(newPriorityTx(4, 11), make([]byte, 10)...)
+	require.NoError(t, checkPriorityTx(t, mp, bigTx))
+	require.Equal(t, 2, mp.Size())
+	require.Equal(t, int64(50), mp.SizeBytes())
+	require.Equal(t, types.Txs{bigTx, newPriorityTx(3, 2)}, mp.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolRecheckUpdatesPriority(t *testing.T) {
+	mp := newPriorityMempoolWithApp(t, &priorityApp{negateOnRecheck: true}, 3, 1<<20)
+
+	tx1 := newPriorityTx(1, 1)
+	tx2 := newPriorityTx(2, 2)
+	tx3 := newPriorityTx(3, 3)
+	for _, tx := range []types.Tx{tx1, tx2, tx3} {
+		require.NoError(t, checkPriorityTx(t, mp, tx))
+	}
+	require.Equal(t, types.Txs{tx3, tx2, tx1}, mp.ReapMaxTxs(-1))
+
+	// Rechecking inverts the priorities, and so the reaping order.
+	doUpdate(t, mp, 1, nil)
+	require.Equal(t, types.Txs{tx1, tx2, tx3}, mp.ReapMaxTxs(-1))
+
+	// Eviction uses the updated priorities: tx3 now has the lowest one.
+	tx4 := newPriorityTx(0, 4)
+	require.NoError(t, checkPriorityTx(t, mp, tx4))
+	require.False(t, mp.Contains(tx3.Key()))
+	require.Equal(t, types.Txs{tx4, tx1, tx2}, mp.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolConcurrentAdmission(t *testing.T) {
+	const size = 10
+	mp := newPriorityMempool(t, size, 1<<20)
+
+	for i := 0; i < size; i++ {
+		require.NoError(t, checkPriorityTx(t, mp, newPriorityTx(0, uint32(i))))
+	}
+
+	// Higher-priority txs compete for the same room; the mempool never grows
+	// beyond its limits.
+	var wg sync.WaitGroup
+	for i := 0; i < 4*size; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = checkPriorityTx(t, mp, newPriorityTx(int64(i%5)+1, uint32(size+i)))
+		}(i)
+	}
+	wg.Wait()
+
+	require.Equal(t, size, mp.Size())
+	require.Equal(t, int64(size*20), mp.SizeBytes())
+	require.Len(t, mp.ReapMaxTxs(-1), size)
+}
+
+func TestPriorityMempoolReactorIterator(t *testing.T) {
+	mp := newPriorityMempool(t, 100, 1<<20)
+
+	txs := []types.Tx{newPriorityTx(1, 1), newPriorityTx(3, 2), newPriorityTx(2, 3)}
+	for _, tx := range txs {
+		require.NoError(t, checkPriorityTx(t, mp, tx))
+	}
+
+	// Gossiping iterates over txs in arrival order.
+	iter := NewBlockingIterator(context.Background(), mp.CListMempool, t.Name())
+	for _, tx := range txs {
+		entry := <-iter.WaitNextCh()
+		require.NotNil(t, entry)
+		require.Equal(t, tx, entry.Tx())
+	}
+}
@@ -0,0 +1,84 @@
+package mempool
+
+import (
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+	"github.com/cometbft/cometbft/v2/types"
+	cmttime "github.com/cometbft/cometbft/v2/types/time"
+)
+
+// maxTxTraceEvents is the maximum number of events kept per transaction. Once
+// reached, the oldest events are dropped, except for the first one.
+const maxTxTraceEvents = 64
+
+// Reasons why a transaction left the mempool.
+const (
+	RemovedCommitted = "committed"
+	RemovedRecheck   = "invalid on recheck"
+	RemovedEvicted   = "evicted by a higher-priority transaction"
+	RemovedFlushed   = "mempool flushed"
+	RemovedByKey     = "removed by key"
+)
+
+// TxTracer records the events of the lifecycle of the transactions seen by the
+// mempool, so that one can tell why a transaction did not make it into a
+// block. Only the events of the last transactions seen are kept: the tracer is
+// a ring of traces, one per transaction.
+type TxTracer struct {
+	onEvent func(types.EventDataMempoolTx)
+
+	mtx    cmtsync.Mutex
+	traces map[types.TxKey][]types.MempoolTxEvent
+	ring   []types.TxKey // keys of the traced txs, in the order they were first seen
+	next   int           // index in ring of the next trace to replace
+}
+
+// NewTxTracer returns a tracer keeping the events of the last size
+// transactions seen. If not nil, onEvent is called with each event, whether or
+// not size is zero.
+func NewTxTracer(size int, onEvent func(types.EventDataMempoolTx)) *TxTracer {
+	return &TxTracer{
+		onEvent: onEvent,
+		traces:  make(map[types.TxKey][]types.MempoolTxEvent, size),
+		ring:    make([]types.TxKey, 0, size),
+	}
+}
+
+// Events returns the events recorded for the transaction, from oldest to
+// newest, or nil if the transaction is not traced.
+func (t *TxTracer) Events(txKey types.TxKey) []types.MempoolTxEvent {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	events, ok := t.traces[txKey]
+	if !ok {
+		return nil
+	}
+	return append([]types.MempoolTxEvent(nil), events...)
+}
+
+// record adds event to the trace of the transaction.
+func (t *TxTracer) record(txKey types.TxKey, event types.MempoolTxEvent) {
+	event.Time = cmttime.Now()
+
+	t.mtx.Lock()
+	if cap(t.ring) > 0 {
+		events, ok := t.traces[txKey]
+		switch {
+		case !ok && len(t.ring) < cap(t.ring):
+			t.ring = append(t.ring, txKey)
+		case !ok:
+			// Replace the trace of the oldest transaction.
+			delete(t.traces, t.ring[t.next])
+			t.ring[t.next] = txKey
+			t.next = (t.next + 1) % len(t.ring)
+		case len(events) == maxTxTraceEvents:
+			events = append(events[:1], events[2:]...)
+		}
+		t.traces[txKey] = append(events, event)
+	}
+	t.mtx.Unlock()
+
+	if t.onEvent != nil {
+		t.onEvent(types.EventDataMempoolTx{Hash: txKey.Hash(), Event: event})
+	}
+}
@@ -0,0 +1,113 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/v2/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/proxy"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+func eventTypes(events []types.MempoolTxEvent) []string {
+	eventTypes := make([]string, 0, len(events))
+	for _, e := range events {
+		eventTypes = append(eventTypes, e.Type)
+	}
+	return eventTypes
+}
+
+func TestTxTracerLifecycle(t *testing.T) {
+	mp, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()))
+	defer cleanup()
+	var published []types.EventDataMempoolTx
+	mp.tracer = NewTxTracer(10, func(ev types.EventDataMempoolTx) {
+		published = append(published, ev)
+	})
+
+	// A transaction added to the mempool and then committed.
+	txs := addTxs(t, mp, 0, 2)
+	require.NoError(t, mp.FlushAppConn())
+	events := mp.tracer.Events(txs[0].Key())
+	require.Equal(t, []string{types.MempoolTxReceived, types.MempoolTxChecked, types.MempoolTxAdded}, eventTypes(events))
+	assert.Equal(t, string(kvstoreAssignLane(0)), events[2].Lane)
+
+	doUpdate(t, mp, 1, txs[:1])
+	events = mp.tracer.Events(txs[0].Key())
+	require.Equal(t, []string{
+		types.MempoolTxReceived, types.MempoolTxChecked, types.MempoolTxAdded,
+		types.MempoolTxIncluded, types.MempoolTxRemoved,
+	}, eventTypes(events))
+	assert.Equal(t, int64(1), events[3].Height)
+	assert.Equal(t, RemovedCommitted, events[4].Reason)
+
+	// The transaction left in the mempool is rechecked.
+	events = mp.tracer.Events(txs[1].Key())
+	require.Len(t, events, 4)
+	assert.Equal(t, types.MempoolTxRechecked, events[3].Type)
+	assert.Equal(t, abci.CodeTypeOK, events[3].Code)
+
+	// A transaction rejected by CheckTx.
+	invalidTx := types.Tx("invalid")
+	_, err := mp.CheckTx(invalidTx, "peer")
+	require.NoError(t, err)
+	require.NoError(t, mp.FlushAppConn())
+	events = mp.tracer.Events(invalidTx.Key())
+	require.Equal(t, []string{types.MempoolTxReceived, types.MempoolTxChecked, types.MempoolTxRejected}, eventTypes(events))
+	assert.Equal(t, "peer", events[0].Sender)
+	assert.Equal(t, kvstore.CodeTypeInvalidTxFormat, events[1].Code)
+	assert.NotEmpty(t, events[2].Reason)
+
+	// Flushing the mempool removes the transaction left.
+	mp.Flush()
+	events = mp.tracer.Events(txs[1].Key())
+	assert.Equal(t, types.MempoolTxRemoved, events[len(events)-1].Type)
+	assert.Equal(t, RemovedFlushed, events[len(events)-1].Reason)
+
+	// All the events were published.
+	numEvents := 0
+	for _, tx := range append(txs, invalidTx) {
+		numEvents += len(mp.tracer.Events(tx.Key()))
+	}
+	assert.Len(t, published, numEvents)
+	assert.Equal(t, types.EventDataMempoolTx{Hash: txs[0].Hash(), Event: mp.tracer.Events(txs[0].Key())[0]}, published[0])
+
+	assert.Nil(t, mp.tracer.Events(types.Tx("unknown").Key()))
+}
+
+func TestTxTracerRing(t *testing.T) {
+	tracer := NewTxTracer(3, nil)
+	txs := NewRandomTxs(5, 10)
+	for _, tx := range txs {
+		tracer.record(tx.Key(), types.MempoolTxEvent{Type: types.MempoolTxReceived})
+	}
+
+	// Only the last 3 transactions seen are traced.
+	for _, tx := range txs[:2] {
+		assert.Nil(t, tracer.Events(tx.Key()))
+	}
+	for _, tx := range txs[2:] {
+		assert.Len(t, tracer.Events(tx.Key()), 1)
+	}
+
+	// The first event of a transaction is kept when its trace is full.
+	tracer.record(txs[4].Key(), types.MempoolTxEvent{Type: types.MempoolTxAdded})
+	for i := 0; i < maxTxTraceEvents; i++ {
+		tracer.record(txs[4].Key(), types.MempoolTxEvent{Type: types.MempoolTxRechecked})
+	}
+	events := tracer.Events(txs[4].Key())
+	require.Len(t, events, maxTxTraceEvents)
+	assert.Equal(t, types.MempoolTxReceived, events[0].Type)
+	assert.Equal(t, types.MempoolTxRechecked, events[1].Type)
+
+	// Recording events for traced transactions does not evict other traces.
+	assert.Len(t, tracer.Events(txs[2].Key()), 1)
+
+	// A tracer of size zero keeps nothing.
+	tracer = NewTxTracer(0, nil)
+	tracer.record(txs[0].Key(), types.MempoolTxEvent{Type: types.MempoolTxReceived})
+	assert.Nil(t, tracer.Events(txs[0].Key()))
+}
@@ -0,0 +1,99 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	cfg "github.com/cometbft/cometbft/v2/config"
+	"github.com/cometbft/cometbft/v2/internal/blocksync"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	mempl "github.com/cometbft/cometbft/v2/mempool"
+	"github.com/cometbft/cometbft/v2/proxy"
+	sm "github.com/cometbft/cometbft/v2/state"
+	"github.com/cometbft/cometbft/v2/store"
+)
+
+// SyncFromBlockArchive syncs the stores of the node, and the application, from
+// the block archive at archivePath instead of from peers, then returns the
+// height of the last block applied. This allows reaching the tip of the chain
+// without state sync and without network access; the node then starts from
+// that height.
+//
+// A block archive is a block store export, as written by store.Export.
+func SyncFromBlockArchive(ctx context.Context,
+	config *cfg.Config,
+	clientCreator proxy.ClientCreator,
+	genesisDocProvider GenesisDocProvider,
+	dbProvider cfg.DBProvider,
+	archivePath string,
+	logger log.Logger,
+) (int64, error) {
+	blockStoreDB, stateDB, err := initDBs(config, dbProvider)
+	if err != nil {
+		return 0, err
+	}
+	state, genDoc, err := LoadStateFromDBOrGenesisDocProvider(stateDB, genesisDocProvider, "")
+	if err != nil {
+		return 0, err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+		Logger:               logger,
+		DBKeyLayout:          config.Storage.ExperimentalKeyLayout,
+	})
+	defer stateStore.Close()
+	blockStore := store.NewBlockStore(blockStoreDB, store.WithDBKeyLayout(config.Storage.ExperimentalKeyLayout))
+	defer blockStore.Close()
+
+	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, proxy.NopMetrics())
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = proxyApp.Stop() }()
+
+	eventBus, err := createAndStartEventBus(logger)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = eventBus.Stop() }()
+
+	indexerService, _, _, err := createAndStartIndexerService(config, genDoc.ChainID, dbProvider, eventBus, logger)
+	if err != nil {
+		return 0, err
+	}
+	if indexerService != nil {
+		defer func() { _ = indexerService.Stop() }()
+	}
+
+	// Sync the application with the stores first, as when starting the node.
+	appInfoResponse, err := proxyApp.Query().Info(ctx, proxy.InfoRequest)
+	if err != nil {
+		return 0, fmt.Errorf("error calling ABCI Info method: %v", err)
+	}
+	if err := doHandshake(ctx, stateStore, state, blockStore, genDoc, eventBus, appInfoResponse, proxyApp, logger.With("module", "consensus")); err != nil {
+		return 0, ErrHandshake{Err: err}
+	}
+	state, err = stateStore.Load()
+	if err != nil {
+		return 0, sm.ErrCannotLoadState{Err: err}
+	}
+
+	_, evidencePool, err := createEvidenceReactor(config, dbProvider, stateStore, blockStore, logger)
+	if err != nil {
+		return 0, err
+	}
+	defer evidencePool.Close()
+
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		logger.With("module", "state"),
+		proxyApp.Consensus(),
+		&mempl.NopMempool{},
+		evidencePool,
+		blockStore,
+	)
+	blockExec.SetEventBus(eventBus)
+
+	state, err = blocksync.SyncFromArchive(state, blockExec, blockStore, archivePath, logger.With("module", "blocksync"))
+	return state.LastBlockHeight, err
+}
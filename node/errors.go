@@ -64,6 +64,19 @@ func (e ErrPrivValidatorSocketClient) Unwrap() error {
 	return e.Err
 }
 
+// ErrPrivValidatorGRPCClient is returned when the node fails to create private validator gRPC client.
+type ErrPrivValidatorGRPCClient struct {
+	Err error
+}
+
+func (e ErrPrivValidatorGRPCClient) Error() string {
+	return fmt.Sprintf("error with private validator gRPC client: %v", e.Err)
+}
+
+func (e ErrPrivValidatorGRPCClient) Unwrap() error {
+	return e.Err
+}
+
 // ErrGetPubKey is returned when the node fails to get the public key.
 type ErrGetPubKey struct {
 	Err error
@@ -289,3 +302,17 @@ func (e ErrorLoadOrGenFilePV) Error() string {
 func (e ErrorLoadOrGenFilePV) Unwrap() error {
 	return e.Err
 }
+
+// ErrorOpenSignLog is returned when the node fails to open the sign log of the priv validator.
+type ErrorOpenSignLog struct {
+	Err         error
+	SignLogFile string
+}
+
+func (e ErrorOpenSignLog) Error() string {
+	return fmt.Sprintf("failed to open privval sign log %s: %v", e.SignLogFile, e.Err)
+}
+
+func (e ErrorOpenSignLog) Unwrap() error {
+	return e.Err
+}
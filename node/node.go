@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
@@ -23,6 +24,7 @@ import (
 	bc "github.com/cometbft/cometbft/v2/internal/blocksync"
 	cs "github.com/cometbft/cometbft/v2/internal/consensus"
 	"github.com/cometbft/cometbft/v2/internal/evidence"
+	"github.com/cometbft/cometbft/v2/internal/rpcsub"
 	cmtjson "github.com/cometbft/cometbft/v2/libs/json"
 	"github.com/cometbft/cometbft/v2/libs/log"
 	cmtpubsub "github.com/cometbft/cometbft/v2/libs/pubsub"
@@ -32,7 +34,6 @@ import (
 	"github.com/cometbft/cometbft/v2/p2p"
 	na "github.com/cometbft/cometbft/v2/p2p/netaddr"
 	"github.com/cometbft/cometbft/v2/p2p/pex"
-	"github.com/cometbft/cometbft/v2/p2p/transport/tcp"
 	"github.com/cometbft/cometbft/v2/proxy"
 	rpccore "github.com/cometbft/cometbft/v2/rpc/core"
 	grpcserver "github.com/cometbft/cometbft/v2/rpc/grpc/server"
@@ -60,7 +61,7 @@ type Node struct {
 	privValidator types.PrivValidator // local node's validator key
 
 	// network
-	transport   *tcp.MultiplexTransport
+	transport   p2pTransport
 	sw          *p2p.Switch  // p2p connections
 	addrBook    pex.AddrBook // known peers
 	nodeInfo    p2p.NodeInfo
@@ -75,6 +76,8 @@ type Node struct {
 	bcReactor        p2p.Reactor    // for block-syncing
 	mempoolReactor   mempoolReactor // for gossipping transactions
 	mempool          mempl.Mempool
+	mempoolJournal   *mempl.TxJournal
+	mempoolTracer    *mempl.TxTracer
 	consensusState   *cs.State      // latest consensus state
 	consensusReactor *cs.Reactor    // for participating in the consensus
 	pexReactor       *pex.Reactor   // for exchanging peer addresses
@@ -86,6 +89,7 @@ type Node struct {
 	indexerService   *txindex.IndexerService
 	prometheusSrv    *http.Server
 	pprofSrv         *http.Server
+	abciRecorder     *proxy.Recorder
 
 	// statesync
 	stateSync         bool                    // whether the node should statesync on startup
@@ -362,8 +366,26 @@ func NewNodeWithCliParams(ctx context.Context,
 		logger.Error("Failed to delete genesis doc from DB ", err)
 	}
 
+	// Record the calls to the ABCI app, if enabled.
+	abciRecorder, err := createAndStartABCIRecorder(config, logger)
+	if err != nil {
+		return nil, err
+	}
+	proxyAppOptions := []proxy.MultiAppConnOption{
+		proxy.WithQueryConnections(config.ABCIQueryConnections, proxy.QueryDispatch(config.ABCIQueryDispatch)),
+	}
+	if abciRecorder != nil {
+		proxyAppOptions = append(proxyAppOptions, proxy.WithRecorder(abciRecorder))
+	}
+	if config.ABCIReconnect {
+		proxyAppOptions = append(proxyAppOptions, proxy.WithReconnect(proxy.ReconnectConfig{
+			MaxBackoff: config.ABCIReconnectMaxBackoff,
+			Handshake:  reconnectHandshake(stateStore, blockStore, genDoc, logger.With("module", "consensus")),
+		}))
+	}
+
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
-	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, abciMetrics)
+	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, abciMetrics, proxyAppOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -393,6 +415,14 @@ func NewNodeWithCliParams(ctx context.Context,
 		}
 	}
 
+	// If a gRPC address is provided, dial the external signing process.
+	if config.PrivValidatorGRPCAddr != "" {
+		privValidator, err = createPrivValidatorGRPCClient(config, genDoc.ChainID)
+		if err != nil {
+			return nil, ErrPrivValidatorGRPCClient{Err: err}
+		}
+	}
+
 	pubKey, err := privValidator.GetPubKey()
 	if err != nil {
 		return nil, ErrGetPubKey{Err: err}
@@ -434,7 +464,12 @@ func NewNodeWithCliParams(ctx context.Context,
 	// Blocksync is always active, except if the local node blocks the chain
 	waitSync := !state.Validators.ValidatorBlocksTheChain(localAddr)
 
-	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, eventBus, waitSync, memplMetrics, logger, appInfoResponse)
+	mempoolJournal, err := createMempoolJournal(config, dbProvider, stateSync)
+	if err != nil {
+		return nil, err
+	}
+	mempoolTracer := createMempoolTxTracer(config, eventBus)
+	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, eventBus, waitSync, memplMetrics, logger, appInfoResponse, mempoolJournal, mempoolTracer)
 
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateStore, blockStore, logger)
 	if err != nil {
@@ -506,7 +541,10 @@ func NewNodeWithCliParams(ctx context.Context,
 		return nil, err
 	}
 
-	transport, peerFilters := createTransport(config, nodeKey, proxyApp)
+	transport, peerFilters, err := createTransport(config, nodeKey, proxyApp)
+	if err != nil {
+		return nil, err
+	}
 
 	p2pLogger := logger.With("module", "p2p")
 	transport.SetLogger(p2pLogger)
@@ -568,11 +606,14 @@ func NewNodeWithCliParams(ctx context.Context,
 		bcReactor:        bcReactor,
 		mempoolReactor:   mempoolReactor,
 		mempool:          mempool,
+		mempoolJournal:   mempoolJournal,
+		mempoolTracer:    mempoolTracer,
 		consensusState:   consensusState,
 		consensusReactor: consensusReactor,
 		pexReactor:       pexReactor,
 		evidencePool:     evidencePool,
 		proxyApp:         proxyApp,
+		abciRecorder:     abciRecorder,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
 		blockIndexer:     blockIndexer,
@@ -757,6 +798,10 @@ func (n *Node) OnStop() {
 		if err := pvsc.Stop(); err != nil {
 			n.Logger.Error("Error closing private validator", "err", err)
 		}
+	} else if pvc, ok := n.privValidator.(io.Closer); ok {
+		if err := pvc.Close(); err != nil {
+			n.Logger.Error("Error closing private validator", "err", err)
+		}
 	}
 
 	if n.prometheusSrv != nil {
@@ -782,6 +827,18 @@ func (n *Node) OnStop() {
 			n.Logger.Error("problem closing statestore", "err", err)
 		}
 	}
+	if n.mempoolJournal != nil {
+		n.Logger.Info("Closing mempool journal")
+		if err := n.mempoolJournal.Close(); err != nil {
+			n.Logger.Error("problem closing mempool journal", "err", err)
+		}
+	}
+	if n.abciRecorder != nil {
+		n.Logger.Info("Closing ABCI recording")
+		if err := n.abciRecorder.Stop(); err != nil {
+			n.Logger.Error("problem closing ABCI recording", "err", err)
+		}
+	}
 	if n.evidencePool != nil {
 		n.Logger.Info("Closing evidencestore")
 		if err := n.EvidencePool().Close(); err != nil {
@@ -816,6 +873,7 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 		MempoolReactor:   n.mempoolReactor,
 		EventBus:         n.eventBus,
 		Mempool:          n.mempool,
+		MempoolTracer:    n.mempoolTracer,
 
 		Logger: n.Logger.With("module", "rpc"),
 
@@ -857,6 +915,11 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		config.WriteTimeout = n.config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
 	}
 
+	rateLimiter, err := n.createRPCRateLimiter()
+	if err != nil {
+		return nil, err
+	}
+
 	// we may expose the rpc over both a unix and tcp socket
 	listeners := make([]net.Listener, 0, len(listenAddrs))
 	for _, listenAddr := range listenAddrs {
@@ -872,11 +935,12 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 			}),
 			rpcserver.ReadLimit(config.MaxBodyBytes),
 			rpcserver.WriteChanCapacity(n.config.RPC.WebSocketWriteBufferSize),
+			rpcserver.RateLimiting(rateLimiter),
 		)
 		wm.SetLogger(wmLogger)
 		mux.HandleFunc("/websocket", wm.WebsocketHandler)
 		mux.HandleFunc("/v1/websocket", wm.WebsocketHandler)
-		rpcserver.RegisterRPCFuncs(mux, routes, rpcLogger)
+		rpcserver.RegisterRPCFuncs(mux, routes, rpcLogger, rpcserver.WithRateLimiter(rateLimiter))
 		listener, err := rpcserver.Listen(
 			listenAddr,
 			config.MaxOpenConnections,
@@ -935,15 +999,29 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		opts := []grpcserver.Option{
 			grpcserver.WithLogger(n.Logger),
 		}
+		// The subscriptions of the gRPC services share the limits of the RPC.
+		subscriptions := rpcsub.NewLimiter(n.config.RPC.MaxSubscriptionClients, n.config.RPC.MaxSubscriptionsPerClient)
 		if n.config.GRPC.VersionService.Enabled {
 			opts = append(opts, grpcserver.WithVersionService())
 		}
 		if n.config.GRPC.BlockService.Enabled {
-			opts = append(opts, grpcserver.WithBlockService(n.blockStore, n.eventBus, n.Logger))
+			opts = append(opts, grpcserver.WithBlockService(n.blockStore, n.stateStore, n.eventBus, n.Logger))
 		}
 		if n.config.GRPC.BlockResultsService.Enabled {
 			opts = append(opts, grpcserver.WithBlockResultsService(n.blockStore, n.stateStore, n.Logger))
 		}
+		if n.config.GRPC.MempoolService.Enabled {
+			opts = append(opts, grpcserver.WithMempoolService(
+				n.mempoolReactor, n.mempool, n.proxyApp.Mempool(), n.eventBus, subscriptions,
+				n.config.GRPC.MempoolService.TimeoutBroadcastTxCommit, n.Logger,
+			))
+		}
+		if n.config.GRPC.EventService.Enabled {
+			opts = append(opts, grpcserver.WithEventService(
+				n.eventBus, n.blockStore, n.stateStore, n.txIndexer, subscriptions,
+				n.config.GRPC.EventService.BufferSize, n.config.GRPC.EventService.MaxReplayHeights, n.Logger,
+			))
+		}
 		go func() {
 			if err := grpcserver.Serve(listener, opts...); err != nil {
 				n.Logger.Error("Error starting gRPC server", "err", err)
@@ -974,6 +1052,45 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	return listeners, nil
 }
 
+// createRPCRateLimiter returns the rate limiter of the RPC calls, or nil if
+// rate limiting is disabled.
+func (n *Node) createRPCRateLimiter() (*rpcserver.RateLimiter, error) {
+	if !n.config.RPC.IsRateLimitEnabled() {
+		return nil, nil
+	}
+
+	costs, err := n.config.RPC.RateLimitMethodCostsMap()
+	if err != nil {
+		return nil, err
+	}
+	methodLimits, err := n.config.RPC.RateLimitMethodsMap()
+	if err != nil {
+		return nil, err
+	}
+	limiterConfig := rpcserver.RateLimiterConfig{
+		PerIP: rpcserver.RateLimit{
+			Rate:  n.config.RPC.RateLimitPerIP,
+			Burst: n.config.RPC.RateLimitPerIPBurst,
+		},
+		Methods:     make(map[string]rpcserver.RateLimit, len(methodLimits)),
+		MethodCosts: costs,
+	}
+	for method, limit := range methodLimits {
+		limiterConfig.Methods[method] = rpcserver.RateLimit{Rate: limit.Rate, Burst: limit.Burst}
+	}
+
+	metrics := rpcserver.NopMetrics()
+	if n.config.Instrumentation.Prometheus {
+		state, err := n.stateStore.Load()
+		if err != nil {
+			return nil, fmt.Errorf("loading state: %w", err)
+		}
+		metrics = rpcserver.PrometheusMetrics(n.config.Instrumentation.Namespace, "chain_id", state.ChainID)
+	}
+
+	return rpcserver.NewRateLimiter(limiterConfig, metrics), nil
+}
+
 // startPrometheusServer starts a Prometheus HTTP server, listening for metrics
 // collectors on addr.
 func (n *Node) startPrometheusServer() *http.Server {
@@ -1151,6 +1268,10 @@ func makeNodeInfo(
 		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
 	}
 
+	if config.Consensus.CompactBlocks {
+		nodeInfo.Channels = append(nodeInfo.Channels, cs.CompactBlockChannel)
+	}
+
 	lAddr := config.P2P.ExternalAddress
 
 	if lAddr == "" {
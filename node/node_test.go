@@ -225,6 +225,19 @@ func TestPrivValidatorListenAddrNoProtocol(t *testing.T) {
 	require.ErrorAs(t, err, &ErrPrivValidatorSocketClient{})
 }
 
+// missing certificates must result in error.
+func TestNodeSetPrivValGRPCNoCertificates(t *testing.T) {
+	config := test.ResetTestRoot("node_priv_val_grpc_test")
+	defer os.RemoveAll(config.RootDir)
+	config.BaseConfig.PrivValidatorGRPCAddr = testFreeAddr(t)
+	config.BaseConfig.PrivValidatorClientCertificate = "config/client.crt"
+	config.BaseConfig.PrivValidatorClientKey = "config/client.key"
+	config.BaseConfig.PrivValidatorRootCA = "config/ca.crt"
+
+	_, err := DefaultNewNode(config, log.TestingLogger(), CliParams{}, nil)
+	require.ErrorAs(t, err, &ErrPrivValidatorGRPCClient{})
+}
+
 func TestNodeSetPrivValIPC(t *testing.T) {
 	tmpfile := "/tmp/kms." + cmtrand.Str(6) + ".sock"
 	defer os.Remove(tmpfile) // clean up
@@ -273,6 +286,73 @@ func TestNodeSetFilePrivVal(t *testing.T) {
 	}
 }
 
+func TestNodeSetFilePrivValSignLog(t *testing.T) {
+	config := test.ResetTestRoot("node_priv_val_sign_log_test")
+	defer os.RemoveAll(config.RootDir)
+	config.BaseConfig.PrivValidatorSignStateStore = cfg.SignStateStoreLog
+
+	n, err := DefaultNewNode(config, log.TestingLogger(), CliParams{}, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &privval.FilePV{}, n.PrivValidator())
+	assert.FileExists(t, config.PrivValidatorSignLogFile())
+	require.NoError(t, n.PrivValidator().(*privval.FilePV).Close())
+}
+
+func TestNodeMempoolJournal(t *testing.T) {
+	config := test.ResetTestRoot("node_mempool_journal_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Mempool.Journal = true
+	// The journal must outlive the node.
+	config.DBBackend = cfg.DefaultBaseConfig().DBBackend
+
+	n, err := DefaultNewNode(config, log.TestingLogger(), CliParams{}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, n.mempoolJournal)
+
+	// The node is not started, so the transactions stay in the mempool.
+	txs := []types.Tx{types.Tx("a=1"), types.Tx("b=2")}
+	for _, tx := range txs {
+		reqRes, err := n.Mempool().CheckTx(tx, "")
+		require.NoError(t, err)
+		reqRes.Wait()
+		require.True(t, reqRes.Response.GetCheckTx().IsOK())
+	}
+	require.Equal(t, len(txs), n.Mempool().Size())
+	n.OnStop()
+
+	// After a restart, the journaled transactions are checked again and
+	// admitted to the mempool of the new node.
+	n, err = DefaultNewNode(config, log.TestingLogger(), CliParams{}, nil)
+	require.NoError(t, err)
+	defer n.OnStop()
+	require.Equal(t, len(txs), n.Mempool().Size())
+	for _, tx := range txs {
+		assert.True(t, n.Mempool().Contains(tx.Key()))
+	}
+}
+
+func TestNodeABCIRecorder(t *testing.T) {
+	config := test.ResetTestRoot("node_abci_recorder_test")
+	defer os.RemoveAll(config.RootDir)
+	config.ABCIRecord = "data/abci.rec"
+
+	n, err := DefaultNewNode(config, log.TestingLogger(), CliParams{}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, n.abciRecorder)
+	require.NoError(t, n.abciRecorder.Stop())
+
+	// The handshake with the application was recorded.
+	rec, err := proxy.OpenRecording(config.ABCIRecordFile())
+	require.NoError(t, err)
+	defer rec.Close()
+	call, err := rec.Next()
+	require.NoError(t, err)
+	assert.NotNil(t, call.Request.GetInfo())
+	call, err = rec.Next()
+	require.NoError(t, err)
+	assert.NotNil(t, call.Request.GetInitChain())
+}
+
 // testFreeAddr claims a free port so we don't block on listener being ready.
 func testFreeAddr(t *testing.T) string {
 	t.Helper()
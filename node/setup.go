@@ -29,6 +29,7 @@ import (
 	na "github.com/cometbft/cometbft/v2/p2p/netaddr"
 	"github.com/cometbft/cometbft/v2/p2p/pex"
 	"github.com/cometbft/cometbft/v2/p2p/transport"
+	"github.com/cometbft/cometbft/v2/p2p/transport/quic"
 	"github.com/cometbft/cometbft/v2/p2p/transport/tcp"
 	tcpconn "github.com/cometbft/cometbft/v2/p2p/transport/tcp/conn"
 	"github.com/cometbft/cometbft/v2/privval"
@@ -122,11 +123,28 @@ func DefaultNewNode(
 			StateFile: config.PrivValidatorStateFile(),
 		}
 	}
+	if config.PrivValidatorSignStateStore == cfg.SignStateStoreLog {
+		signLog, err := privval.OpenSignLog(config.PrivValidatorSignLogFile())
+		if err != nil {
+			return nil, ErrorOpenSignLog{Err: err, SignLogFile: config.PrivValidatorSignLogFile()}
+		}
+		if err := pv.SetSignStateStore(signLog); err != nil {
+			signLog.Close()
+			return nil, ErrorOpenSignLog{Err: err, SignLogFile: config.PrivValidatorSignLogFile()}
+		}
+	}
+
+	clientCreator := proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir())
+	if config.ABCIReconnect {
+		// Fail to connect rather than retrying, for the attempts to reconnect
+		// to be retried with backoff.
+		clientCreator = proxy.NewRemoteClientCreator(config.ProxyApp, config.ABCI, true)
+	}
 
 	return NewNodeWithCliParams(context.Background(), config,
 		pv,
 		nodeKey,
-		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
+		clientCreator,
 		DefaultGenesisDocProviderFunc(config),
 		cfg.DefaultDBProvider,
 		DefaultMetricsProvider(config.Instrumentation),
@@ -176,8 +194,8 @@ func initDBs(config *cfg.Config, dbProvider cfg.DBProvider) (bsDB dbm.DB, stateD
 	return bsDB, stateDB, nil
 }
 
-func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.Logger, metrics *proxy.Metrics) (proxy.AppConns, error) {
-	proxyApp := proxy.NewAppConns(clientCreator, metrics)
+func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.Logger, metrics *proxy.Metrics, options ...proxy.MultiAppConnOption) (proxy.AppConns, error) {
+	proxyApp := proxy.NewAppConns(clientCreator, metrics, options...)
 	proxyApp.SetLogger(logger.With("module", "proxy"))
 	if err := proxyApp.Start(); err != nil {
 		return nil, fmt.Errorf("error starting proxy app connections: %v", err)
@@ -185,6 +203,23 @@ func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.L
 	return proxyApp, nil
 }
 
+// createAndStartABCIRecorder starts recording the calls to the application, if
+// enabled.
+func createAndStartABCIRecorder(config *cfg.Config, logger log.Logger) (*proxy.Recorder, error) {
+	if config.ABCIRecord == "" {
+		return nil, nil
+	}
+	recorder, err := proxy.NewRecorder(config.ABCIRecordFile())
+	if err != nil {
+		return nil, err
+	}
+	recorder.SetLogger(logger.With("module", "abci-recorder"))
+	if err := recorder.Start(); err != nil {
+		return nil, fmt.Errorf("error starting ABCI recorder: %v", err)
+	}
+	return recorder, nil
+}
+
 func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 	eventBus := types.NewEventBus()
 	eventBus.SetLogger(logger.With("module", "events"))
@@ -246,6 +281,42 @@ func doHandshake(
 	return nil
 }
 
+// reconnectHandshake returns the handshake bringing an ABCI application which
+// went away back in sync with the committed blocks, when reconnecting to it.
+func reconnectHandshake(
+	stateStore sm.Store,
+	blockStore sm.BlockStore,
+	genDoc *types.GenesisDoc,
+	consensusLogger log.Logger,
+) func(context.Context, *abci.InfoResponse, proxy.AppConns) error {
+	return func(ctx context.Context, appInfoResponse *abci.InfoResponse, proxyApp proxy.AppConns) error {
+		state, err := stateStore.Load()
+		if err != nil {
+			return sm.ErrCannotLoadState{Err: err}
+		}
+		// The block saved but not committed yet, if any, is being applied:
+		// leave it to consensus, which retries the calls it was making.
+		store := committedBlockStore{BlockStore: blockStore, height: state.LastBlockHeight}
+		handshaker := cs.NewHandshaker(stateStore, state, store, genDoc)
+		handshaker.SetLogger(consensusLogger)
+		if err := handshaker.Handshake(ctx, appInfoResponse, proxyApp); err != nil {
+			return fmt.Errorf("error during handshake: %v", err)
+		}
+		return nil
+	}
+}
+
+// committedBlockStore is a block store whose height is that of the last
+// committed block.
+type committedBlockStore struct {
+	sm.BlockStore
+	height int64
+}
+
+func (bs committedBlockStore) Height() int64 {
+	return bs.height
+}
+
 func logNodeStartupInfo(state sm.State, pubKey crypto.PubKey, logger, consensusLogger log.Logger) {
 	// Log the version info.
 	logger.Info("Version info",
@@ -283,7 +354,29 @@ func createMempoolAndMempoolReactor(
 	memplMetrics *mempl.Metrics,
 	logger log.Logger,
 	appInfoResponse *abci.InfoResponse,
+	journal *mempl.TxJournal,
+	tracer *mempl.TxTracer,
 ) (mempl.Mempool, mempoolReactor) {
+	logger = logger.With("module", "mempool")
+	options := []mempl.CListMempoolOption{
+		mempl.WithMetrics(memplMetrics),
+		mempl.WithPreCheck(sm.TxPreCheck(state)),
+		mempl.WithPostCheck(sm.TxPostCheck(state)),
+	}
+	if journal != nil {
+		options = append(options, mempl.WithJournal(journal))
+	}
+	if tracer != nil {
+		options = append(options, mempl.WithTxTracer(tracer))
+	}
+	if config.Mempool.ExperimentalPublishEventPendingTx {
+		options = append(options, mempl.WithNewTxCallback(func(tx types.Tx) {
+			_ = eventBus.PublishEventPendingTx(types.EventDataPendingTx{
+				Tx: tx,
+			})
+		}))
+	}
+
 	switch config.Mempool.Type {
 	// allow empty string for backward compatibility
 	case cfg.MempoolTypeFlood, "":
@@ -292,19 +385,6 @@ func createMempoolAndMempoolReactor(
 			panic(fmt.Sprintf("could not get lanes info from app: %s", err))
 		}
 
-		logger = logger.With("module", "mempool")
-		options := []mempl.CListMempoolOption{
-			mempl.WithMetrics(memplMetrics),
-			mempl.WithPreCheck(sm.TxPreCheck(state)),
-			mempl.WithPostCheck(sm.TxPostCheck(state)),
-		}
-		if config.Mempool.ExperimentalPublishEventPendingTx {
-			options = append(options, mempl.WithNewTxCallback(func(tx types.Tx) {
-				_ = eventBus.PublishEventPendingTx(types.EventDataPendingTx{
-					Tx: tx,
-				})
-			}))
-		}
 		mp := mempl.NewCListMempool(
 			config.Mempool,
 			proxyApp.Mempool(),
@@ -322,6 +402,35 @@ func createMempoolAndMempoolReactor(
 			mp.EnableTxsAvailable()
 		}
 		reactor.SetLogger(logger)
+		if err := mp.ReplayJournal(); err != nil {
+			logger.Error("Could not replay the mempool journal", "err", err)
+		}
+
+		return mp, reactor
+	case cfg.MempoolTypePriority:
+		if len(appInfoResponse.LanePriorities) > 0 {
+			logger.Info("The priority mempool does not support lanes; lanes defined by the app will be ignored")
+		}
+
+		mp := mempl.NewPriorityMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			options...,
+		)
+		mp.SetLogger(logger)
+		reactor := mempl.NewReactor(
+			config.Mempool,
+			mp.CListMempool,
+			waitSync,
+		)
+		if config.Consensus.WaitForTxs() {
+			mp.EnableTxsAvailable()
+		}
+		reactor.SetLogger(logger)
+		if err := mp.ReplayJournal(); err != nil {
+			logger.Error("Could not replay the mempool journal", "err", err)
+		}
 
 		return mp, reactor
 	case cfg.MempoolTypeNop:
@@ -333,6 +442,46 @@ func createMempoolAndMempoolReactor(
 	}
 }
 
+// createMempoolTxTracer returns the tracer of the lifecycle of the transactions
+// in the mempool, or nil if tracing is disabled.
+func createMempoolTxTracer(config *cfg.Config, eventBus *types.EventBus) *mempl.TxTracer {
+	if config.Mempool.TxTraceSize == 0 {
+		return nil
+	}
+	var onEvent func(types.EventDataMempoolTx)
+	if config.Mempool.ExperimentalPublishEventMempoolTx {
+		onEvent = func(data types.EventDataMempoolTx) {
+			_ = eventBus.PublishEventMempoolTx(data)
+		}
+	}
+	return mempl.NewTxTracer(config.Mempool.TxTraceSize, onEvent)
+}
+
+// createMempoolJournal opens the journal of the mempool, if enabled. After
+// state sync, the journaled transactions cannot be checked against the state
+// of the application, so they are dropped.
+func createMempoolJournal(config *cfg.Config, dbProvider cfg.DBProvider, stateSync bool) (*mempl.TxJournal, error) {
+	if !config.Mempool.Journal {
+		return nil, nil
+	}
+	mempoolDB, err := dbProvider(&cfg.DBContext{ID: "mempool", Config: config})
+	if err != nil {
+		return nil, err
+	}
+	journal, err := mempl.NewTxJournal(mempoolDB)
+	if err != nil {
+		mempoolDB.Close()
+		return nil, err
+	}
+	if stateSync {
+		if err := journal.Clear(); err != nil {
+			journal.Close()
+			return nil, err
+		}
+	}
+	return journal, nil
+}
+
 func createEvidenceReactor(config *cfg.Config, dbProvider cfg.DBProvider,
 	stateStore sm.Store, blockStore *store.BlockStore, logger log.Logger,
 ) (*evidence.Reactor, *evidence.Pool, error) {
@@ -362,7 +511,8 @@ func createBlocksyncReactor(config *cfg.Config,
 ) (bcReactor p2p.Reactor, err error) {
 	switch config.BlockSync.Version {
 	case "v0":
-		bcReactor = blocksync.NewReactor(state.Copy(), blockExec, blockStore, blockSync, localAddr, metrics, offlineStateSyncHeight)
+		bcReactor = blocksync.NewReactor(state.Copy(), blockExec, blockStore, blockSync, localAddr, metrics, offlineStateSyncHeight,
+			blocksync.WithVerifyAhead(config.BlockSync.VerifyAhead))
 	case "v1", "v2":
 		return nil, fmt.Errorf("block sync version %s has been deprecated. Please use v0", config.BlockSync.Version)
 	default:
@@ -400,7 +550,8 @@ func createConsensusReactor(config *cfg.Config,
 	if privValidator != nil {
 		consensusState.SetPrivValidator(privValidator)
 	}
-	consensusReactor := cs.NewReactor(consensusState, waitSync, cs.ReactorMetrics(csMetrics))
+	consensusReactor := cs.NewReactor(consensusState, waitSync,
+		cs.ReactorMetrics(csMetrics), cs.ReactorMempool(mempool))
 	consensusReactor.SetLogger(consensusLogger)
 	// services which will be publishing and/or subscribing for messages (events)
 	// consensusReactor will set it on consensusState and blockExecutor
@@ -408,51 +559,45 @@ func createConsensusReactor(config *cfg.Config,
 	return consensusReactor, consensusState
 }
 
+// p2pTransport is a transport.Transport that the node listens on and closes.
+type p2pTransport interface {
+	transport.Transport
+	SetLogger(l log.Logger)
+	Listen(addr na.NetAddr) error
+	Close() error
+}
+
 func createTransport(
 	config *cfg.Config,
 	nodeKey *p2p.NodeKey,
 	proxyApp proxy.AppConns,
 ) (
-	*tcp.MultiplexTransport,
+	p2pTransport,
 	[]p2p.PeerFilterFunc,
+	error,
 ) {
-	tcpConfig := tcpconn.DefaultMConnConfig()
-	tcpConfig.FlushThrottle = config.P2P.FlushThrottleTimeout
-	tcpConfig.SendRate = config.P2P.SendRate
-	tcpConfig.RecvRate = config.P2P.RecvRate
-	tcpConfig.MaxPacketMsgPayloadSize = config.P2P.MaxPacketMsgPayloadSize
-	tcpConfig.TestFuzz = config.P2P.TestFuzz
-	tcpConfig.TestFuzzConfig = config.P2P.TestFuzzConfig
 	var (
-		transport   = tcp.NewMultiplexTransport(*nodeKey, tcpConfig)
-		connFilters = []tcp.ConnFilterFunc{}
+		addrFilter  func(addr net.Addr) error
 		peerFilters = []p2p.PeerFilterFunc{}
 	)
 
-	if !config.P2P.AllowDuplicateIP {
-		connFilters = append(connFilters, tcp.ConnDuplicateIPFilter())
-	}
-
 	// Filter peers by addr or pubkey with an ABCI query.
 	// If the query return code is OK, add peer.
 	if config.FilterPeers {
-		connFilters = append(
-			connFilters,
-			// ABCI query for address filtering.
-			func(_ tcp.ConnSet, c net.Conn, _ []net.IP) error {
-				res, err := proxyApp.Query().Query(context.TODO(), &abci.QueryRequest{
-					Path: "/p2p/filter/addr/" + c.RemoteAddr().String(),
-				})
-				if err != nil {
-					return err
-				}
-				if res.IsErr() {
-					return fmt.Errorf("error querying abci app: %v", res)
-				}
-
-				return nil
-			},
-		)
+		// ABCI query for address filtering.
+		addrFilter = func(addr net.Addr) error {
+			res, err := proxyApp.Query().Query(context.TODO(), &abci.QueryRequest{
+				Path: "/p2p/filter/addr/" + addr.String(),
+			})
+			if err != nil {
+				return err
+			}
+			if res.IsErr() {
+				return fmt.Errorf("error querying abci app: %v", res)
+			}
+
+			return nil
+		}
 
 		peerFilters = append(
 			peerFilters,
@@ -473,13 +618,57 @@ func createTransport(
 		)
 	}
 
-	tcp.MultiplexTransportConnFilters(connFilters...)(transport)
-
 	// Limit the number of incoming connections.
 	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
+
+	if config.P2P.Transport == cfg.P2PTransportQUIC {
+		connFilters := []quic.ConnFilterFunc{}
+		if !config.P2P.AllowDuplicateIP {
+			connFilters = append(connFilters, quic.ConnDuplicateIPFilter())
+		}
+		if addrFilter != nil {
+			connFilters = append(connFilters, func(_ []net.Addr, remote net.Addr) error {
+				return addrFilter(remote)
+			})
+		}
+
+		transport, err := quic.NewTransport(
+			*nodeKey,
+			quic.TransportConnFilters(connFilters...),
+			quic.TransportMaxIncomingConnections(max),
+			quic.TransportRateLimits(config.P2P.SendRate, config.P2P.RecvRate, config.P2P.MaxPacketMsgPayloadSize),
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating QUIC transport: %w", err)
+		}
+		return transport, peerFilters, nil
+	}
+
+	tcpConfig := tcpconn.DefaultMConnConfig()
+	tcpConfig.FlushThrottle = config.P2P.FlushThrottleTimeout
+	tcpConfig.SendRate = config.P2P.SendRate
+	tcpConfig.RecvRate = config.P2P.RecvRate
+	tcpConfig.MaxPacketMsgPayloadSize = config.P2P.MaxPacketMsgPayloadSize
+	tcpConfig.TestFuzz = config.P2P.TestFuzz
+	tcpConfig.TestFuzzConfig = config.P2P.TestFuzzConfig
+	var (
+		transport   = tcp.NewMultiplexTransport(*nodeKey, tcpConfig)
+		connFilters = []tcp.ConnFilterFunc{}
+	)
+
+	if !config.P2P.AllowDuplicateIP {
+		connFilters = append(connFilters, tcp.ConnDuplicateIPFilter())
+	}
+	if addrFilter != nil {
+		connFilters = append(connFilters, func(_ tcp.ConnSet, c net.Conn, _ []net.IP) error {
+			return addrFilter(c.RemoteAddr())
+		})
+	}
+
+	tcp.MultiplexTransportConnFilters(connFilters...)(transport)
 	tcp.MultiplexTransportMaxIncomingConnections(max)(transport)
 
-	return transport, peerFilters
+	return transport, peerFilters, nil
 }
 
 func createSwitch(config *cfg.Config,
@@ -738,6 +927,32 @@ func createAndStartPrivValidatorSocketClient(
 	return pvscWithRetries, nil
 }
 
+func createPrivValidatorGRPCClient(config *cfg.Config, chainID string) (types.PrivValidator, error) {
+	tlsConfig, err := privval.NewGRPCClientTLSConfig(
+		config.PrivValidatorClientCertificateFile(),
+		config.PrivValidatorClientKeyFile(),
+		config.PrivValidatorRootCAFile(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS configuration: %w", err)
+	}
+
+	const timeout = 5 * time.Second
+	pvsc, err := privval.NewGRPCSignerClient(config.PrivValidatorGRPCAddr, chainID, tlsConfig, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start private validator: %w", err)
+	}
+
+	// try to get a pubkey from private validate first time
+	_, err = pvsc.GetPubKey()
+	if err != nil {
+		_ = pvsc.Close()
+		return nil, fmt.Errorf("can't get pubkey: %w", err)
+	}
+
+	return pvsc, nil
+}
+
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
 // slice of the string s with all leading and trailing Unicode code points
 // contained in cutset removed. If sep is empty, SplitAndTrim splits after each
@@ -42,9 +42,13 @@ func TestNew(t *testing.T) {
 	addr := New("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", tcpAddr)
 	assert.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", addr.String())
 
+	udpAddr := &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000}
+	addr = New("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", udpAddr)
+	assert.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8000", addr.String())
+
 	assert.NotPanics(t, func() {
-		New("", &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000})
-	}, "Calling New with UDPAddr should not panic in testing")
+		New("", &net.IPAddr{IP: net.ParseIP("127.0.0.1")})
+	}, "Calling New with IPAddr should not panic in testing")
 }
 
 func TestNewFromString(t *testing.T) {
@@ -17,7 +17,6 @@ import (
 	"github.com/cometbft/cometbft/v2/p2p/internal/nodekey"
 	na "github.com/cometbft/cometbft/v2/p2p/netaddr"
 	"github.com/cometbft/cometbft/v2/p2p/transport"
-	tcpconn "github.com/cometbft/cometbft/v2/p2p/transport/tcp/conn"
 	"github.com/cometbft/cometbft/v2/types"
 )
 
@@ -71,6 +70,18 @@ type Peer interface {
 
 // ----------------------------------------------------------
 
+// msgReceiver is implemented by connections that deliver received messages
+// via a callback (e.g., MConnection).
+type msgReceiver interface {
+	OnReceive(fn func(streamID byte, msgBytes []byte))
+}
+
+// startable is implemented by connections that must be started after all the
+// streams have been opened (e.g., MConnection).
+type startable interface {
+	Start() error
+}
+
 // peerConn contains the raw connection and its config.
 type peerConn struct {
 	outbound       bool
@@ -189,8 +200,8 @@ func newPeer(
 		option(p)
 	}
 
-	if mconn, ok := p.peerConn.Conn.(*tcpconn.MConnection); ok {
-		mconn.OnReceive(p.onReceive)
+	if r, ok := p.peerConn.Conn.(msgReceiver); ok {
+		r.OnReceive(p.onReceive)
 	}
 
 	return p
@@ -279,11 +290,11 @@ func (p *peer) OnStart() error {
 		p.streams[streamID] = stream
 	}
 
-	// Start the MConnection if it's an MConnection.
-	// NOTE: we do not start the MConnection until all the streams are registered.
-	if mconn, ok := p.peerConn.Conn.(*tcpconn.MConnection); ok {
-		if err := mconn.Start(); err != nil {
-			return fmt.Errorf("starting MConnection: %w", err)
+	// Start the connection if it needs to be started (e.g., MConnection).
+	// NOTE: we do not start the connection until all the streams are registered.
+	if c, ok := p.peerConn.Conn.(startable); ok {
+		if err := c.Start(); err != nil {
+			return fmt.Errorf("starting connection: %w", err)
 		}
 	}
 
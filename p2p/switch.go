@@ -14,6 +14,7 @@ import (
 	"github.com/cometbft/cometbft/v2/p2p/internal/nodekey"
 	na "github.com/cometbft/cometbft/v2/p2p/netaddr"
 	"github.com/cometbft/cometbft/v2/p2p/transport"
+	"github.com/cometbft/cometbft/v2/p2p/transport/quic"
 	"github.com/cometbft/cometbft/v2/p2p/transport/tcp"
 )
 
@@ -611,7 +612,7 @@ func (sw *Switch) acceptRoutine() {
 		conn, addr, err := sw.transport.Accept()
 		if err != nil {
 			switch err := err.(type) {
-			case tcp.ErrRejected:
+			case tcp.ErrRejected, quic.ErrRejected:
 				sw.Logger.Info(
 					"Inbound Peer rejected",
 					"peer", addr,
@@ -620,7 +621,7 @@ func (sw *Switch) acceptRoutine() {
 				)
 
 				continue
-			case tcp.ErrFilterTimeout:
+			case tcp.ErrFilterTimeout, quic.ErrFilterTimeout:
 				sw.Logger.Error(
 					"Peer filter timed out",
 					"peer", addr,
@@ -628,7 +629,7 @@ func (sw *Switch) acceptRoutine() {
 				)
 
 				continue
-			case tcp.ErrTransportClosed:
+			case tcp.ErrTransportClosed, quic.ErrTransportClosed:
 				sw.Logger.Error("Stopped accept routine, as transport is closed")
 			default:
 				sw.Logger.Error(
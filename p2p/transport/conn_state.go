@@ -9,12 +9,8 @@ type ConnState struct {
 	// StreamStates describes the state of streams.
 	StreamStates map[byte]StreamState `json:"stream_states"`
 	// SendRateLimiterDelay is the delay imposed by the send rate limiter.
-	//
-	// Only applies to TCP.
 	SendRateLimiterDelay time.Duration `json:"send_rate_limiter_delay"`
 	// RecvRateLimiterDelay is the delay imposed by the receive rate limiter.
-	//
-	// Only applies to TCP.
 	RecvRateLimiterDelay time.Duration `json:"recv_rate_limiter_delay"`
 }
 
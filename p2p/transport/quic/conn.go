@@ -0,0 +1,509 @@
+package quic
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	quicgo "github.com/quic-go/quic-go"
+
+	flow "github.com/cometbft/cometbft/v2/internal/flowrate"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+	"github.com/cometbft/cometbft/v2/p2p/transport"
+	tcpconn "github.com/cometbft/cometbft/v2/p2p/transport/tcp/conn"
+)
+
+const (
+	// Application error codes sent when closing a connection or a stream.
+	codeNoError       quicgo.ApplicationErrorCode = 0
+	codeProtocolError quicgo.StreamErrorCode      = 1
+
+	// defaultFlushTimeout is the maximum time FlushAndClose waits for the
+	// remote to receive the pending messages.
+	defaultFlushTimeout = 2 * time.Second
+)
+
+// connConfig holds the limits applied to the messages of a connection (see
+// TransportRateLimits).
+type connConfig struct {
+	sendRate     int64
+	recvRate     int64
+	maxChunkSize int
+}
+
+// Conn is a QUIC connection to a peer.
+//
+// Each stream opened with OpenStream is mapped to an outgoing unidirectional
+// QUIC stream, so streams are flow-controlled independently and a slow or
+// lossy stream (e.g., mempool) does not delay the others (e.g., consensus).
+// The first byte sent on a QUIC stream is the ID of the stream; after that,
+// every message is prefixed by its length (uvarint).
+//
+// The bidirectional stream used for the handshake is kept open afterwards to
+// signal a graceful close (see FlushAndClose).
+//
+// The send and receive rates are limited for the connection as a whole, as
+// with MConnection, so the streams share the bandwidth.
+type Conn struct {
+	qc          quicgo.Connection
+	handshake   quicgo.Stream
+	connectedAt time.Time
+	logger      log.Logger
+
+	config      connConfig
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+
+	mtx       cmtsync.Mutex
+	streams   map[byte]*stream
+	onReceive func(streamID byte, msgBytes []byte)
+	started   bool
+
+	// Tracks the receive routines, so that a graceful close initiated by the
+	// remote waits for all the messages to be delivered.
+	recvWg sync.WaitGroup
+
+	errorCh   chan error
+	quitCh    chan struct{}
+	closeOnce sync.Once
+}
+
+var _ transport.Conn = (*Conn)(nil)
+
+func newConn(qc quicgo.Connection, handshake quicgo.Stream, config connConfig) *Conn {
+	return &Conn{
+		qc:          qc,
+		handshake:   handshake,
+		connectedAt: time.Now(),
+		logger:      log.NewNopLogger(),
+		config:      config,
+		sendMonitor: flow.New(0, 0),
+		recvMonitor: flow.New(0, 0),
+		streams:     make(map[byte]*stream),
+		onReceive:   func(byte, []byte) {},
+		errorCh:     make(chan error, 1),
+		quitCh:      make(chan struct{}),
+	}
+}
+
+// SetLogger sets the logger for the connection.
+func (c *Conn) SetLogger(l log.Logger) {
+	c.logger = l
+}
+
+// OnReceive sets the callback invoked for every message received. Messages
+// of the same stream are delivered sequentially; messages of different
+// streams may be delivered concurrently. Must be called before Start.
+func (c *Conn) OnReceive(fn func(streamID byte, msgBytes []byte)) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.onReceive = fn
+}
+
+// Start starts receiving messages. All the streams must be opened before
+// calling Start, otherwise messages received on them are treated as errors.
+func (c *Conn) Start() error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.started {
+		return errors.New("connection already started")
+	}
+	c.started = true
+
+	go c.acceptStreamsRoutine()
+	go c.controlRoutine()
+
+	return nil
+}
+
+// OpenStream implements transport.Conn. If desc is a tcpconn.StreamDescriptor,
+// its SendQueueCapacity and RecvMessageCapacity are honored.
+func (c *Conn) OpenStream(streamID byte, desc any) (transport.Stream, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.started {
+		return nil, errors.New("connection already started; streams must be opened in advance")
+	}
+	if _, ok := c.streams[streamID]; ok {
+		return nil, fmt.Errorf("stream %X already exists", streamID)
+	}
+
+	d := tcpconn.StreamDescriptor{ID: streamID}
+	if desc, ok := desc.(tcpconn.StreamDescriptor); ok {
+		d = desc
+	}
+	d = d.FillDefaults()
+
+	ss, err := c.qc.OpenUniStream()
+	if err != nil {
+		return nil, fmt.Errorf("opening QUIC stream: %w", err)
+	}
+
+	s := &stream{
+		conn:                c,
+		id:                  streamID,
+		qs:                  ss,
+		sendQueue:           make(chan []byte, d.SendQueueCapacity),
+		flushCh:             make(chan struct{}),
+		doneCh:              make(chan struct{}),
+		recvMessageCapacity: d.RecvMessageCapacity,
+	}
+	c.streams[streamID] = s
+	go s.sendRoutine()
+
+	return s, nil
+}
+
+// LocalAddr implements transport.Conn.
+func (c *Conn) LocalAddr() net.Addr {
+	return c.qc.LocalAddr()
+}
+
+// RemoteAddr implements transport.Conn.
+func (c *Conn) RemoteAddr() net.Addr {
+	return c.qc.RemoteAddr()
+}
+
+// Close implements transport.Conn. Pending messages are discarded.
+func (c *Conn) Close(reason string) error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.quitCh)
+		err = c.qc.CloseWithError(codeNoError, reason)
+	})
+	return err
+}
+
+// FlushAndClose implements transport.Conn. It waits for the queued messages to
+// be written, signals the remote that no more messages will be sent and waits
+// for the remote to close the connection once it has received all of them,
+// up to defaultFlushTimeout.
+func (c *Conn) FlushAndClose(reason string) error {
+	c.mtx.Lock()
+	streams := make([]*stream, 0, len(c.streams))
+	for _, s := range c.streams {
+		streams = append(streams, s)
+	}
+	c.mtx.Unlock()
+
+	timeout := time.NewTimer(defaultFlushTimeout)
+	defer timeout.Stop()
+
+	for _, s := range streams {
+		s.flush()
+	}
+	for _, s := range streams {
+		select {
+		case <-s.doneCh:
+		case <-timeout.C:
+			return c.Close(reason)
+		}
+	}
+
+	if err := c.handshake.Close(); err == nil {
+		select {
+		case <-c.qc.Context().Done():
+		case <-timeout.C:
+		}
+	}
+
+	return c.Close(reason)
+}
+
+// ConnState implements transport.Conn.
+func (c *Conn) ConnState() transport.ConnState {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	state := transport.ConnState{
+		ConnectedFor:         time.Since(c.connectedAt),
+		StreamStates:         make(map[byte]transport.StreamState, len(c.streams)),
+		SendRateLimiterDelay: c.sendMonitor.Status().SleepTime,
+		RecvRateLimiterDelay: c.recvMonitor.Status().SleepTime,
+	}
+	for id, s := range c.streams {
+		state.StreamStates[id] = transport.StreamState{
+			SendQueueSize:     int(s.sendQueueSize.Load()),
+			SendQueueCapacity: cap(s.sendQueue),
+		}
+	}
+	return state
+}
+
+// ErrorCh implements transport.Conn.
+func (c *Conn) ErrorCh() <-chan error {
+	return c.errorCh
+}
+
+// HandshakeStream implements transport.Conn.
+func (c *Conn) HandshakeStream() transport.HandshakeStream {
+	return c.handshake
+}
+
+func (c *Conn) String() string {
+	return fmt.Sprintf("QUIC{%v}", c.qc.RemoteAddr())
+}
+
+// stopForError reports err on ErrorCh, unless the connection was closed
+// locally, and closes the connection.
+func (c *Conn) stopForError(err error) {
+	select {
+	case <-c.quitCh:
+		return
+	default:
+	}
+
+	select {
+	case c.errorCh <- err:
+	default:
+	}
+	_ = c.Close(err.Error())
+}
+
+// acceptStreamsRoutine accepts the streams opened by the remote and starts a
+// receive routine for each of them.
+func (c *Conn) acceptStreamsRoutine() {
+	ctx := c.qc.Context()
+	for {
+		rs, err := c.qc.AcceptUniStream(ctx)
+		if err != nil {
+			c.stopForError(err)
+			return
+		}
+		c.recvWg.Add(1)
+		go func() {
+			defer c.recvWg.Done()
+			c.recvRoutine(rs)
+		}()
+	}
+}
+
+// recvRoutine reads messages from a stream opened by the remote and delivers
+// them to the onReceive callback.
+func (c *Conn) recvRoutine(rs quicgo.ReceiveStream) {
+	r := bufio.NewReader(&limitedReader{rs: rs, conn: c})
+
+	streamID, err := r.ReadByte()
+	if err != nil {
+		c.stopForError(fmt.Errorf("reading stream ID: %w", err))
+		return
+	}
+	c.mtx.Lock()
+	s, ok := c.streams[streamID]
+	onReceive := c.onReceive
+	c.mtx.Unlock()
+	if !ok {
+		rs.CancelRead(codeProtocolError)
+		c.stopForError(ErrUnknownStream{StreamID: streamID})
+		return
+	}
+
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				// The remote closed the stream gracefully.
+				return
+			}
+			c.stopForError(fmt.Errorf("reading from stream %X: %w", streamID, err))
+			return
+		}
+		if size > uint64(s.recvMessageCapacity) {
+			rs.CancelRead(codeProtocolError)
+			c.stopForError(ErrMessageTooBig{StreamID: streamID, Received: size, Max: s.recvMessageCapacity})
+			return
+		}
+
+		msg := make([]byte, size)
+		if _, err := io.ReadFull(r, msg); err != nil {
+			c.stopForError(fmt.Errorf("reading from stream %X: %w", streamID, err))
+			return
+		}
+		onReceive(streamID, msg)
+	}
+}
+
+// controlRoutine waits for the remote to close its side of the handshake
+// stream, which it does in FlushAndClose after all its streams have been
+// closed. It then closes the connection once all the messages received have
+// been delivered.
+func (c *Conn) controlRoutine() {
+	_, err := io.Copy(io.Discard, c.handshake)
+	if err != nil {
+		// The connection was closed; the error is reported by
+		// acceptStreamsRoutine.
+		return
+	}
+
+	done := make(chan struct{})
+	go func() {
+		c.recvWg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(defaultFlushTimeout):
+	}
+	c.stopForError(errors.New("connection closed by remote"))
+}
+
+// limitedReader reads from a stream in chunks of at most maxChunkSize bytes,
+// blocking in accordance with the receive rate of the connection.
+type limitedReader struct {
+	rs   quicgo.ReceiveStream
+	conn *Conn
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if size := r.conn.config.maxChunkSize; size > 0 && len(p) > size {
+		p = p[:size]
+	}
+	r.conn.recvMonitor.Limit(len(p), r.conn.config.recvRate, true)
+	n, err := r.rs.Read(p)
+	r.conn.recvMonitor.Update(n)
+	return n, err
+}
+
+// stream is a Stream backed by a unidirectional QUIC stream. Messages are
+// queued and written by sendRoutine.
+type stream struct {
+	conn *Conn
+	id   byte
+	qs   quicgo.SendStream
+
+	sendQueue     chan []byte
+	sendQueueSize atomic.Int32
+	flushOnce     sync.Once
+	flushCh       chan struct{} // closed to write the queued messages and close the stream
+	doneCh        chan struct{} // closed when sendRoutine returns
+
+	recvMessageCapacity int
+}
+
+var _ transport.Stream = (*stream)(nil)
+
+// Write queues b to be sent, blocking until there is room in the send queue.
+// thread-safe.
+func (s *stream) Write(b []byte) (n int, err error) {
+	select {
+	case s.sendQueue <- b:
+		s.sendQueueSize.Add(1)
+		return len(b), nil
+	case <-s.doneCh:
+		return 0, net.ErrClosed
+	case <-s.conn.quitCh:
+		return 0, net.ErrClosed
+	}
+}
+
+// TryWrite queues b to be sent. If the send queue is full, it returns
+// ErrWriteQueueFull.
+// thread-safe.
+func (s *stream) TryWrite(b []byte) (n int, err error) {
+	select {
+	case s.sendQueue <- b:
+		s.sendQueueSize.Add(1)
+		return len(b), nil
+	case <-s.doneCh:
+		return 0, net.ErrClosed
+	case <-s.conn.quitCh:
+		return 0, net.ErrClosed
+	default:
+		return 0, ErrWriteQueueFull{}
+	}
+}
+
+// Close writes the queued messages and closes the stream.
+func (s *stream) Close() error {
+	s.flush()
+	return nil
+}
+
+func (s *stream) flush() {
+	s.flushOnce.Do(func() { close(s.flushCh) })
+}
+
+func (s *stream) sendRoutine() {
+	defer close(s.doneCh)
+
+	w := bufio.NewWriter(s.qs)
+	if err := s.writeByte(w, s.id); err != nil {
+		s.conn.stopForError(err)
+		return
+	}
+
+	lenBuf := make([]byte, binary.MaxVarintLen64)
+	for {
+		var msg []byte
+		select {
+		case msg = <-s.sendQueue:
+		case <-s.flushCh:
+			// Write what is left in the queue, then close the stream.
+			for {
+				select {
+				case msg := <-s.sendQueue:
+					s.sendQueueSize.Add(-1)
+					if err := s.writeMsg(w, lenBuf, msg); err != nil {
+						return
+					}
+				default:
+					_ = s.qs.Close()
+					return
+				}
+			}
+		case <-s.conn.quitCh:
+			return
+		}
+
+		s.sendQueueSize.Add(-1)
+		if err := s.writeMsg(w, lenBuf, msg); err != nil {
+			s.conn.stopForError(fmt.Errorf("writing to stream %X: %w", s.id, err))
+			return
+		}
+	}
+}
+
+func (*stream) writeByte(w *bufio.Writer, b byte) error {
+	if err := w.WriteByte(b); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// writeMsg writes msg prefixed by its length, in chunks of at most
+// maxChunkSize bytes, blocking in accordance with the send rate of the
+// connection.
+func (s *stream) writeMsg(w *bufio.Writer, lenBuf []byte, msg []byte) error {
+	n := binary.PutUvarint(lenBuf, uint64(len(msg)))
+	if err := s.writeChunk(w, lenBuf[:n]); err != nil {
+		return err
+	}
+	for len(msg) > 0 {
+		chunk := msg
+		if size := s.conn.config.maxChunkSize; size > 0 && len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		if err := s.writeChunk(w, chunk); err != nil {
+			return err
+		}
+		msg = msg[len(chunk):]
+	}
+	return nil
+}
+
+func (s *stream) writeChunk(w *bufio.Writer, b []byte) error {
+	s.conn.sendMonitor.Limit(len(b), s.conn.config.sendRate, true)
+	if _, err := w.Write(b); err != nil {
+		return err
+	}
+	s.conn.sendMonitor.Update(len(b))
+	return w.Flush()
+}
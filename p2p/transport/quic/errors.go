@@ -0,0 +1,94 @@
+package quic
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/cometbft/cometbft/v2/p2p/internal/nodekey"
+)
+
+// ErrTransportClosed is raised when the Transport has been closed.
+type ErrTransportClosed struct{}
+
+func (ErrTransportClosed) Error() string {
+	return "transport has been closed"
+}
+
+// ErrFilterTimeout indicates that a filter operation timed out.
+type ErrFilterTimeout struct{}
+
+func (ErrFilterTimeout) Error() string {
+	return "filter timed out"
+}
+
+// ErrRejected indicates that a connection was rejected, carrying additional
+// information as to the reason.
+type ErrRejected struct {
+	addr          net.Addr
+	err           error
+	id            nodekey.ID
+	isAuthFailure bool
+	isFiltered    bool
+}
+
+func (e ErrRejected) Error() string {
+	if e.isAuthFailure {
+		return fmt.Sprintf("auth failure: %s", e.err)
+	}
+
+	if e.isFiltered {
+		if e.addr != nil {
+			return fmt.Sprintf("filtered CONN<%s>: %s", e.addr, e.err)
+		}
+
+		if e.id != "" {
+			return fmt.Sprintf("filtered ID<%v>: %s", e.id, e.err)
+		}
+	}
+
+	return e.err.Error()
+}
+
+func (e ErrRejected) Unwrap() error { return e.err }
+
+// IsAuthFailure when the remote could not be authenticated.
+func (e ErrRejected) IsAuthFailure() bool { return e.isAuthFailure }
+
+// IsFiltered when the connection was refused by a filter.
+func (e ErrRejected) IsFiltered() bool { return e.isFiltered }
+
+// ErrWriteQueueFull is returned by TryWrite when the send queue of a stream is
+// full.
+type ErrWriteQueueFull struct{}
+
+func (ErrWriteQueueFull) Error() string {
+	return "write queue is full"
+}
+
+// Full implements transport.WriteError.
+func (ErrWriteQueueFull) Full() bool {
+	return true
+}
+
+// ErrMessageTooBig is returned when a peer sends a message bigger than the
+// receive capacity of the stream.
+type ErrMessageTooBig struct {
+	StreamID byte
+	Received uint64
+	Max      int
+}
+
+func (e ErrMessageTooBig) Error() string {
+	return fmt.Sprintf("message on stream %X exceeds available capacity (max: %d, got: %d)",
+		e.StreamID, e.Max, e.Received)
+}
+
+// ErrUnknownStream is returned when a peer opens a stream that was not
+// registered locally.
+type ErrUnknownStream struct {
+	StreamID byte
+}
+
+func (e ErrUnknownStream) Error() string {
+	return fmt.Sprintf("unknown stream %X", e.StreamID)
+}
@@ -0,0 +1,332 @@
+package quic
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/v2/crypto/ed25519"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	"github.com/cometbft/cometbft/v2/p2p/internal/nodekey"
+	na "github.com/cometbft/cometbft/v2/p2p/netaddr"
+	"github.com/cometbft/cometbft/v2/p2p/transport"
+	tcpconn "github.com/cometbft/cometbft/v2/p2p/transport/tcp/conn"
+)
+
+type received struct {
+	streamID byte
+	msg      []byte
+}
+
+func newTestTransport(t *testing.T, options ...TransportOption) (*Transport, nodekey.ID) {
+	t.Helper()
+
+	nodeKey := nodekey.NodeKey{PrivKey: ed25519.GenPrivKey()}
+	tr, err := NewTransport(nodeKey, options...)
+	require.NoError(t, err)
+	tr.SetLogger(log.TestingLogger())
+
+	addr, err := na.NewFromString(na.IDAddrString(nodeKey.ID(), "127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, tr.Listen(*addr))
+	t.Cleanup(func() { _ = tr.Close() })
+
+	return tr, nodeKey.ID()
+}
+
+// connect dials from a to b and returns both ends of the connection, after
+// exchanging a message over the handshake stream (the acceptor only sees the
+// connection once the dialer has written to it).
+func connect(t *testing.T, a, b *Transport) (dialed, accepted *Conn, acceptedAddr *na.NetAddr) {
+	t.Helper()
+
+	errc := make(chan error, 1)
+	go func() {
+		c, err := a.Dial(b.NetAddr())
+		if err != nil {
+			errc <- err
+			return
+		}
+		dialed = c.(*Conn)
+		_, err = dialed.HandshakeStream().Write([]byte("ping"))
+		errc <- err
+	}()
+
+	c, addr, err := b.Accept()
+	require.NoError(t, err)
+	require.NoError(t, <-errc)
+	accepted = c.(*Conn)
+
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(accepted.HandshakeStream(), buf)
+	require.NoError(t, err)
+	require.Equal(t, []byte("ping"), buf)
+
+	t.Cleanup(func() {
+		_ = dialed.Close("done")
+		_ = accepted.Close("done")
+	})
+	return dialed, accepted, addr
+}
+
+// openStreams opens the given streams on c and starts it. Received messages
+// are sent to the returned channel.
+func openStreams(t *testing.T, c *Conn, descs ...tcpconn.StreamDescriptor) (map[byte]transport.Stream, <-chan received) {
+	t.Helper()
+
+	recvCh := make(chan received, 100)
+	c.OnReceive(func(streamID byte, msg []byte) {
+		recvCh <- received{streamID, msg}
+	})
+
+	streams := make(map[byte]transport.Stream)
+	for _, d := range descs {
+		s, err := c.OpenStream(d.ID, d)
+		require.NoError(t, err)
+		streams[d.ID] = s
+	}
+	require.NoError(t, c.Start())
+
+	return streams, recvCh
+}
+
+func TestTransportDialAccept(t *testing.T) {
+	a, aID := newTestTransport(t)
+	b, _ := newTestTransport(t)
+
+	dialed, accepted, addr := connect(t, a, b)
+
+	// The acceptor learns the ID of the dialer from its certificate.
+	assert.Equal(t, aID, addr.ID)
+	assert.Equal(t, "127.0.0.1", addr.IP.String())
+	assert.IsType(t, &net.UDPAddr{}, dialed.RemoteAddr())
+
+	descs := []tcpconn.StreamDescriptor{{ID: 0x01}, {ID: 0x02, SendQueueCapacity: 10}}
+	dialedStreams, _ := openStreams(t, dialed, descs...)
+	_, recvCh := openStreams(t, accepted, descs...)
+
+	msgs := []received{
+		{0x01, []byte("consensus")},
+		{0x02, []byte("mempool 1")},
+		{0x02, []byte("mempool 2")},
+		{0x01, []byte{}},
+	}
+	for _, m := range msgs {
+		_, err := dialedStreams[m.streamID].Write(m.msg)
+		require.NoError(t, err)
+	}
+
+	// Messages of the same stream are received in order.
+	got := make(map[byte][][]byte)
+	for range msgs {
+		select {
+		case r := <-recvCh:
+			got[r.streamID] = append(got[r.streamID], r.msg)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for messages")
+		}
+	}
+	assert.Equal(t, [][]byte{[]byte("consensus"), {}}, got[0x01])
+	assert.Equal(t, [][]byte{[]byte("mempool 1"), []byte("mempool 2")}, got[0x02])
+
+	state := dialed.ConnState()
+	assert.Len(t, state.StreamStates, 2)
+	assert.Equal(t, 10, state.StreamStates[0x02].SendQueueCapacity)
+}
+
+func TestTransportDialIDMismatch(t *testing.T) {
+	a, _ := newTestTransport(t)
+	b, _ := newTestTransport(t)
+
+	addr := b.NetAddr()
+	addr.ID = nodekey.PubKeyToID(ed25519.GenPrivKey().PubKey())
+
+	_, err := a.Dial(addr)
+	var e ErrRejected
+	require.ErrorAs(t, err, &e)
+	assert.True(t, e.IsAuthFailure())
+}
+
+func TestTransportConnFilter(t *testing.T) {
+	a, _ := newTestTransport(t)
+	b, _ := newTestTransport(t, TransportConnFilters(
+		func([]net.Addr, net.Addr) error { return nil },
+		func([]net.Addr, net.Addr) error { return errors.New("rejected") },
+	))
+
+	go func() {
+		if c, err := a.Dial(b.NetAddr()); err == nil {
+			_, _ = c.HandshakeStream().Write([]byte("ping"))
+		}
+	}()
+
+	_, _, err := b.Accept()
+	var e ErrRejected
+	require.ErrorAs(t, err, &e)
+	assert.True(t, e.IsFiltered())
+}
+
+func TestTransportConnFilterTimeout(t *testing.T) {
+	a, _ := newTestTransport(t)
+	b, _ := newTestTransport(t,
+		TransportFilterTimeout(5*time.Millisecond),
+		TransportConnFilters(func([]net.Addr, net.Addr) error {
+			time.Sleep(time.Second)
+			return nil
+		}),
+	)
+
+	go func() {
+		if c, err := a.Dial(b.NetAddr()); err == nil {
+			_, _ = c.HandshakeStream().Write([]byte("ping"))
+		}
+	}()
+
+	_, _, err := b.Accept()
+	assert.ErrorIs(t, err, ErrFilterTimeout{})
+}
+
+func TestTransportClose(t *testing.T) {
+	tr, _ := newTestTransport(t)
+	require.NoError(t, tr.Close())
+
+	_, _, err := tr.Accept()
+	assert.ErrorIs(t, err, ErrTransportClosed{})
+}
+
+func TestConnMessageTooBig(t *testing.T) {
+	a, _ := newTestTransport(t)
+	b, _ := newTestTransport(t)
+
+	dialed, accepted, _ := connect(t, a, b)
+
+	dialedStreams, _ := openStreams(t, dialed, tcpconn.StreamDescriptor{ID: 0x01})
+	openStreams(t, accepted, tcpconn.StreamDescriptor{ID: 0x01, RecvMessageCapacity: 10})
+
+	_, err := dialedStreams[0x01].Write(bytes.Repeat([]byte{1}, 11))
+	require.NoError(t, err)
+
+	select {
+	case err := <-accepted.ErrorCh():
+		assert.ErrorAs(t, err, &ErrMessageTooBig{})
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for error")
+	}
+}
+
+func TestConnUnknownStream(t *testing.T) {
+	a, _ := newTestTransport(t)
+	b, _ := newTestTransport(t)
+
+	dialed, accepted, _ := connect(t, a, b)
+
+	dialedStreams, _ := openStreams(t, dialed, tcpconn.StreamDescriptor{ID: 0x01})
+	openStreams(t, accepted, tcpconn.StreamDescriptor{ID: 0x02})
+
+	_, err := dialedStreams[0x01].Write([]byte("hello"))
+	require.NoError(t, err)
+
+	select {
+	case err := <-accepted.ErrorCh():
+		assert.ErrorIs(t, err, ErrUnknownStream{StreamID: 0x01})
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for error")
+	}
+}
+
+func TestConnFlushAndClose(t *testing.T) {
+	a, _ := newTestTransport(t)
+	b, _ := newTestTransport(t)
+
+	dialed, accepted, _ := connect(t, a, b)
+
+	desc := tcpconn.StreamDescriptor{ID: 0x01, SendQueueCapacity: 100}
+	dialedStreams, _ := openStreams(t, dialed, desc)
+	_, recvCh := openStreams(t, accepted, desc)
+
+	const numMsgs = 50
+	for i := 0; i < numMsgs; i++ {
+		_, err := dialedStreams[0x01].TryWrite(bytes.Repeat([]byte{byte(i)}, 1000))
+		require.NoError(t, err)
+	}
+	require.NoError(t, dialed.FlushAndClose("bye"))
+
+	// All messages are delivered before the connection is closed.
+	for i := 0; i < numMsgs; i++ {
+		select {
+		case r := <-recvCh:
+			require.Equal(t, byte(i), r.msg[0])
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for message %d", i)
+		}
+	}
+
+	// The remote closes its end once it has received everything.
+	select {
+	case err := <-accepted.ErrorCh():
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the connection to be closed")
+	}
+}
+
+func TestStreamTryWriteFull(t *testing.T) {
+	a, _ := newTestTransport(t)
+	b, _ := newTestTransport(t)
+
+	dialed, _, _ := connect(t, a, b)
+
+	// The connection is not started and the remote does not read from it, so
+	// the send queue eventually fills up.
+	s, err := dialed.OpenStream(0x01, tcpconn.StreamDescriptor{ID: 0x01, SendQueueCapacity: 1})
+	require.NoError(t, err)
+
+	msg := make([]byte, 1<<20)
+	for i := 0; i < 100; i++ {
+		if _, err = s.TryWrite(msg); err != nil {
+			break
+		}
+	}
+	var e transport.WriteError
+	require.ErrorAs(t, err, &e)
+	assert.True(t, e.Full())
+}
+
+func TestConnRateLimits(t *testing.T) {
+	a, _ := newTestTransport(t, TransportRateLimits(20000, 0, 1000))
+	b, _ := newTestTransport(t)
+
+	dialed, accepted, _ := connect(t, a, b)
+
+	descs := []tcpconn.StreamDescriptor{{ID: 0x01}, {ID: 0x02}}
+	dialedStreams, _ := openStreams(t, dialed, descs...)
+	_, recvCh := openStreams(t, accepted, descs...)
+
+	// 15kB sent on two streams at 20kB/s, so it takes more than 0.5s.
+	start := time.Now()
+	msgs := []received{
+		{0x01, bytes.Repeat([]byte{1}, 5000)},
+		{0x02, bytes.Repeat([]byte{2}, 5000)},
+		{0x01, bytes.Repeat([]byte{3}, 5000)},
+	}
+	for _, m := range msgs {
+		_, err := dialedStreams[m.streamID].Write(m.msg)
+		require.NoError(t, err)
+	}
+	for range msgs {
+		select {
+		case r := <-recvCh:
+			assert.Len(t, r.msg, 5000)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for messages")
+		}
+	}
+	assert.Greater(t, time.Since(start), 500*time.Millisecond)
+	assert.Positive(t, dialed.ConnState().SendRateLimiterDelay)
+}
@@ -0,0 +1,153 @@
+package quic
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/cometbft/cometbft/v2/crypto"
+	"github.com/cometbft/cometbft/v2/crypto/encoding"
+)
+
+// alpn is the application protocol negotiated during the TLS handshake.
+const alpn = "cometbft-p2p/1"
+
+// certSignaturePrefix is prepended to the certificate's public key before
+// signing it with the node key, so the signature cannot be reused in another
+// context.
+const certSignaturePrefix = "cometbft-quic-tls-cert:"
+
+// certValidity is how long the ephemeral certificate is valid for.
+const certValidity = 100 * 365 * 24 * time.Hour
+
+// nodeKeyExtensionID identifies the X.509 extension carrying the node key.
+var nodeKeyExtensionID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 58349, 1, 1}
+
+// signedNodeKey is the content of the node key extension. It binds the
+// ephemeral TLS key to the node key: Signature is the signature, by the node
+// key, of the certificate's SubjectPublicKeyInfo.
+type signedNodeKey struct {
+	KeyType   string
+	PubKey    []byte
+	Signature []byte
+}
+
+// newTLSConfig returns a TLS configuration with an ephemeral self-signed
+// certificate bound to the given node key. Peers are authenticated by their
+// node key and not by a certificate chain, hence the standard verification is
+// replaced by pubKeyFromCerts.
+func newTLSConfig(privKey crypto.PrivKey) (*tls.Config, error) {
+	cert, err := newCertificate(privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS13,
+		Certificates:       []tls.Certificate{cert},
+		NextProtos:         []string{alpn},
+		ClientAuth:         tls.RequireAnyClientCert,
+		InsecureSkipVerify: true, //nolint:gosec // see VerifyPeerCertificate
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			_, err := pubKeyFromCerts(rawCerts)
+			return err
+		},
+	}, nil
+}
+
+func newCertificate(privKey crypto.PrivKey) (tls.Certificate, error) {
+	certPub, certPriv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	spki, err := x509.MarshalPKIXPublicKey(certPub)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	sig, err := privKey.Sign(append([]byte(certSignaturePrefix), spki...))
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("signing certificate key: %w", err)
+	}
+	pubKey := privKey.PubKey()
+	ext, err := asn1.Marshal(signedNodeKey{
+		KeyType:   pubKey.Type(),
+		PubKey:    pubKey.Bytes(),
+		Signature: sig,
+	})
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:    serial,
+		NotBefore:       now.Add(-time.Hour),
+		NotAfter:        now.Add(certValidity),
+		ExtraExtensions: []pkix.Extension{{Id: nodeKeyExtensionID, Value: ext}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, certPub, certPriv)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: certPriv}, nil
+}
+
+// pubKeyFromCerts verifies the certificate presented by a peer and returns
+// the node key it is bound to.
+func pubKeyFromCerts(rawCerts [][]byte) (crypto.PubKey, error) {
+	if len(rawCerts) != 1 {
+		return nil, fmt.Errorf("expected 1 certificate, got %d", len(rawCerts))
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate: %w", err)
+	}
+	now := time.Now()
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return nil, errors.New("certificate is not valid at the current time")
+	}
+	if err := cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature); err != nil {
+		return nil, fmt.Errorf("invalid certificate signature: %w", err)
+	}
+
+	var ext []byte
+	for _, e := range cert.Extensions {
+		if e.Id.Equal(nodeKeyExtensionID) {
+			ext = e.Value
+			break
+		}
+	}
+	if ext == nil {
+		return nil, errors.New("certificate is missing the node key extension")
+	}
+	var snk signedNodeKey
+	if rest, err := asn1.Unmarshal(ext, &snk); err != nil {
+		return nil, fmt.Errorf("decoding node key extension: %w", err)
+	} else if len(rest) > 0 {
+		return nil, errors.New("trailing data after node key extension")
+	}
+
+	pubKey, err := encoding.PubKeyFromTypeAndBytes(snk.KeyType, snk.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	msg := append([]byte(certSignaturePrefix), cert.RawSubjectPublicKeyInfo...)
+	if !pubKey.VerifySignature(msg, snk.Signature) {
+		return nil, errors.New("invalid node key signature of the certificate")
+	}
+
+	return pubKey, nil
+}
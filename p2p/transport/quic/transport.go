@@ -0,0 +1,424 @@
+// Package quic implements a transport.Transport on top of QUIC.
+//
+// Peers are authenticated with their node keys: each node presents an
+// ephemeral TLS certificate whose key is signed by its node key, so the IDs
+// of the peers are the same as with the TCP transport.
+package quic
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	quicgo "github.com/quic-go/quic-go"
+
+	"github.com/cometbft/cometbft/v2/libs/log"
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+	"github.com/cometbft/cometbft/v2/p2p/internal/nodekey"
+	na "github.com/cometbft/cometbft/v2/p2p/netaddr"
+	"github.com/cometbft/cometbft/v2/p2p/transport"
+	tcpconn "github.com/cometbft/cometbft/v2/p2p/transport/tcp/conn"
+)
+
+const (
+	defaultDialTimeout      = time.Second
+	defaultFilterTimeout    = 5 * time.Second
+	defaultHandshakeTimeout = 3 * time.Second
+	defaultKeepAlivePeriod  = 10 * time.Second
+	defaultMaxIdleTimeout   = 30 * time.Second
+
+	// maxIncomingStreams is the maximum number of streams a peer can open. A
+	// stream is opened for each reactor channel.
+	maxIncomingStreams = 256
+)
+
+// accept is the container to carry the upgraded connection from an
+// asynchronously running routine to the Accept method.
+type accept struct {
+	netAddr *na.NetAddr
+	conn    *Conn
+	err     error
+}
+
+// ConnFilterFunc to be implemented by filter hooks after a new connection has
+// been established. The remote addresses of the existing connections are
+// passed along together with the remote address of the new connection.
+type ConnFilterFunc func(existing []net.Addr, remote net.Addr) error
+
+// ConnDuplicateIPFilter refuses new connections if they come from a known IP.
+func ConnDuplicateIPFilter() ConnFilterFunc {
+	return func(existing []net.Addr, remote net.Addr) error {
+		ip := addrIP(remote)
+		for _, addr := range existing {
+			if ip != nil && ip.Equal(addrIP(addr)) {
+				return fmt.Errorf("ip<%v> already connected", ip)
+			}
+		}
+
+		return nil
+	}
+}
+
+// TransportOption sets an optional parameter on the Transport.
+type TransportOption func(*Transport)
+
+// TransportConnFilters sets the filters for rejection new connections.
+func TransportConnFilters(filters ...ConnFilterFunc) TransportOption {
+	return func(t *Transport) { t.connFilters = filters }
+}
+
+// TransportFilterTimeout sets the timeout waited for filter calls to return.
+func TransportFilterTimeout(timeout time.Duration) TransportOption {
+	return func(t *Transport) { t.filterTimeout = timeout }
+}
+
+// TransportRateLimits sets the rates, in bytes/second, at which each
+// connection can send and receive messages, across all its streams. The
+// messages are written and read in chunks of at most maxChunkSize bytes, so
+// that a big message does not exceed the limits for long. A rate of 0 means
+// no limit. Default: the send and receive rates and the maximum packet payload
+// size of the default MConnConfig.
+func TransportRateLimits(sendRate, recvRate int64, maxChunkSize int) TransportOption {
+	return func(t *Transport) {
+		t.connConfig = connConfig{
+			sendRate:     sendRate,
+			recvRate:     recvRate,
+			maxChunkSize: maxChunkSize,
+		}
+	}
+}
+
+// TransportMaxIncomingConnections sets the maximum number of simultaneous
+// connections (incoming). Default: 0 (unlimited).
+func TransportMaxIncomingConnections(n int) TransportOption {
+	return func(t *Transport) { t.maxIncomingConnections = n }
+}
+
+// Transport accepts and dials QUIC connections.
+type Transport struct {
+	netAddr                na.NetAddr
+	listener               *quicgo.Listener
+	maxIncomingConnections int // see TransportMaxIncomingConnections
+
+	acceptc   chan accept
+	closec    chan struct{}
+	closeOnce sync.Once
+
+	// Established connections, used for filtering.
+	connsMtx      cmtsync.Mutex
+	conns         map[*Conn]struct{}
+	numIncoming   int
+	connFilters   []ConnFilterFunc
+	filterTimeout time.Duration
+	tlsConfig     *tls.Config
+	quicConfig    *quicgo.Config
+	connConfig    connConfig
+	dialTimeout   time.Duration
+	hsTimeout     time.Duration
+	logger        log.Logger
+}
+
+var _ transport.Transport = (*Transport)(nil)
+
+// NewTransport returns a QUIC transport authenticating with nodeKey.
+func NewTransport(nodeKey nodekey.NodeKey, options ...TransportOption) (*Transport, error) {
+	tlsConfig, err := newTLSConfig(nodeKey.PrivKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating TLS config: %w", err)
+	}
+
+	mConfig := tcpconn.DefaultMConnConfig()
+	t := &Transport{
+		acceptc:       make(chan accept),
+		closec:        make(chan struct{}),
+		conns:         make(map[*Conn]struct{}),
+		filterTimeout: defaultFilterTimeout,
+		tlsConfig:     tlsConfig,
+		quicConfig: &quicgo.Config{
+			HandshakeIdleTimeout:  defaultHandshakeTimeout,
+			MaxIdleTimeout:        defaultMaxIdleTimeout,
+			KeepAlivePeriod:       defaultKeepAlivePeriod,
+			MaxIncomingStreams:    1, // the handshake stream
+			MaxIncomingUniStreams: maxIncomingStreams,
+		},
+		connConfig: connConfig{
+			sendRate:     mConfig.SendRate,
+			recvRate:     mConfig.RecvRate,
+			maxChunkSize: mConfig.MaxPacketMsgPayloadSize,
+		},
+		dialTimeout: defaultDialTimeout,
+		hsTimeout:   defaultHandshakeTimeout,
+		logger:      log.NewNopLogger(),
+	}
+	for _, option := range options {
+		option(t)
+	}
+
+	return t, nil
+}
+
+// SetLogger sets the logger for the transport.
+func (t *Transport) SetLogger(l log.Logger) {
+	t.logger = l
+}
+
+// NetAddr implements Transport.
+func (t *Transport) NetAddr() na.NetAddr {
+	return t.netAddr
+}
+
+// Listen starts listening for QUIC connections on the UDP address of addr.
+func (t *Transport) Listen(addr na.NetAddr) error {
+	ln, err := quicgo.ListenAddr(addr.DialString(), t.tlsConfig, t.quicConfig)
+	if err != nil {
+		return err
+	}
+
+	t.netAddr = *na.New(addr.ID, ln.Addr())
+	t.listener = ln
+
+	go t.acceptPeers()
+
+	return nil
+}
+
+// Close stops listening and closes the transport.
+func (t *Transport) Close() error {
+	var err error
+	t.closeOnce.Do(func() {
+		close(t.closec)
+		if t.listener != nil {
+			err = t.listener.Close()
+		}
+	})
+	return err
+}
+
+// Accept implements Transport.
+func (t *Transport) Accept() (transport.Conn, *na.NetAddr, error) {
+	select {
+	case a := <-t.acceptc:
+		if a.err != nil {
+			return nil, nil, a.err
+		}
+
+		return a.conn, a.netAddr, nil
+	case <-t.closec:
+		return nil, nil, ErrTransportClosed{}
+	}
+}
+
+// Dial implements Transport.
+func (t *Transport) Dial(addr na.NetAddr) (transport.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), t.dialTimeout+t.hsTimeout)
+	defer cancel()
+
+	qc, err := quicgo.DialAddr(ctx, addr.DialString(), t.tlsConfig, t.quicConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the remote key matches the dialed ID.
+	connID, err := remoteID(qc)
+	if err != nil {
+		_ = qc.CloseWithError(codeNoError, "auth failure")
+		return nil, ErrRejected{addr: qc.RemoteAddr(), err: err, isAuthFailure: true}
+	}
+	if connID != addr.ID {
+		_ = qc.CloseWithError(codeNoError, "auth failure")
+		return nil, ErrRejected{
+			addr:          qc.RemoteAddr(),
+			id:            connID,
+			err:           fmt.Errorf("conn.ID (%v) dialed ID (%v) mismatch", connID, addr.ID),
+			isAuthFailure: true,
+		}
+	}
+
+	// TODO: Evaluate if we should apply filters if we explicitly dial (same
+	// as the TCP transport).
+	if err := t.filterConn(qc.RemoteAddr()); err != nil {
+		_ = qc.CloseWithError(codeNoError, "filtered")
+		return nil, err
+	}
+
+	hs, err := qc.OpenStreamSync(ctx)
+	if err != nil {
+		_ = qc.CloseWithError(codeNoError, "opening handshake stream failed")
+		return nil, err
+	}
+
+	c := newConn(qc, hs, t.connConfig)
+	c.SetLogger(t.logger.With("remote", addr))
+	t.addConn(c, false)
+
+	return c, nil
+}
+
+func (t *Transport) acceptPeers() {
+	for {
+		qc, err := t.listener.Accept(context.Background())
+		if err != nil {
+			// If Close() has been called, silently exit.
+			select {
+			case <-t.closec:
+				return
+			default:
+				// Transport is not closed
+			}
+
+			select {
+			case t.acceptc <- accept{err: err}:
+			case <-t.closec:
+			}
+			return
+		}
+
+		// Filtering and waiting for the handshake stream should be
+		// asynchronous to avoid head-of-line blocking.
+		go func(qc quicgo.Connection) {
+			netAddr, c, err := t.upgrade(qc)
+			if err != nil {
+				_ = qc.CloseWithError(codeNoError, "rejected")
+			}
+
+			select {
+			case t.acceptc <- accept{netAddr, c, err}:
+				// Make the upgraded peer available.
+			case <-t.closec:
+				// Give up if the transport was closed.
+				_ = qc.CloseWithError(codeNoError, "transport closed")
+			}
+		}(qc)
+	}
+}
+
+// upgrade authenticates, filters and waits for the handshake stream of an
+// incoming connection.
+func (t *Transport) upgrade(qc quicgo.Connection) (*na.NetAddr, *Conn, error) {
+	connID, err := remoteID(qc)
+	if err != nil {
+		return nil, nil, ErrRejected{addr: qc.RemoteAddr(), err: err, isAuthFailure: true}
+	}
+
+	if t.maxIncomingConnections > 0 && t.incomingConns() >= t.maxIncomingConnections {
+		return nil, nil, ErrRejected{
+			addr:       qc.RemoteAddr(),
+			err:        fmt.Errorf("max incoming connections (%d) reached", t.maxIncomingConnections),
+			isFiltered: true,
+		}
+	}
+
+	if err := t.filterConn(qc.RemoteAddr()); err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(qc.Context(), t.hsTimeout)
+	defer cancel()
+	hs, err := qc.AcceptStream(ctx)
+	if err != nil {
+		return nil, nil, ErrRejected{
+			addr: qc.RemoteAddr(),
+			id:   connID,
+			err:  fmt.Errorf("accepting handshake stream: %w", err),
+		}
+	}
+
+	netAddr := na.New(connID, qc.RemoteAddr())
+	c := newConn(qc, hs, t.connConfig)
+	c.SetLogger(t.logger.With("remote", netAddr))
+	t.addConn(c, true)
+
+	return netAddr, c, nil
+}
+
+func (t *Transport) filterConn(remote net.Addr) error {
+	t.connsMtx.Lock()
+	existing := make([]net.Addr, 0, len(t.conns))
+	for c := range t.conns {
+		existing = append(existing, c.RemoteAddr())
+	}
+	t.connsMtx.Unlock()
+
+	errc := make(chan error, len(t.connFilters))
+	for _, f := range t.connFilters {
+		go func(f ConnFilterFunc) {
+			errc <- f(existing, remote)
+		}(f)
+	}
+
+	timeout := time.NewTimer(t.filterTimeout)
+	defer timeout.Stop()
+	for i := 0; i < cap(errc); i++ {
+		select {
+		case err := <-errc:
+			if err != nil {
+				return ErrRejected{addr: remote, err: err, isFiltered: true}
+			}
+		case <-timeout.C:
+			return ErrFilterTimeout{}
+		}
+	}
+
+	return nil
+}
+
+func (t *Transport) incomingConns() int {
+	t.connsMtx.Lock()
+	defer t.connsMtx.Unlock()
+	return t.numIncoming
+}
+
+// addConn tracks c until it is closed.
+func (t *Transport) addConn(c *Conn, incoming bool) {
+	t.connsMtx.Lock()
+	t.conns[c] = struct{}{}
+	if incoming {
+		t.numIncoming++
+	}
+	t.connsMtx.Unlock()
+
+	go func() {
+		select {
+		case <-c.qc.Context().Done():
+		case <-t.closec:
+			return
+		}
+
+		t.connsMtx.Lock()
+		delete(t.conns, c)
+		if incoming {
+			t.numIncoming--
+		}
+		t.connsMtx.Unlock()
+	}()
+}
+
+// remoteID returns the ID of the node key the remote's certificate is bound
+// to.
+func remoteID(qc quicgo.Connection) (nodekey.ID, error) {
+	certs := qc.ConnectionState().TLS.PeerCertificates
+	rawCerts := make([][]byte, len(certs))
+	for i, cert := range certs {
+		rawCerts[i] = cert.Raw
+	}
+
+	pubKey, err := pubKeyFromCerts(rawCerts)
+	if err != nil {
+		return "", err
+	}
+	return nodekey.PubKeyToID(pubKey), nil
+}
+
+func addrIP(addr net.Addr) net.IP {
+	switch addr := addr.(type) {
+	case *net.UDPAddr:
+		return addr.IP
+	case *net.TCPAddr:
+		return addr.IP
+	default:
+		return nil
+	}
+}
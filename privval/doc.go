@@ -6,6 +6,14 @@ Package privval provides different implementations of the types.PrivValidator.
 FilePV is the simplest implementation and developer default.
 It uses one file for the private key and another to store state.
 
+# SignStateStore
+
+By default, FilePV keeps only its last sign state, in its state file. It can
+use another SignStateStore as well, like SignLog, which records every sign
+state in an append-only log, so that what a validator signed can be audited.
+The state file is kept up to date, for the FilePV to be used without the
+SignStateStore again.
+
 # SignerListenerEndpoint
 
 SignerListenerEndpoint establishes a connection to an external process,
@@ -23,5 +31,13 @@ SignerDialerEndpoint is a simple wrapper around a net.Conn. It's used by both IP
 SignerClient handles remote validator connections that provide signing services.
 In production, it's recommended to wrap it with RetrySignerClient to avoid
 termination in case of temporary errors.
+
+# GRPCSignerClient
+
+GRPCSignerClient dials a remote signer serving the PrivValidatorService gRPC
+service, like GRPCSignerServer, and authenticates with mutual TLS. Unlike with
+SignerListenerEndpoint, the node dials the remote signer, which can therefore
+sit behind standard gRPC tooling, like load balancers. Certificates are reloaded
+when their files change, so that they can be rotated without restarts.
 */
 package privval
@@ -166,6 +166,10 @@ var _ types.PrivValidator = (*FilePV)(nil)
 type FilePV struct {
 	Key           FilePVKey
 	LastSignState FilePVLastSignState
+
+	// If set, the sign state is persisted to store, as well as to
+	// LastSignState's file.
+	store SignStateStore
 }
 
 // NewFilePV generates a new validator from the given key and paths.
@@ -266,6 +270,39 @@ func LoadOrGenFilePV(keyFilePath, stateFilePath string, keyGenF func() (crypto.P
 	return pv, nil
 }
 
+// SetSignStateStore makes the FilePV persist its sign state to store, in
+// addition to the state file. The sign state of the FilePV is replaced with
+// the one of store, and saved to the state file, unless the former is behind
+// (e.g. because store is new), in which case the sign state of the FilePV is
+// saved to store first, so as not to lose the double signing protection.
+func (pv *FilePV) SetSignStateStore(store SignStateStore) error {
+	lss, err := store.LoadSignState()
+	if err != nil {
+		return err
+	}
+	if lss.isBehind(pv.LastSignState) {
+		if err := store.SaveSignState(pv.LastSignState); err != nil {
+			return err
+		}
+	} else {
+		lss.filePath = pv.LastSignState.filePath
+		if err := NewFileSignStateStore(lss.filePath).SaveSignState(lss); err != nil {
+			return err
+		}
+		pv.LastSignState = lss
+	}
+	pv.store = store
+	return nil
+}
+
+// Close closes the sign state store of the FilePV, if any.
+func (pv *FilePV) Close() error {
+	if pv.store == nil {
+		return nil
+	}
+	return pv.store.Close()
+}
+
 // GetAddress returns the address of the validator.
 // Implements PrivValidator.
 func (pv *FilePV) GetAddress() types.Address {
@@ -304,7 +341,9 @@ func (pv *FilePV) SignBytes(bytes []byte) ([]byte, error) {
 // Save persists the FilePV to disk.
 func (pv *FilePV) Save() {
 	pv.Key.Save()
-	pv.LastSignState.Save()
+	if err := pv.saveSignState(); err != nil {
+		panic(err)
+	}
 }
 
 // Reset resets all fields in the FilePV.
@@ -393,7 +432,9 @@ func (pv *FilePV) signVote(chainID string, vote *cmtproto.Vote, signExtension bo
 	if err != nil {
 		return err
 	}
-	pv.saveSigned(height, round, step, signBytes, sig)
+	if err := pv.saveSigned(height, round, step, signBytes, sig); err != nil {
+		return err
+	}
 	vote.Signature = sig
 
 	return nil
@@ -436,7 +477,9 @@ func (pv *FilePV) signProposal(chainID string, proposal *cmtproto.Proposal) erro
 	if err != nil {
 		return err
 	}
-	pv.saveSigned(height, round, step, signBytes, sig)
+	if err := pv.saveSigned(height, round, step, signBytes, sig); err != nil {
+		return err
+	}
 	proposal.Signature = sig
 	return nil
 }
@@ -444,13 +487,29 @@ func (pv *FilePV) signProposal(chainID string, proposal *cmtproto.Proposal) erro
 // Persist height/round/step and signature.
 func (pv *FilePV) saveSigned(height int64, round int32, step int8,
 	signBytes []byte, sig []byte,
-) {
+) error {
 	pv.LastSignState.Height = height
 	pv.LastSignState.Round = round
 	pv.LastSignState.Step = step
 	pv.LastSignState.Signature = sig
 	pv.LastSignState.SignBytes = signBytes
-	pv.LastSignState.Save()
+	return pv.saveSignState()
+}
+
+// saveSignState persists the sign state to the state file, and to the sign
+// state store if any. The state file is written first, so that it is never
+// behind the store: the FilePV can then be used without the store again
+// without risking a double sign, while a store left behind by a crash is
+// caught up by SetSignStateStore.
+func (pv *FilePV) saveSignState() error {
+	if pv.store == nil {
+		pv.LastSignState.Save()
+		return nil
+	}
+	if err := NewFileSignStateStore(pv.LastSignState.filePath).SaveSignState(pv.LastSignState); err != nil {
+		return err
+	}
+	return pv.store.SaveSignState(pv.LastSignState)
 }
 
 // -----------------------------------------------------------------------------------------
@@ -0,0 +1,141 @@
+package privval
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	pvproto "github.com/cometbft/cometbft/api/cometbft/privval/v2"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/v2/crypto"
+	cryptoenc "github.com/cometbft/cometbft/v2/crypto/encoding"
+	"github.com/cometbft/cometbft/v2/types"
+	cmterrors "github.com/cometbft/cometbft/v2/types/errors"
+)
+
+// GRPCSignerClient implements PrivValidator.
+// It sends the signing requests to a remote signer serving them over gRPC,
+// such as GRPCSignerServer.
+type GRPCSignerClient struct {
+	conn    *grpc.ClientConn
+	client  pvproto.PrivValidatorServiceClient
+	chainID string
+	timeout time.Duration
+}
+
+var _ types.PrivValidator = (*GRPCSignerClient)(nil)
+
+// NewGRPCSignerClient returns a GRPCSignerClient sending the requests for
+// chainID to the remote signer at target, which can be any gRPC target, e.g.
+// "signer.example.com:26659" or "dns:///signer.example.com:26659".
+// Connections are authenticated with tlsConfig, which is typically created
+// with NewGRPCClientTLSConfig.
+//
+// The connection is established lazily, and re-established if it breaks.
+// Each request waits up to timeout for the connection to be ready and for the
+// remote signer to respond.
+func NewGRPCSignerClient(target, chainID string, tlsConfig *tls.Config, timeout time.Duration) (*GRPCSignerClient, error) {
+	conn, err := grpc.NewClient(target,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+
+	return &GRPCSignerClient{
+		conn:    conn,
+		client:  pvproto.NewPrivValidatorServiceClient(conn),
+		chainID: chainID,
+		timeout: timeout,
+	}, nil
+}
+
+// Close closes the underlying connection.
+func (sc *GRPCSignerClient) Close() error {
+	return sc.conn.Close()
+}
+
+// --------------------------------------------------------
+// Implement PrivValidator
+
+// GetPubKey retrieves a public key from the remote signer
+// returns an error if client is not able to provide the key.
+func (sc *GRPCSignerClient) GetPubKey() (crypto.PubKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.GetPubKey(ctx, &pvproto.PubKeyRequest{ChainId: sc.chainID})
+	if err != nil {
+		return nil, fmt.Errorf("send: %w", err)
+	}
+	if resp.Error != nil {
+		return nil, &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	pk, err := cryptoenc.PubKeyFromTypeAndBytes(resp.PubKeyType, resp.PubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return pk, nil
+}
+
+// SignVote requests the remote signer to sign a vote.
+func (sc *GRPCSignerClient) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.SignVote(ctx, &pvproto.SignVoteRequest{Vote: vote, ChainId: chainID, SkipExtensionSigning: !signExtension})
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	*vote = resp.Vote
+
+	return nil
+}
+
+// SignProposal requests the remote signer to sign a proposal.
+func (sc *GRPCSignerClient) SignProposal(chainID string, proposal *cmtproto.Proposal) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.SignProposal(ctx, &pvproto.SignProposalRequest{Proposal: proposal, ChainId: chainID})
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+
+	*proposal = resp.Proposal
+
+	return nil
+}
+
+// SignBytes requests the remote signer to sign bytes.
+func (sc *GRPCSignerClient) SignBytes(bytes []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	resp, err := sc.client.SignBytes(ctx, &pvproto.SignBytesRequest{Value: bytes})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		return nil, &RemoteSignerError{Code: int(resp.Error.Code), Description: resp.Error.Description}
+	}
+	if len(resp.Signature) == 0 {
+		return nil, cmterrors.ErrRequiredField{Field: "signature"}
+	}
+
+	return resp.Signature, nil
+}
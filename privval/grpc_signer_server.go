@@ -0,0 +1,158 @@
+package privval
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	pvproto "github.com/cometbft/cometbft/api/cometbft/privval/v2"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	"github.com/cometbft/cometbft/v2/libs/service"
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// GRPCSignerServer serves the signing requests of a node over gRPC, using its
+// privVal. It also serves the standard gRPC health service, so that load
+// balancers and orchestrators can check the health of the signer.
+type GRPCSignerServer struct {
+	service.BaseService
+
+	listener net.Listener
+	server   *grpc.Server
+	health   *health.Server
+	chainID  string
+	privVal  types.PrivValidator
+
+	handlerMtx               cmtsync.Mutex
+	validationRequestHandler ValidationRequestHandlerFunc
+}
+
+var _ pvproto.PrivValidatorServiceServer = (*GRPCSignerServer)(nil)
+
+// NewGRPCSignerServer returns a GRPCSignerServer serving the requests for
+// chainID on listener. Connections are authenticated with tlsConfig, which is
+// typically created with NewGRPCServerTLSConfig.
+func NewGRPCSignerServer(
+	listener net.Listener,
+	chainID string,
+	privVal types.PrivValidator,
+	tlsConfig *tls.Config,
+	logger log.Logger,
+) *GRPCSignerServer {
+	ss := &GRPCSignerServer{
+		listener:                 listener,
+		server:                   grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig))),
+		health:                   health.NewServer(),
+		chainID:                  chainID,
+		privVal:                  privVal,
+		validationRequestHandler: DefaultValidationRequestHandler,
+	}
+	pvproto.RegisterPrivValidatorServiceServer(ss.server, ss)
+	healthpb.RegisterHealthServer(ss.server, ss.health)
+
+	ss.BaseService = *service.NewBaseService(logger, "GRPCSignerServer", ss)
+
+	return ss
+}
+
+// OnStart implements service.Service.
+func (ss *GRPCSignerServer) OnStart() error {
+	ss.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	ss.health.SetServingStatus(pvproto.PrivValidatorService_serviceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	go func() {
+		if err := ss.server.Serve(ss.listener); err != nil {
+			ss.Logger.Error("GRPCSignerServer: Serve", "err", err)
+		}
+	}()
+	return nil
+}
+
+// OnStop implements service.Service.
+func (ss *GRPCSignerServer) OnStop() {
+	ss.health.Shutdown()
+	ss.server.GracefulStop()
+}
+
+// SetRequestHandler override the default function that is used to service requests.
+func (ss *GRPCSignerServer) SetRequestHandler(validationRequestHandler ValidationRequestHandlerFunc) {
+	ss.handlerMtx.Lock()
+	defer ss.handlerMtx.Unlock()
+	ss.validationRequestHandler = validationRequestHandler
+}
+
+// handle passes the request to the request handler. Requests are handled one
+// at a time, as with the socket protocol, since the PrivValidator does not
+// need to be safe for concurrent use.
+//
+// As with the socket protocol, errors of the PrivValidator are returned in the
+// response, which is returned along with the error of the handler.
+func (ss *GRPCSignerServer) handle(req pvproto.Message) (pvproto.Message, error) {
+	ss.handlerMtx.Lock()
+	defer ss.handlerMtx.Unlock()
+
+	res, err := ss.validationRequestHandler(ss.privVal, req, ss.chainID)
+	if err != nil {
+		// only log the error; we'll reply with an error in res if there is one
+		ss.Logger.Error("GRPCSignerServer: handleMessage", "err", err)
+	}
+	return res, err
+}
+
+// responseError returns the error to reply with when the request handler did
+// not return a response of the expected type.
+func responseError(err error) error {
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, "Unexpected response from the request handler")
+}
+
+// GetPubKey implements v2.PrivValidatorServiceServer.
+func (ss *GRPCSignerServer) GetPubKey(_ context.Context, req *pvproto.PubKeyRequest) (*pvproto.PubKeyResponse, error) {
+	res, err := ss.handle(mustWrapMsg(req))
+	if r := res.GetPubKeyResponse(); r != nil {
+		return r, nil
+	}
+	return nil, responseError(err)
+}
+
+// SignVote implements v2.PrivValidatorServiceServer.
+func (ss *GRPCSignerServer) SignVote(_ context.Context, req *pvproto.SignVoteRequest) (*pvproto.SignedVoteResponse, error) {
+	if req.Vote == nil {
+		return nil, status.Error(codes.InvalidArgument, "Vote cannot be empty")
+	}
+	res, err := ss.handle(mustWrapMsg(req))
+	if r := res.GetSignedVoteResponse(); r != nil {
+		return r, nil
+	}
+	return nil, responseError(err)
+}
+
+// SignProposal implements v2.PrivValidatorServiceServer.
+func (ss *GRPCSignerServer) SignProposal(_ context.Context, req *pvproto.SignProposalRequest) (*pvproto.SignedProposalResponse, error) {
+	if req.Proposal == nil {
+		return nil, status.Error(codes.InvalidArgument, "Proposal cannot be empty")
+	}
+	res, err := ss.handle(mustWrapMsg(req))
+	if r := res.GetSignedProposalResponse(); r != nil {
+		return r, nil
+	}
+	return nil, responseError(err)
+}
+
+// SignBytes implements v2.PrivValidatorServiceServer.
+func (ss *GRPCSignerServer) SignBytes(_ context.Context, req *pvproto.SignBytesRequest) (*pvproto.SignBytesResponse, error) {
+	res, err := ss.handle(mustWrapMsg(req))
+	if r := res.GetSignBytesResponse(); r != nil {
+		return r, nil
+	}
+	return nil, responseError(err)
+}
@@ -0,0 +1,284 @@
+package privval
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/v2/crypto"
+	"github.com/cometbft/cometbft/v2/crypto/tmhash"
+	cmtrand "github.com/cometbft/cometbft/v2/internal/rand"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	"github.com/cometbft/cometbft/v2/types"
+	cmttime "github.com/cometbft/cometbft/v2/types/time"
+)
+
+const testGRPCTimeout = 5 * time.Second
+
+// testCA is a certificate authority issuing the certificates of the tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes a certificate signed by the CA and its key to dir, and returns
+// the paths of the files.
+func (ca *testCA) issue(t *testing.T, dir, name string) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(cmtrand.Int63()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, name+".crt")
+	keyFile = filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certFile, keyFile
+}
+
+// startGRPCSigner starts a GRPCSignerServer trusting the clients of clientCA,
+// and returns its address and the path of the file of trusted CAs.
+func startGRPCSigner(t *testing.T, chainID string, pv types.PrivValidator, serverCA, clientCA *testCA) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	certFile, keyFile := serverCA.issue(t, dir, "server")
+	caFile := filepath.Join(dir, "client-ca.crt")
+	require.NoError(t, os.WriteFile(caFile, clientCA.pem, 0o600))
+	tlsConfig, err := NewGRPCServerTLSConfig(certFile, keyFile, caFile)
+	require.NoError(t, err)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ss := NewGRPCSignerServer(ln, chainID, pv, tlsConfig, log.TestingLogger())
+	require.NoError(t, ss.Start())
+	t.Cleanup(func() {
+		if err := ss.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	return ln.Addr().String(), caFile
+}
+
+// newTestGRPCSignerClient returns a client presenting a certificate issued by
+// clientCA, and trusting the servers of serverCA.
+func newTestGRPCSignerClient(t *testing.T, addr, chainID string, clientCA, serverCA *testCA, timeout time.Duration) *GRPCSignerClient {
+	t.Helper()
+	dir := t.TempDir()
+	certFile, keyFile := clientCA.issue(t, dir, "client")
+	caFile := filepath.Join(dir, "server-ca.crt")
+	require.NoError(t, os.WriteFile(caFile, serverCA.pem, 0o600))
+	tlsConfig, err := NewGRPCClientTLSConfig(certFile, keyFile, caFile)
+	require.NoError(t, err)
+
+	sc, err := NewGRPCSignerClient(addr, chainID, tlsConfig, timeout)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := sc.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	return sc
+}
+
+func TestGRPCSignerClient(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	mockPV := types.NewMockPV()
+	ca := newTestCA(t)
+	addr, _ := startGRPCSigner(t, chainID, mockPV, ca, ca)
+	sc := newTestGRPCSignerClient(t, addr, chainID, ca, ca, testGRPCTimeout)
+
+	t.Run("GetPubKey", func(t *testing.T) {
+		pubKey, err := sc.GetPubKey()
+		require.NoError(t, err)
+		expectedPubKey, err := mockPV.GetPubKey()
+		require.NoError(t, err)
+		assert.Equal(t, expectedPubKey, pubKey)
+	})
+
+	t.Run("SignVote", func(t *testing.T) {
+		hash := cmtrand.Bytes(tmhash.Size)
+		vote := func() *types.Vote {
+			return &types.Vote{
+				Type:             types.PrecommitType,
+				Height:           1,
+				Round:            2,
+				BlockID:          types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Hash: hash, Total: 2}},
+				Timestamp:        cmttime.Now(),
+				ValidatorAddress: cmtrand.Bytes(crypto.AddressSize),
+				ValidatorIndex:   1,
+			}
+		}
+		want, have := vote(), vote()
+		have.Timestamp, have.ValidatorAddress = want.Timestamp, want.ValidatorAddress
+
+		wantProto, haveProto := want.ToProto(), have.ToProto()
+		require.NoError(t, mockPV.SignVote(chainID, wantProto, true))
+		require.NoError(t, sc.SignVote(chainID, haveProto, true))
+		assert.Equal(t, wantProto.Signature, haveProto.Signature)
+		assert.Equal(t, wantProto.ExtensionSignature, haveProto.ExtensionSignature)
+	})
+
+	t.Run("SignProposal", func(t *testing.T) {
+		ts := cmttime.Now()
+		hash := cmtrand.Bytes(tmhash.Size)
+		proposal := func() *types.Proposal {
+			return &types.Proposal{
+				Type:      types.ProposalType,
+				Height:    1,
+				Round:     2,
+				POLRound:  2,
+				BlockID:   types.BlockID{Hash: hash, PartSetHeader: types.PartSetHeader{Hash: hash, Total: 2}},
+				Timestamp: ts,
+			}
+		}
+		want, have := proposal().ToProto(), proposal().ToProto()
+
+		require.NoError(t, mockPV.SignProposal(chainID, want))
+		require.NoError(t, sc.SignProposal(chainID, have))
+		assert.Equal(t, want.Signature, have.Signature)
+	})
+
+	t.Run("SignBytes", func(t *testing.T) {
+		bytes := cmtrand.Bytes(32)
+		signature, err := sc.SignBytes(bytes)
+		require.NoError(t, err)
+		pubKey, err := mockPV.GetPubKey()
+		require.NoError(t, err)
+		assert.True(t, pubKey.VerifySignature(bytes, signature))
+	})
+
+	t.Run("ChainIDMismatch", func(t *testing.T) {
+		proposal := &types.Proposal{Type: types.ProposalType, Height: 1, Timestamp: cmttime.Now()}
+		err := sc.SignProposal("other-chain", proposal.ToProto())
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGRPCSignerClient_RemoteSignerError(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	ca := newTestCA(t)
+	addr, _ := startGRPCSigner(t, chainID, types.NewErroringMockPV(), ca, ca)
+	sc := newTestGRPCSignerClient(t, addr, chainID, ca, ca, testGRPCTimeout)
+
+	proposal := &types.Proposal{Type: types.ProposalType, Height: 1, Timestamp: cmttime.Now()}
+	err := sc.SignProposal(chainID, proposal.ToProto())
+	var remoteErr *RemoteSignerError
+	require.ErrorAs(t, err, &remoteErr)
+}
+
+func TestGRPCSignerServer_RequiresClientCertificate(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	ca, otherCA := newTestCA(t), newTestCA(t)
+	addr, _ := startGRPCSigner(t, chainID, types.NewMockPV(), ca, ca)
+
+	// A client certificate issued by another CA is rejected.
+	sc := newTestGRPCSignerClient(t, addr, chainID, otherCA, ca, time.Second)
+	_, err := sc.GetPubKey()
+	require.Error(t, err)
+
+	// So is a client without a certificate.
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, "")))
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	require.Error(t, err)
+}
+
+func TestGRPCSignerClient_RequiresServerCertificate(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	ca, otherCA := newTestCA(t), newTestCA(t)
+	addr, _ := startGRPCSigner(t, chainID, types.NewMockPV(), otherCA, ca)
+
+	sc := newTestGRPCSignerClient(t, addr, chainID, ca, ca, time.Second)
+	_, err := sc.GetPubKey()
+	require.Error(t, err)
+}
+
+func TestGRPCSignerServer_Health(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	ca := newTestCA(t)
+	addr, _ := startGRPCSigner(t, chainID, types.NewMockPV(), ca, ca)
+	sc := newTestGRPCSignerClient(t, addr, chainID, ca, ca, testGRPCTimeout)
+
+	ctx, cancel := context.WithTimeout(context.Background(), testGRPCTimeout)
+	defer cancel()
+	res, err := healthpb.NewHealthClient(sc.conn).Check(ctx, &healthpb.HealthCheckRequest{
+		Service: "cometbft.privval.v2.PrivValidatorService",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.Status)
+}
+
+func TestGRPCSignerServer_RotateClientCA(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	ca, newCA := newTestCA(t), newTestCA(t)
+	addr, clientCAFile := startGRPCSigner(t, chainID, types.NewMockPV(), ca, ca)
+
+	sc := newTestGRPCSignerClient(t, addr, chainID, newCA, ca, time.Second)
+	_, err := sc.GetPubKey()
+	require.Error(t, err)
+
+	// Trust the new CA, making sure the modification time changes.
+	require.NoError(t, os.WriteFile(clientCAFile, append(ca.pem, newCA.pem...), 0o600))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(clientCAFile, later, later))
+
+	sc = newTestGRPCSignerClient(t, addr, chainID, newCA, ca, testGRPCTimeout)
+	_, err = sc.GetPubKey()
+	require.NoError(t, err)
+}
@@ -0,0 +1,239 @@
+package privval
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cometbft/cometbft/v2/internal/framing"
+	cmtbytes "github.com/cometbft/cometbft/v2/libs/bytes"
+	cmtjson "github.com/cometbft/cometbft/v2/libs/json"
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+	cmttime "github.com/cometbft/cometbft/v2/types/time"
+)
+
+// Entries are much smaller in practice, this only guards against allocating
+// huge buffers for corrupted lengths.
+const maxSignLogEntrySize = 1 << 20
+
+// SignLogEntry is an entry of a SignLog, recording a sign state of a
+// validator.
+type SignLogEntry struct {
+	Height    int64             `json:"height"`
+	Round     int32             `json:"round"`
+	Step      int8              `json:"step"`
+	Signature []byte            `json:"signature,omitempty"`
+	SignBytes cmtbytes.HexBytes `json:"signbytes,omitempty"`
+	// The local time at which the entry was recorded.
+	Time time.Time `json:"time"`
+}
+
+// SignLog is a SignStateStore recording every sign state of a validator, i.e.
+// the height, round and step of every signature along with the signed bytes,
+// in an append-only log file. This allows auditing what a validator signed,
+// e.g. with ReadSignLog. Entries are encoded as JSON, and framed by their
+// CRC-32C checksum and length like the messages of the consensus WAL.
+//
+// Each entry is synced to disk before SaveSignState returns. An entry left
+// incomplete by a crash is discarded when the log is opened.
+type SignLog struct {
+	mtx  cmtsync.Mutex
+	file *os.File
+	size int64
+	last FilePVLastSignState
+}
+
+var _ SignStateStore = (*SignLog)(nil)
+
+// OpenSignLog opens the sign log at filePath, creating it if it does not exist.
+func OpenSignLog(filePath string) (*SignLog, error) {
+	_, err := os.Stat(filePath)
+	created := errors.Is(err, os.ErrNotExist)
+
+	file, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	l := &SignLog{file: file}
+
+	size, torn, err := scanSignLog(file, func(entry SignLogEntry) error {
+		l.last = FilePVLastSignState{
+			Height:    entry.Height,
+			Round:     entry.Round,
+			Step:      entry.Step,
+			Signature: entry.Signature,
+			SignBytes: entry.SignBytes,
+		}
+		return nil
+	})
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("reading sign log %s: %w", filePath, err)
+	}
+	l.size = size
+
+	if torn {
+		if err := l.truncate(); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("discarding incomplete entry of sign log %s: %w", filePath, err)
+		}
+	}
+	if _, err := file.Seek(size, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if created {
+		// Make sure the log itself survives a crash.
+		if err := syncDir(filepath.Dir(filePath)); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// LoadSignState implements SignStateStore. It returns the sign state of the
+// last entry of the log.
+func (l *SignLog) LoadSignState() (FilePVLastSignState, error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.last, nil
+}
+
+// SaveSignState implements SignStateStore. It appends an entry to the log.
+func (l *SignLog) SaveSignState(lss FilePVLastSignState) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	payload, err := cmtjson.Marshal(SignLogEntry{
+		Height:    lss.Height,
+		Round:     lss.Round,
+		Step:      lss.Step,
+		Signature: lss.Signature,
+		SignBytes: lss.SignBytes,
+		Time:      cmttime.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	buf, err := framing.Encode(payload, maxSignLogEntrySize)
+	if err != nil {
+		return err
+	}
+
+	if _, err := l.file.Write(buf); err != nil {
+		// Do not leave an incomplete entry in the middle of the log.
+		_ = l.truncate()
+		return err
+	}
+	if err := l.file.Sync(); err != nil {
+		_ = l.truncate()
+		return err
+	}
+	l.size += int64(len(buf))
+	l.last = lss
+	return nil
+}
+
+// Close implements SignStateStore.
+func (l *SignLog) Close() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.file.Close()
+}
+
+// truncate discards anything written after the last complete entry.
+func (l *SignLog) truncate() error {
+	if err := l.file.Truncate(l.size); err != nil {
+		return err
+	}
+	if _, err := l.file.Seek(l.size, io.SeekStart); err != nil {
+		return err
+	}
+	return l.file.Sync()
+}
+
+// ReadSignLog calls fn with every entry of the sign log at filePath, in the
+// order in which they were recorded. It stops at the first error returned by
+// fn. The log can be read while it is being written to.
+func ReadSignLog(filePath string, fn func(SignLogEntry) error) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, _, err = scanSignLog(file, fn)
+	return err
+}
+
+// errInvalidSignLogEntry is returned by readSignLogEntry for an entry that is
+// complete, but cannot be decoded.
+var errInvalidSignLogEntry = errors.New("invalid sign log entry")
+
+// scanSignLog calls fn with the entries read from r, and returns the size of
+// the complete entries. If the log ends with an incomplete entry, as left by a
+// crash in the middle of an append, torn is true. Any other invalid entry is
+// an error.
+func scanSignLog(r io.Reader, fn func(SignLogEntry) error) (size int64, torn bool, err error) {
+	br := bufio.NewReader(r)
+	for {
+		entry, n, err := readSignLogEntry(br)
+		switch {
+		case errors.Is(err, io.EOF):
+			return size, false, nil
+		case errors.Is(err, io.ErrUnexpectedEOF):
+			return size, true, nil
+		case errors.Is(err, errInvalidSignLogEntry):
+			// A crash may leave a complete but garbled (often zeroed) entry
+			// at the end of the log. Garbage followed by anything else is
+			// corruption.
+			rest, readErr := io.ReadAll(br)
+			if readErr != nil {
+				return size, false, readErr
+			}
+			if len(bytes.Trim(rest, "\x00")) == 0 {
+				return size, true, nil
+			}
+			return size, false, fmt.Errorf("%w at offset %d", err, size)
+		case err != nil:
+			return size, false, err
+		}
+		if err := fn(entry); err != nil {
+			return size, false, err
+		}
+		size += int64(n)
+	}
+}
+
+// readSignLogEntry reads an entry from r, and returns it along with its size.
+func readSignLogEntry(r io.Reader) (SignLogEntry, int, error) {
+	var entry SignLogEntry
+
+	payload, err := framing.Read(r, maxSignLogEntrySize)
+	switch {
+	case errors.Is(err, framing.ErrInvalidLength), errors.Is(err, framing.ErrChecksumMismatch):
+		return entry, 0, fmt.Errorf("%w: %v", errInvalidSignLogEntry, err)
+	case err != nil:
+		return entry, 0, err
+	}
+	if err := cmtjson.Unmarshal(payload, &entry); err != nil {
+		return entry, 0, fmt.Errorf("%w: %v", errInvalidSignLogEntry, err)
+	}
+	return entry, framing.HeaderSize + len(payload), nil
+}
+
+// syncDir syncs the directory at path, which persists the entries of the files
+// it contains.
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
@@ -0,0 +1,177 @@
+package privval
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/v2/crypto/tmhash"
+	"github.com/cometbft/cometbft/v2/internal/framing"
+	cmtrand "github.com/cometbft/cometbft/v2/internal/rand"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+func readTestSignLog(t *testing.T, filePath string) []SignLogEntry {
+	t.Helper()
+	var entries []SignLogEntry
+	err := ReadSignLog(filePath, func(entry SignLogEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	require.NoError(t, err)
+	return entries
+}
+
+func openTestSignLog(t *testing.T, filePath string) *SignLog {
+	t.Helper()
+	l, err := OpenSignLog(filePath)
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	return l
+}
+
+func TestSignLog(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "sign.log")
+	l := openTestSignLog(t, filePath)
+
+	lss, err := l.LoadSignState()
+	require.NoError(t, err)
+	assert.Equal(t, FilePVLastSignState{}, lss)
+
+	states := []FilePVLastSignState{
+		{Height: 1, Round: 0, Step: stepPropose, Signature: []byte("sig1"), SignBytes: []byte("bytes1")},
+		{Height: 1, Round: 0, Step: stepPrevote, Signature: []byte("sig2"), SignBytes: []byte("bytes2")},
+		{Height: 1, Round: 0, Step: stepPrecommit, Signature: []byte("sig3"), SignBytes: []byte("bytes3")},
+	}
+	for _, lss := range states {
+		require.NoError(t, l.SaveSignState(lss))
+	}
+	require.NoError(t, l.Close())
+
+	entries := readTestSignLog(t, filePath)
+	require.Len(t, entries, len(states))
+	for i, entry := range entries {
+		assert.Equal(t, states[i].Height, entry.Height)
+		assert.Equal(t, states[i].Round, entry.Round)
+		assert.Equal(t, states[i].Step, entry.Step)
+		assert.Equal(t, states[i].Signature, entry.Signature)
+		assert.Equal(t, states[i].SignBytes, entry.SignBytes)
+		assert.False(t, entry.Time.IsZero())
+	}
+
+	l = openTestSignLog(t, filePath)
+	lss, err = l.LoadSignState()
+	require.NoError(t, err)
+	assert.Equal(t, states[len(states)-1], lss)
+}
+
+func TestSignLogIncompleteEntry(t *testing.T) {
+	testCases := map[string]func(entry []byte) []byte{
+		"truncated header":  func(entry []byte) []byte { return entry[:framing.HeaderSize/2] },
+		"truncated payload": func(entry []byte) []byte { return entry[:len(entry)-1] },
+		"zeroed entry":      func(entry []byte) []byte { return make([]byte, len(entry)) },
+	}
+	for name, garble := range testCases {
+		t.Run(name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "sign.log")
+			l := openTestSignLog(t, filePath)
+			lss := FilePVLastSignState{Height: 1, Step: stepPropose, Signature: []byte("sig1"), SignBytes: []byte("bytes1")}
+			require.NoError(t, l.SaveSignState(lss))
+			require.NoError(t, l.SaveSignState(FilePVLastSignState{Height: 1, Step: stepPrevote}))
+			require.NoError(t, l.Close())
+
+			// Simulate a crash in the middle of the append of the second
+			// entry.
+			data, err := os.ReadFile(filePath)
+			require.NoError(t, err)
+			_, first, err := readSignLogEntry(bytes.NewReader(data))
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(filePath, append(data[:first], garble(data[first:])...), 0o600))
+
+			// The incomplete entry is ignored when reading...
+			assert.Len(t, readTestSignLog(t, filePath), 1)
+
+			// ...and discarded when opening.
+			l = openTestSignLog(t, filePath)
+			loaded, err := l.LoadSignState()
+			require.NoError(t, err)
+			assert.Equal(t, lss, loaded)
+			require.NoError(t, l.SaveSignState(FilePVLastSignState{Height: 1, Step: stepPrecommit}))
+			require.NoError(t, l.Close())
+
+			entries := readTestSignLog(t, filePath)
+			require.Len(t, entries, 2)
+			assert.Equal(t, stepPrecommit, entries[1].Step)
+		})
+	}
+}
+
+func TestSignLogCorruptedEntry(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "sign.log")
+	l := openTestSignLog(t, filePath)
+	for step := stepPropose; step <= stepPrecommit; step++ {
+		require.NoError(t, l.SaveSignState(FilePVLastSignState{Height: 1, Step: step}))
+	}
+	require.NoError(t, l.Close())
+
+	data, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	data[framing.HeaderSize+1]++
+	require.NoError(t, os.WriteFile(filePath, data, 0o600))
+
+	_, err = OpenSignLog(filePath)
+	require.ErrorIs(t, err, errInvalidSignLogEntry)
+	err = ReadSignLog(filePath, func(SignLogEntry) error { return nil })
+	require.ErrorIs(t, err, errInvalidSignLogEntry)
+}
+
+func TestFilePVSignLog(t *testing.T) {
+	privVal, _, _ := newTestFilePV(t, nil)
+	privVal.Save()
+	chainID := "mychainid"
+	blockID := types.BlockID{Hash: cmtrand.Bytes(tmhash.Size)}
+
+	// The state of the FilePV is saved to a new log.
+	vote := newVote(privVal.Key.Address, 10, 0, types.PrevoteType, blockID)
+	require.NoError(t, privVal.SignVote(chainID, vote.ToProto(), false))
+
+	filePath := filepath.Join(t.TempDir(), "sign.log")
+	l, err := OpenSignLog(filePath)
+	require.NoError(t, err)
+	require.NoError(t, privVal.SetSignStateStore(l))
+	require.Len(t, readTestSignLog(t, filePath), 1)
+
+	// Signatures are recorded in the log.
+	proposal := newProposal(11, 0, blockID)
+	require.NoError(t, privVal.SignProposal(chainID, proposal.ToProto()))
+	vote = newVote(privVal.Key.Address, 11, 0, types.PrevoteType, blockID)
+	require.NoError(t, privVal.SignVote(chainID, vote.ToProto(), false))
+	entries := readTestSignLog(t, filePath)
+	require.Len(t, entries, 3)
+	assert.Equal(t, int64(11), entries[2].Height)
+	assert.Equal(t, stepPrevote, entries[2].Step)
+	assert.Equal(t, types.VoteSignBytes(chainID, vote.ToProto()), []byte(entries[2].SignBytes))
+	require.NoError(t, privVal.Close())
+
+	// The state file is kept in sync, for the log not to be needed anymore.
+	filePV := LoadFilePV(privVal.Key.filePath, privVal.LastSignState.filePath)
+	assert.Equal(t, privVal.LastSignState, filePV.LastSignState)
+
+	// The state is loaded from the log, which protects against double
+	// signing.
+	privVal = LoadFilePVEmptyState(privVal.Key.filePath, privVal.LastSignState.filePath)
+	l, err = OpenSignLog(filePath)
+	require.NoError(t, err)
+	require.NoError(t, privVal.SetSignStateStore(l))
+	defer privVal.Close()
+	assert.Equal(t, int64(11), privVal.LastSignState.Height)
+
+	conflicting := newVote(privVal.Key.Address, 11, 0, types.PrevoteType, types.BlockID{Hash: cmtrand.Bytes(tmhash.Size)})
+	require.Error(t, privVal.SignVote(chainID, conflicting.ToProto(), false))
+	require.Error(t, privVal.SignProposal(chainID, newProposal(10, 0, blockID).ToProto()))
+	assert.Len(t, readTestSignLog(t, filePath), 3)
+}
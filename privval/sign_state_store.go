@@ -0,0 +1,80 @@
+package privval
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cometbft/cometbft/v2/internal/tempfile"
+	cmtjson "github.com/cometbft/cometbft/v2/libs/json"
+)
+
+// SignStateStore persists the last sign state of a validator, which protects it
+// from double signing. The state must be durable once SaveSignState returns,
+// since the signature is only released afterwards.
+type SignStateStore interface {
+	// LoadSignState returns the last saved sign state, or an empty state if
+	// none was saved.
+	LoadSignState() (FilePVLastSignState, error)
+	// SaveSignState persists the given sign state.
+	SaveSignState(lss FilePVLastSignState) error
+	// Close releases the resources of the store.
+	Close() error
+}
+
+// FileSignStateStore is a SignStateStore keeping only the last sign state, in a
+// JSON file written atomically. This is the format of
+// priv_validator_state.json.
+type FileSignStateStore struct {
+	filePath string
+}
+
+var _ SignStateStore = (*FileSignStateStore)(nil)
+
+// NewFileSignStateStore returns a FileSignStateStore persisting the sign state
+// to filePath.
+func NewFileSignStateStore(filePath string) *FileSignStateStore {
+	return &FileSignStateStore{filePath: filePath}
+}
+
+// LoadSignState implements SignStateStore.
+func (s *FileSignStateStore) LoadSignState() (FilePVLastSignState, error) {
+	lss := FilePVLastSignState{filePath: s.filePath}
+	jsonBytes, err := os.ReadFile(s.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return lss, nil
+	} else if err != nil {
+		return lss, err
+	}
+	if err := cmtjson.Unmarshal(jsonBytes, &lss); err != nil {
+		return lss, fmt.Errorf("error reading PrivValidator state from %v: %w", s.filePath, err)
+	}
+	return lss, nil
+}
+
+// SaveSignState implements SignStateStore.
+func (s *FileSignStateStore) SaveSignState(lss FilePVLastSignState) error {
+	jsonBytes, err := cmtjson.MarshalIndent(lss, "", "  ")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(s.filePath, jsonBytes, 0o600)
+}
+
+// Close implements SignStateStore.
+func (*FileSignStateStore) Close() error {
+	return nil
+}
+
+// isBehind reports whether the height, round and step of lss are lower than
+// those of other.
+func (lss *FilePVLastSignState) isBehind(other FilePVLastSignState) bool {
+	switch {
+	case lss.Height != other.Height:
+		return lss.Height < other.Height
+	case lss.Round != other.Round:
+		return lss.Round < other.Round
+	default:
+		return lss.Step < other.Step
+	}
+}
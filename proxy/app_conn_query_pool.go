@@ -0,0 +1,110 @@
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+
+	abcitypes "github.com/cometbft/cometbft/v2/abci/types"
+)
+
+// QueryDispatch is how the calls are dispatched among the connections of a
+// query connection pool.
+type QueryDispatch string
+
+const (
+	// QueryDispatchRoundRobin sends the calls to each connection in turn.
+	QueryDispatchRoundRobin QueryDispatch = "round_robin"
+	// QueryDispatchLeastBusy sends each call to the connection with the
+	// fewest calls in progress.
+	QueryDispatchLeastBusy QueryDispatch = "least_busy"
+)
+
+// appConnQueryPool implements AppConnQuery by dispatching the calls among
+// several query connections, so that they are served concurrently.
+type appConnQueryPool struct {
+	metrics  *Metrics
+	conns    []AppConnQuery
+	dispatch QueryDispatch
+
+	next    atomic.Uint64  // number of calls dispatched
+	pending []atomic.Int64 // number of calls in progress on each connection
+}
+
+var _ AppConnQuery = (*appConnQueryPool)(nil)
+
+// NewAppConnQueryPool returns a query connection dispatching the calls among
+// conns. It panics if conns is empty.
+func NewAppConnQueryPool(conns []AppConnQuery, dispatch QueryDispatch, metrics *Metrics) AppConnQuery {
+	if len(conns) == 0 {
+		panic("query connection pool without connections")
+	}
+	return &appConnQueryPool{
+		metrics:  metrics,
+		conns:    conns,
+		dispatch: dispatch,
+		pending:  make([]atomic.Int64, len(conns)),
+	}
+}
+
+// Error returns the error of the first connection of the pool that failed.
+func (p *appConnQueryPool) Error() error {
+	for _, conn := range p.conns {
+		if err := conn.Error(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *appConnQueryPool) Echo(ctx context.Context, msg string) (*abcitypes.EchoResponse, error) {
+	conn, done := p.acquire()
+	defer done()
+	return conn.Echo(ctx, msg)
+}
+
+func (p *appConnQueryPool) Info(ctx context.Context, req *abcitypes.InfoRequest) (*abcitypes.InfoResponse, error) {
+	conn, done := p.acquire()
+	defer done()
+	return conn.Info(ctx, req)
+}
+
+func (p *appConnQueryPool) Query(ctx context.Context, req *abcitypes.QueryRequest) (*abcitypes.QueryResponse, error) {
+	conn, done := p.acquire()
+	defer done()
+	return conn.Query(ctx, req)
+}
+
+// acquire picks the connection to send a call on. The returned function must
+// be called once the call is done.
+func (p *appConnQueryPool) acquire() (AppConnQuery, func()) {
+	i := p.pick()
+	label := strconv.Itoa(i)
+	pending := p.metrics.QueryConnectionPending.With("connection", label)
+
+	p.pending[i].Add(1)
+	pending.Add(1)
+	p.metrics.QueryConnectionRequests.With("connection", label).Add(1)
+	return p.conns[i], func() {
+		p.pending[i].Add(-1)
+		pending.Add(-1)
+	}
+}
+
+// pick returns the index of the connection to send a call on.
+func (p *appConnQueryPool) pick() int {
+	// Start from the next connection in turn, so that ties are broken
+	// round-robin.
+	first := int((p.next.Add(1) - 1) % uint64(len(p.conns)))
+	if p.dispatch != QueryDispatchLeastBusy {
+		return first
+	}
+	best, bestPending := first, p.pending[first].Load()
+	for j := 1; j < len(p.conns) && bestPending > 0; j++ {
+		i := (first + j) % len(p.conns)
+		if pending := p.pending[i].Load(); pending < bestPending {
+			best, bestPending = i, pending
+		}
+	}
+	return best
+}
@@ -0,0 +1,110 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abcimocks "github.com/cometbft/cometbft/v2/abci/client/mocks"
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/proxy/mocks"
+)
+
+// newQueryConns returns n query connections responding to Query with their
+// index as the response code.
+func newQueryConns(n int) []*mocks.AppConnQuery {
+	conns := make([]*mocks.AppConnQuery, n)
+	for i := range conns {
+		conns[i] = &mocks.AppConnQuery{}
+		conns[i].On("Query", mock.Anything, mock.Anything).Return(&abci.QueryResponse{Code: uint32(i)}, nil)
+	}
+	return conns
+}
+
+func newQueryPool(conns []*mocks.AppConnQuery, dispatch QueryDispatch) AppConnQuery {
+	poolConns := make([]AppConnQuery, len(conns))
+	for i, conn := range conns {
+		poolConns[i] = conn
+	}
+	return NewAppConnQueryPool(poolConns, dispatch, NopMetrics())
+}
+
+func TestAppConnQueryPoolRoundRobin(t *testing.T) {
+	pool := newQueryPool(newQueryConns(3), QueryDispatchRoundRobin)
+
+	codes := make([]uint32, 0, 6)
+	for i := 0; i < 6; i++ {
+		res, err := pool.Query(context.Background(), &abci.QueryRequest{})
+		require.NoError(t, err)
+		codes = append(codes, res.Code)
+	}
+	assert.Equal(t, []uint32{0, 1, 2, 0, 1, 2}, codes)
+}
+
+func TestAppConnQueryPoolLeastBusy(t *testing.T) {
+	conns := newQueryConns(2)
+	// The first connection is stuck on a slow query.
+	slow := make(chan time.Time)
+	conns[0].ExpectedCalls = nil
+	conns[0].On("Query", mock.Anything, mock.Anything).WaitUntil(slow).Return(&abci.QueryResponse{Code: 0}, nil).Once()
+	conns[0].On("Query", mock.Anything, mock.Anything).Return(&abci.QueryResponse{Code: 0}, nil)
+	pool := newQueryPool(conns, QueryDispatchLeastBusy)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = pool.Query(context.Background(), &abci.QueryRequest{})
+	}()
+	require.Eventually(t, func() bool {
+		return pool.(*appConnQueryPool).pending[0].Load() == 1
+	}, time.Second, time.Millisecond)
+
+	// All the other queries go to the second connection.
+	for i := 0; i < 3; i++ {
+		res, err := pool.Query(context.Background(), &abci.QueryRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), res.Code)
+	}
+
+	close(slow)
+	<-done
+	assert.Zero(t, pool.(*appConnQueryPool).pending[0].Load())
+}
+
+func TestAppConnQueryPoolError(t *testing.T) {
+	conns := newQueryConns(2)
+	conns[0].On("Error").Return(nil)
+	conns[1].On("Error").Return(errors.New("EOF"))
+	pool := newQueryPool(conns, QueryDispatchRoundRobin)
+	require.EqualError(t, pool.Error(), "EOF")
+}
+
+func TestAppConns_QueryConnections(t *testing.T) {
+	quitCh := make(<-chan struct{})
+
+	clientCreatorMock := &mocks.ClientCreator{}
+
+	clientMock := &abcimocks.Client{}
+	clientMock.On("SetLogger", mock.Anything).Return()
+	clientMock.On("Start").Return(nil).Times(6)
+	clientMock.On("Stop").Return(nil).Times(6)
+	clientMock.On("Quit").Return(quitCh).Maybe()
+
+	clientCreatorMock.On("NewABCIQueryClient").Return(clientMock, nil).Times(3)
+	clientCreatorMock.On("NewABCIMempoolClient").Return(clientMock, nil).Once()
+	clientCreatorMock.On("NewABCISnapshotClient").Return(clientMock, nil).Once()
+	clientCreatorMock.On("NewABCIConsensusClient").Return(clientMock, nil).Once()
+
+	appConns := NewAppConns(clientCreatorMock, NopMetrics(), WithQueryConnections(3, QueryDispatchLeastBusy))
+	require.NoError(t, appConns.Start())
+	assert.IsType(t, &appConnQueryPool{}, appConns.Query())
+	require.NoError(t, appConns.Stop())
+
+	clientCreatorMock.AssertExpectations(t)
+	clientMock.AssertExpectations(t)
+}
@@ -1,6 +1,7 @@
 package proxy
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -41,3 +42,7 @@ func (e ErrABCIClientStart) Error() string {
 func (e ErrABCIClientStart) Unwrap() error {
 	return e.Err
 }
+
+// ErrReconnecting is returned by the calls made on a connection to the
+// application while it is being reestablished.
+var ErrReconnecting = errors.New("reconnecting to the ABCI application")
@@ -22,11 +22,32 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 			Buckets: []float64{.0001, .0004, .002, .009, .02, .1, .65, 2, 6, 25},
 		}, append(labels, "method", "type")).With(labelsAndValues...),
+		QueryConnectionRequests: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "query_connection_requests",
+			Help:      "Number of calls sent on each query connection.",
+		}, append(labels, "connection")).With(labelsAndValues...),
+		QueryConnectionPending: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "query_connection_pending",
+			Help:      "Number of calls in progress on each query connection.",
+		}, append(labels, "connection")).With(labelsAndValues...),
+		Reconnects: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "reconnects",
+			Help:      "Number of times each connection to the application was reestablished.",
+		}, append(labels, "connection")).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		MethodTimingSeconds: discard.NewHistogram(),
+		MethodTimingSeconds:     discard.NewHistogram(),
+		QueryConnectionRequests: discard.NewCounter(),
+		QueryConnectionPending:  discard.NewGauge(),
+		Reconnects:              discard.NewCounter(),
 	}
 }
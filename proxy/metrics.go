@@ -16,4 +16,13 @@ const (
 type Metrics struct {
 	// Timing for each ABCI method.
 	MethodTimingSeconds metrics.Histogram `metrics_bucketsizes:".0001,.0004,.002,.009,.02,.1,.65,2,6,25" metrics_labels:"method, type"`
+
+	// Number of calls sent on each query connection.
+	QueryConnectionRequests metrics.Counter `metrics_labels:"connection"`
+
+	// Number of calls in progress on each query connection.
+	QueryConnectionPending metrics.Gauge `metrics_labels:"connection"`
+
+	// Number of times each connection to the application was reestablished.
+	Reconnects metrics.Counter `metrics_labels:"connection"`
 }
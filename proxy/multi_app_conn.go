@@ -1,7 +1,11 @@
 package proxy
 
 import (
+	"context"
+	"time"
+
 	abcicli "github.com/cometbft/cometbft/v2/abci/client"
+	abcitypes "github.com/cometbft/cometbft/v2/abci/types"
 	cmtos "github.com/cometbft/cometbft/v2/internal/os"
 	cmtlog "github.com/cometbft/cometbft/v2/libs/log"
 	"github.com/cometbft/cometbft/v2/libs/service"
@@ -30,15 +34,15 @@ type AppConns interface {
 }
 
 // NewAppConns calls NewMultiAppConn.
-func NewAppConns(clientCreator ClientCreator, metrics *Metrics) AppConns {
-	return NewMultiAppConn(clientCreator, metrics)
+func NewAppConns(clientCreator ClientCreator, metrics *Metrics, options ...MultiAppConnOption) AppConns {
+	return NewMultiAppConn(clientCreator, metrics, options...)
 }
 
 // multiAppConn implements AppConns.
 //
 // A multiAppConn is made of a few appConns and manages their underlying abci
-// clients.
-// TODO: on app restart, clients must reboot together.
+// clients. Unless they reconnect (see WithReconnect), CometBFT is killed when
+// any of them fails.
 type multiAppConn struct {
 	service.BaseService
 
@@ -50,17 +54,78 @@ type multiAppConn struct {
 
 	consensusConnClient abcicli.Client
 	mempoolConnClient   abcicli.Client
-	queryConnClient     abcicli.Client
+	queryConnClients    []abcicli.Client
 	snapshotConnClient  abcicli.Client
 
 	clientCreator ClientCreator
+	recorder      *Recorder // nil if the calls are not recorded
+	queryConns    int
+	queryDispatch QueryDispatch
+	reconnect     *ReconnectConfig // nil if the clients do not reconnect
+}
+
+// ReconnectConfig configures how the connections to an out-of-process
+// application are reestablished when it goes away, e.g. when it is restarted.
+type ReconnectConfig struct {
+	// MaxBackoff is the maximum delay between two attempts to reconnect. The
+	// delay doubles after each failed attempt.
+	MaxBackoff time.Duration
+
+	// Handshake brings the restarted application back in sync with the
+	// committed blocks before the consensus connection is used again, like the
+	// consensus Handshaker does on start. It is given the Info response of the
+	// application, and conns whose consensus connection is the new one.
+	//
+	// If nil, the consensus connection does not reconnect.
+	Handshake func(ctx context.Context, info *abcitypes.InfoResponse, conns AppConns) error
+}
+
+// MultiAppConnOption sets an optional parameter on the multiAppConn.
+type MultiAppConnOption func(*multiAppConn)
+
+// WithRecorder records the calls made on all the connections to the
+// application with rec, which must be started before the connections are
+// used.
+func WithRecorder(rec *Recorder) MultiAppConnOption {
+	return func(app *multiAppConn) {
+		app.recorder = rec
+	}
+}
+
+// WithQueryConnections opens n query connections to the application, among
+// which the calls are dispatched as specified by dispatch, instead of one.
+func WithQueryConnections(n int, dispatch QueryDispatch) MultiAppConnOption {
+	return func(app *multiAppConn) {
+		app.queryConns = max(n, 1)
+		app.queryDispatch = dispatch
+	}
+}
+
+// WithReconnect makes the connections to the application reconnect, as
+// configured by cfg, instead of killing CometBFT when the application goes
+// away. The calls made on the query, mempool and snapshot connections fail with
+// ErrReconnecting until they are reestablished, while those made on the
+// consensus connection wait for it to be reestablished and for the application
+// to be brought back in sync by cfg.Handshake.
+//
+// The clients of the ClientCreator should fail to start when the application
+// is unreachable, for the attempts to be retried with backoff.
+func WithReconnect(cfg ReconnectConfig) MultiAppConnOption {
+	return func(app *multiAppConn) {
+		app.reconnect = &cfg
+	}
 }
 
 // NewMultiAppConn makes all necessary abci connections to the application.
-func NewMultiAppConn(clientCreator ClientCreator, metrics *Metrics) AppConns {
+func NewMultiAppConn(clientCreator ClientCreator, metrics *Metrics, options ...MultiAppConnOption) AppConns {
 	multiAppConn := &multiAppConn{
 		metrics:       metrics,
 		clientCreator: clientCreator,
+		queryConns:    1,
+		queryDispatch: QueryDispatchRoundRobin,
+	}
+	for _, option := range options {
+		option(multiAppConn)
 	}
 	multiAppConn.BaseService = *service.NewBaseService(nil, "multiAppConn", multiAppConn)
 	return multiAppConn
@@ -84,6 +149,7 @@ func (app *multiAppConn) Snapshot() AppConnSnapshot {
 
 func (app *multiAppConn) OnStart() error {
 	if err := app.startQueryClient(); err != nil {
+		app.stopAllClients()
 		return err
 	}
 	if err := app.startSnapshotClient(); err != nil {
@@ -106,46 +172,104 @@ func (app *multiAppConn) OnStart() error {
 }
 
 func (app *multiAppConn) startQueryClient() error {
-	c, err := app.clientCreator.NewABCIQueryClient()
-	if err != nil {
-		return ErrABCIClientCreate{ClientName: "query", Err: err}
+	conns := make([]AppConnQuery, 0, app.queryConns)
+	for i := 0; i < app.queryConns; i++ {
+		c, err := app.newClient(connQuery, app.clientCreator.NewABCIQueryClient, nil)
+		if err != nil {
+			return ErrABCIClientCreate{ClientName: "query", Err: err}
+		}
+		app.queryConnClients = append(app.queryConnClients, c)
+		conns = append(conns, NewAppConnQuery(c, app.metrics))
+		if err := app.startClient(c, "query"); err != nil {
+			return err
+		}
 	}
-	app.queryConnClient = c
-	app.queryConn = NewAppConnQuery(c, app.metrics)
-	return app.startClient(c, "query")
+	if len(conns) == 1 {
+		app.queryConn = conns[0]
+	} else {
+		app.queryConn = NewAppConnQueryPool(conns, app.queryDispatch, app.metrics)
+	}
+	if app.recorder != nil {
+		app.queryConn = NewRecordingAppConnQuery(app.queryConn, app.recorder)
+	}
+	return nil
 }
 
 func (app *multiAppConn) startSnapshotClient() error {
-	c, err := app.clientCreator.NewABCISnapshotClient()
+	c, err := app.newClient(connSnapshot, app.clientCreator.NewABCISnapshotClient, nil)
 	if err != nil {
 		return ErrABCIClientCreate{ClientName: "snapshot", Err: err}
 	}
 	app.snapshotConnClient = c
 	app.snapshotConn = NewAppConnSnapshot(c, app.metrics)
+	if app.recorder != nil {
+		app.snapshotConn = NewRecordingAppConnSnapshot(app.snapshotConn, app.recorder)
+	}
 	return app.startClient(c, "snapshot")
 }
 
 func (app *multiAppConn) startMempoolClient() error {
-	c, err := app.clientCreator.NewABCIMempoolClient()
+	c, err := app.newClient(connMempool, app.clientCreator.NewABCIMempoolClient, nil)
 	if err != nil {
 		return ErrABCIClientCreate{ClientName: "mempool", Err: err}
 	}
 	app.mempoolConnClient = c
 	app.mempoolConn = NewAppConnMempool(c, app.metrics)
+	if app.recorder != nil {
+		app.mempoolConn = NewRecordingAppConnMempool(app.mempoolConn, app.recorder)
+	}
 	return app.startClient(c, "mempool")
 }
 
 func (app *multiAppConn) startConsensusClient() error {
-	c, err := app.clientCreator.NewABCIConsensusClient()
+	var resync resyncFunc
+	if app.reconnect != nil && app.reconnect.Handshake != nil {
+		resync = app.handshake
+	}
+	c, err := app.newClient(connConsensus, app.clientCreator.NewABCIConsensusClient, resync)
 	if err != nil {
 		app.stopAllClients()
 		return ErrABCIClientCreate{ClientName: "consensus", Err: err}
 	}
 	app.consensusConnClient = c
 	app.consensusConn = NewAppConnConsensus(c, app.metrics)
+	if app.recorder != nil {
+		app.consensusConn = NewRecordingAppConnConsensus(app.consensusConn, app.recorder)
+	}
 	return app.startClient(c, "consensus")
 }
 
+// newClient makes a client for the conn connection with newClient, which
+// reconnects to the application, bringing it back in sync with resync if set,
+// if the clients reconnect.
+func (app *multiAppConn) newClient(conn string, newClient func() (abcicli.Client, error), resync resyncFunc) (abcicli.Client, error) {
+	if app.reconnect == nil {
+		return newClient()
+	}
+	return newReconnectingClient(conn, newClient, resync, app.reconnect.MaxBackoff, app.metrics), nil
+}
+
+// handshake runs the Handshake of the reconnect configuration on the new
+// consensus client c.
+func (app *multiAppConn) handshake(ctx context.Context, c abcicli.Client, info *abcitypes.InfoResponse) error {
+	conn := NewAppConnConsensus(c, app.metrics)
+	if app.recorder != nil {
+		conn = NewRecordingAppConnConsensus(conn, app.recorder)
+	}
+	return app.reconnect.Handshake(ctx, info, &handshakeAppConns{AppConns: app, consensus: conn})
+}
+
+// handshakeAppConns are the AppConns of a multiAppConn whose consensus
+// connection is being reestablished.
+type handshakeAppConns struct {
+	AppConns
+	consensus AppConnConsensus
+}
+
+func (conns *handshakeAppConns) Consensus() AppConnConsensus {
+	return conns.consensus
+}
+
 func (app *multiAppConn) startClient(c abcicli.Client, conn string) error {
 	c.SetLogger(app.Logger.With("module", "abci-client", "connection", conn))
 	if err := c.Start(); err != nil {
@@ -169,6 +293,15 @@ func (app *multiAppConn) killTMOnClientError() {
 		}
 	}
 
+	// Any of the query clients may fail.
+	queryQuit := make(chan abcicli.Client, len(app.queryConnClients))
+	for _, c := range app.queryConnClients {
+		go func(c abcicli.Client) {
+			<-c.Quit()
+			queryQuit <- c
+		}(c)
+	}
+
 	select {
 	case <-app.consensusConnClient.Quit():
 		if err := app.consensusConnClient.Error(); err != nil {
@@ -178,8 +311,8 @@ func (app *multiAppConn) killTMOnClientError() {
 		if err := app.mempoolConnClient.Error(); err != nil {
 			killFn(connMempool, err, app.Logger)
 		}
-	case <-app.queryConnClient.Quit():
-		if err := app.queryConnClient.Error(); err != nil {
+	case c := <-queryQuit:
+		if err := c.Error(); err != nil {
 			killFn(connQuery, err, app.Logger)
 		}
 	case <-app.snapshotConnClient.Quit():
@@ -200,8 +333,8 @@ func (app *multiAppConn) stopAllClients() {
 			app.Logger.Error("error while stopping mempool client", "error", err)
 		}
 	}
-	if app.queryConnClient != nil {
-		if err := app.queryConnClient.Stop(); err != nil {
+	for _, c := range app.queryConnClients {
+		if err := c.Stop(); err != nil {
 			app.Logger.Error("error while stopping query client", "error", err)
 		}
 	}
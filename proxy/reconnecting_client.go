@@ -0,0 +1,462 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abcicli "github.com/cometbft/cometbft/v2/abci/client"
+	abcitypes "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/libs/service"
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+)
+
+// reconnectMinBackoff is the delay before the second attempt to connect to
+// the application. It doubles after each failed attempt, up to the maximum
+// backoff.
+const reconnectMinBackoff = 500 * time.Millisecond
+
+// resyncFunc brings an application which went away back in sync with the
+// committed blocks, through the newly connected client c. info is the
+// response of the application to the Info call made on c.
+type resyncFunc func(ctx context.Context, c abcicli.Client, info *abcitypes.InfoResponse) error
+
+// reconnectingClient implements abcicli.Client on top of the clients made by
+// newClient, replacing the current one with a new one, with exponential
+// backoff, when the connection to the application is lost.
+//
+// While the application is being reconnected to, the calls fail with
+// ErrReconnecting, unless resync is set: the calls then wait for the
+// application to be back and brought in sync with resync, and are retried on
+// the new connection. This is how the consensus connection is reconnected: to
+// make retrying Commit safe, the last block finalized but not committed yet is
+// finalized again once the application is in sync.
+type reconnectingClient struct {
+	service.BaseService
+
+	conn       string
+	newClient  func() (abcicli.Client, error)
+	resync     resyncFunc // nil if the calls are not retried
+	maxBackoff time.Duration
+	metrics    *Metrics
+
+	ctx    context.Context // canceled when the client is stopped
+	cancel context.CancelFunc
+
+	mtx    cmtsync.Mutex
+	client abcicli.Client // nil while reconnecting
+	ready  chan struct{}  // closed once client is set
+	resCb  abcicli.Callback
+
+	// The last FinalizeBlock call not followed by Commit yet, and whether the
+	// application committed its block before going away.
+	pendingFinalize  *abcitypes.FinalizeBlockRequest
+	pendingCommitted bool
+}
+
+var _ abcicli.Client = (*reconnectingClient)(nil)
+
+func newReconnectingClient(
+	conn string,
+	newClient func() (abcicli.Client, error),
+	resync resyncFunc,
+	maxBackoff time.Duration,
+	metrics *Metrics,
+) *reconnectingClient {
+	ctx, cancel := context.WithCancel(context.Background())
+	cli := &reconnectingClient{
+		conn:       conn,
+		newClient:  newClient,
+		resync:     resync,
+		maxBackoff: max(maxBackoff, reconnectMinBackoff),
+		metrics:    metrics,
+		ctx:        ctx,
+		cancel:     cancel,
+		ready:      make(chan struct{}),
+	}
+	cli.BaseService = *service.NewBaseService(nil, "reconnectingClient", cli)
+	return cli
+}
+
+// OnStart connects to the application, retrying until it is reachable.
+func (cli *reconnectingClient) OnStart() error {
+	c, err := cli.connect(false)
+	if err != nil {
+		return err
+	}
+	cli.setClient(c)
+	return nil
+}
+
+func (cli *reconnectingClient) OnStop() {
+	cli.cancel()
+
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+	if cli.client != nil {
+		if err := cli.client.Stop(); err != nil {
+			cli.Logger.Error("Error stopping ABCI client", "err", err)
+		}
+	}
+}
+
+// connect makes a new client and starts it, bringing the application in sync
+// with resync if requested and set. It retries with exponential backoff until
+// it succeeds or the client is stopped.
+func (cli *reconnectingClient) connect(resync bool) (abcicli.Client, error) {
+	backoff := reconnectMinBackoff
+	for {
+		c, err := cli.tryConnect(resync)
+		if err == nil {
+			return c, nil
+		}
+		cli.Logger.Error("Failed to connect to the application", "err", err, "retry_in", backoff)
+
+		select {
+		case <-time.After(backoff):
+		case <-cli.Quit():
+			return nil, service.ErrAlreadyStopped
+		}
+		backoff = min(2*backoff, cli.maxBackoff)
+	}
+}
+
+func (cli *reconnectingClient) tryConnect(resync bool) (abcicli.Client, error) {
+	c, err := cli.newClient()
+	if err != nil {
+		return nil, err
+	}
+	c.SetLogger(cli.Logger)
+	if err := c.Start(); err != nil {
+		return nil, err
+	}
+	if resync && cli.resync != nil {
+		if err := cli.resyncApp(c); err != nil {
+			_ = c.Stop()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// resyncApp brings the application back in sync through c, and finalizes
+// again the block it lost if it went away between FinalizeBlock and Commit.
+func (cli *reconnectingClient) resyncApp(c abcicli.Client) error {
+	info, err := c.Info(cli.ctx, InfoRequest)
+	if err != nil {
+		return err
+	}
+
+	cli.mtx.Lock()
+	pending := cli.pendingFinalize
+	cli.mtx.Unlock()
+	if pending != nil && info.LastBlockHeight == pending.Height {
+		// The application went away after committing the block: only the
+		// response to Commit was lost.
+		cli.mtx.Lock()
+		cli.pendingCommitted = true
+		cli.mtx.Unlock()
+		return nil
+	}
+
+	if err := cli.resync(cli.ctx, c, info); err != nil {
+		return err
+	}
+	if pending != nil {
+		cli.Logger.Info("Finalizing the block again for Commit to be retried", "height", pending.Height)
+		if _, err := c.FinalizeBlock(cli.ctx, pending); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setClient makes c the current client and watches its connection.
+func (cli *reconnectingClient) setClient(c abcicli.Client) {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+
+	if !cli.IsRunning() {
+		_ = c.Stop()
+		return
+	}
+	if cli.resCb != nil {
+		c.SetResponseCallback(cli.resCb)
+	}
+	cli.client = c
+	close(cli.ready)
+	go cli.watch(c)
+}
+
+// watch replaces c with a new client once it stops.
+func (cli *reconnectingClient) watch(c abcicli.Client) {
+	select {
+	case <-c.Quit():
+	case <-cli.Quit():
+		return
+	}
+	if !cli.IsRunning() {
+		return
+	}
+	cli.detach(c)
+	cli.Logger.Error("Lost the connection to the application, reconnecting", "err", c.Error())
+
+	c, err := cli.connect(true)
+	if err != nil {
+		return // stopped
+	}
+	cli.metrics.Reconnects.With("connection", cli.conn).Add(1)
+	cli.Logger.Info("Reconnected to the application")
+	cli.setClient(c)
+}
+
+// detach stops using c, if it is the current client, for the calls to wait
+// for a new one.
+func (cli *reconnectingClient) detach(c abcicli.Client) {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+	if cli.client == c {
+		cli.client = nil
+		cli.ready = make(chan struct{})
+	}
+}
+
+// lost handles the connection of c being lost during a call: c is stopped,
+// which makes watch reconnect.
+func (cli *reconnectingClient) lost(c abcicli.Client) {
+	cli.detach(c)
+	if c.IsRunning() {
+		_ = c.Stop()
+	}
+}
+
+// get returns the current client. While reconnecting, it waits for the new
+// client if the calls are retried, and fails with ErrReconnecting otherwise.
+func (cli *reconnectingClient) get(ctx context.Context) (abcicli.Client, error) {
+	for {
+		cli.mtx.Lock()
+		c, ready := cli.client, cli.ready
+		cli.mtx.Unlock()
+		if c != nil {
+			return c, nil
+		}
+		if cli.resync == nil {
+			return nil, ErrReconnecting
+		}
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-cli.Quit():
+			return nil, service.ErrAlreadyStopped
+		}
+	}
+}
+
+// connectionLost reports whether the call to c which failed with err did so
+// because the connection to the application was lost.
+func connectionLost(c abcicli.Client, err error) bool {
+	return c.Error() != nil || !c.IsRunning() || status.Code(err) == codes.Unavailable
+}
+
+// call makes the call f on the current client, retrying it on the next client
+// if the calls are retried and the connection is lost.
+func call[T any](ctx context.Context, cli *reconnectingClient, f func(c abcicli.Client) (T, error)) (T, error) {
+	for {
+		c, err := cli.get(ctx)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		res, err := f(c)
+		if err == nil || !connectionLost(c, err) {
+			return res, err
+		}
+		cli.lost(c)
+		if cli.resync == nil {
+			return res, err
+		}
+		cli.Logger.Error("Lost the connection to the application during a call, retrying once reconnected", "err", err)
+	}
+}
+
+// Error returns ErrReconnecting while reconnecting to the application.
+func (cli *reconnectingClient) Error() error {
+	cli.mtx.Lock()
+	c := cli.client
+	cli.mtx.Unlock()
+	if c == nil {
+		if cli.IsRunning() {
+			return ErrReconnecting
+		}
+		return nil
+	}
+	return c.Error()
+}
+
+// SetResponseCallback sets the callback on the current client and on the
+// next ones.
+func (cli *reconnectingClient) SetResponseCallback(resCb abcicli.Callback) {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+	cli.resCb = resCb
+	if cli.client != nil {
+		cli.client.SetResponseCallback(resCb)
+	}
+}
+
+// CheckTxAsync never fails because of the connection to the application: the
+// requests which cannot be sent fail with abcicli.ErrRequestNotSent (see
+// ReqRes.Error), for the transactions to be submitted again later.
+func (cli *reconnectingClient) CheckTxAsync(ctx context.Context, req *abcitypes.CheckTxRequest) (*abcicli.ReqRes, error) {
+	c, err := cli.get(ctx)
+	if err != nil {
+		return notSentReqRes(req), nil
+	}
+	reqRes, err := c.CheckTxAsync(ctx, req)
+	if err != nil && connectionLost(c, err) {
+		cli.lost(c)
+		return notSentReqRes(req), nil
+	}
+	return reqRes, err
+}
+
+// notSentReqRes returns req failed because the application is being
+// reconnected to.
+func notSentReqRes(req *abcitypes.CheckTxRequest) *abcicli.ReqRes {
+	reqRes := abcicli.NewReqRes(abcitypes.ToCheckTxRequest(req))
+	reqRes.Fail(fmt.Errorf("%w: %w", abcicli.ErrRequestNotSent, ErrReconnecting))
+	return reqRes
+}
+
+// Flush succeeds when the connection to the application is lost, as the
+// requests it had pending are resolved without a response.
+func (cli *reconnectingClient) Flush(ctx context.Context) error {
+	c, err := cli.get(ctx)
+	if err != nil {
+		return nil
+	}
+	if err := c.Flush(ctx); err != nil {
+		if !connectionLost(c, err) {
+			return err
+		}
+		cli.lost(c)
+	}
+	return nil
+}
+
+func (cli *reconnectingClient) Echo(ctx context.Context, msg string) (*abcitypes.EchoResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.EchoResponse, error) {
+		return c.Echo(ctx, msg)
+	})
+}
+
+func (cli *reconnectingClient) Info(ctx context.Context, req *abcitypes.InfoRequest) (*abcitypes.InfoResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.InfoResponse, error) {
+		return c.Info(ctx, req)
+	})
+}
+
+func (cli *reconnectingClient) Query(ctx context.Context, req *abcitypes.QueryRequest) (*abcitypes.QueryResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.QueryResponse, error) {
+		return c.Query(ctx, req)
+	})
+}
+
+func (cli *reconnectingClient) CheckTx(ctx context.Context, req *abcitypes.CheckTxRequest) (*abcitypes.CheckTxResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.CheckTxResponse, error) {
+		return c.CheckTx(ctx, req)
+	})
+}
+
+func (cli *reconnectingClient) InitChain(ctx context.Context, req *abcitypes.InitChainRequest) (*abcitypes.InitChainResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.InitChainResponse, error) {
+		return c.InitChain(ctx, req)
+	})
+}
+
+func (cli *reconnectingClient) PrepareProposal(ctx context.Context, req *abcitypes.PrepareProposalRequest) (*abcitypes.PrepareProposalResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.PrepareProposalResponse, error) {
+		return c.PrepareProposal(ctx, req)
+	})
+}
+
+func (cli *reconnectingClient) ProcessProposal(ctx context.Context, req *abcitypes.ProcessProposalRequest) (*abcitypes.ProcessProposalResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.ProcessProposalResponse, error) {
+		return c.ProcessProposal(ctx, req)
+	})
+}
+
+func (cli *reconnectingClient) ExtendVote(ctx context.Context, req *abcitypes.ExtendVoteRequest) (*abcitypes.ExtendVoteResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.ExtendVoteResponse, error) {
+		return c.ExtendVote(ctx, req)
+	})
+}
+
+func (cli *reconnectingClient) VerifyVoteExtension(ctx context.Context, req *abcitypes.VerifyVoteExtensionRequest) (*abcitypes.VerifyVoteExtensionResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.VerifyVoteExtensionResponse, error) {
+		return c.VerifyVoteExtension(ctx, req)
+	})
+}
+
+func (cli *reconnectingClient) FinalizeBlock(ctx context.Context, req *abcitypes.FinalizeBlockRequest) (*abcitypes.FinalizeBlockResponse, error) {
+	res, err := call(ctx, cli, func(c abcicli.Client) (*abcitypes.FinalizeBlockResponse, error) {
+		return c.FinalizeBlock(ctx, req)
+	})
+	if err == nil {
+		cli.mtx.Lock()
+		cli.pendingFinalize = req
+		cli.pendingCommitted = false
+		cli.mtx.Unlock()
+	}
+	return res, err
+}
+
+func (cli *reconnectingClient) Commit(ctx context.Context, req *abcitypes.CommitRequest) (*abcitypes.CommitResponse, error) {
+	res, err := call(ctx, cli, func(c abcicli.Client) (*abcitypes.CommitResponse, error) {
+		cli.mtx.Lock()
+		committed := cli.pendingCommitted
+		cli.mtx.Unlock()
+		if committed {
+			// The response to the Commit of the previous connection was lost,
+			// and with it the retain height.
+			return &abcitypes.CommitResponse{}, nil
+		}
+		return c.Commit(ctx, req)
+	})
+	if err == nil {
+		cli.mtx.Lock()
+		cli.pendingFinalize = nil
+		cli.pendingCommitted = false
+		cli.mtx.Unlock()
+	}
+	return res, err
+}
+
+func (cli *reconnectingClient) ListSnapshots(ctx context.Context, req *abcitypes.ListSnapshotsRequest) (*abcitypes.ListSnapshotsResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.ListSnapshotsResponse, error) {
+		return c.ListSnapshots(ctx, req)
+	})
+}
+
+func (cli *reconnectingClient) OfferSnapshot(ctx context.Context, req *abcitypes.OfferSnapshotRequest) (*abcitypes.OfferSnapshotResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.OfferSnapshotResponse, error) {
+		return c.OfferSnapshot(ctx, req)
+	})
+}
+
+func (cli *reconnectingClient) LoadSnapshotChunk(ctx context.Context, req *abcitypes.LoadSnapshotChunkRequest) (*abcitypes.LoadSnapshotChunkResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.LoadSnapshotChunkResponse, error) {
+		return c.LoadSnapshotChunk(ctx, req)
+	})
+}
+
+func (cli *reconnectingClient) ApplySnapshotChunk(ctx context.Context, req *abcitypes.ApplySnapshotChunkRequest) (*abcitypes.ApplySnapshotChunkResponse, error) {
+	return call(ctx, cli, func(c abcicli.Client) (*abcitypes.ApplySnapshotChunkResponse, error) {
+		return c.ApplySnapshotChunk(ctx, req)
+	})
+}
@@ -0,0 +1,183 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/cometbft/cometbft/v2/abci/client"
+	"github.com/cometbft/cometbft/v2/abci/server"
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	cmtrand "github.com/cometbft/cometbft/v2/internal/rand"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	"github.com/cometbft/cometbft/v2/libs/service"
+)
+
+// restartableApp records the FinalizeBlock and Commit calls it receives.
+type restartableApp struct {
+	abci.BaseApplication
+
+	mtx       sync.Mutex
+	height    int64
+	finalized []int64
+	commits   int
+}
+
+func (app *restartableApp) Info(context.Context, *abci.InfoRequest) (*abci.InfoResponse, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	return &abci.InfoResponse{LastBlockHeight: app.height}, nil
+}
+
+func (app *restartableApp) FinalizeBlock(_ context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.finalized = append(app.finalized, req.Height)
+	return &abci.FinalizeBlockResponse{}, nil
+}
+
+func (app *restartableApp) Commit(context.Context, *abci.CommitRequest) (*abci.CommitResponse, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.height = app.finalized[len(app.finalized)-1]
+	app.commits++
+	return &abci.CommitResponse{RetainHeight: 1}, nil
+}
+
+func startAppServer(t *testing.T, addr string, app abci.Application) service.Service {
+	t.Helper()
+	s := server.NewSocketServer(addr, app)
+	s.SetLogger(log.TestingLogger().With("module", "abci-server"))
+	require.NoError(t, s.Start())
+	return s
+}
+
+// startReconnectingAppConns connects to the application at addr, reporting
+// the handshakes made on reconnecting to handshakes.
+func startReconnectingAppConns(t *testing.T, addr string, handshakes chan<- *abci.InfoResponse) AppConns {
+	t.Helper()
+	appConns := NewAppConns(NewRemoteClientCreator(addr, SOCKET, true), NopMetrics(),
+		WithReconnect(ReconnectConfig{
+			MaxBackoff: time.Second,
+			Handshake: func(_ context.Context, info *abci.InfoResponse, _ AppConns) error {
+				handshakes <- info
+				return nil
+			},
+		}))
+	appConns.SetLogger(log.TestingLogger())
+	require.NoError(t, appConns.Start())
+	t.Cleanup(func() { _ = appConns.Stop() })
+	return appConns
+}
+
+func TestAppConns_Reconnect(t *testing.T) {
+	addr := fmt.Sprintf("unix:///tmp/reconnect_%v.sock", cmtrand.Str(6))
+	s := startAppServer(t, addr, &restartableApp{})
+	handshakes := make(chan *abci.InfoResponse, 1)
+	appConns := startReconnectingAppConns(t, addr, handshakes)
+
+	_, err := appConns.Query().Echo(context.Background(), "hello")
+	require.NoError(t, err)
+
+	require.NoError(t, s.Stop())
+
+	// The query connection fails while the application is away.
+	require.Eventually(t, func() bool {
+		_, err := appConns.Query().Echo(context.Background(), "hello")
+		return err == ErrReconnecting
+	}, 5*time.Second, 10*time.Millisecond)
+	require.ErrorIs(t, appConns.Mempool().Error(), ErrReconnecting)
+
+	// The CheckTx requests which cannot be sent fail.
+	reqRes, err := appConns.Mempool().CheckTxAsync(context.Background(), &abci.CheckTxRequest{Tx: []byte("tx")})
+	require.NoError(t, err)
+	reqRes.Wait()
+	require.ErrorIs(t, reqRes.Error(), abcicli.ErrRequestNotSent)
+	require.ErrorIs(t, reqRes.Error(), ErrReconnecting)
+	require.Nil(t, reqRes.Response)
+
+	// The consensus connection waits for the application to be back.
+	done := make(chan error, 1)
+	go func() {
+		_, err := appConns.Consensus().PrepareProposal(context.Background(), &abci.PrepareProposalRequest{Height: 1})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		require.Fail(t, "consensus call returned while the application is away", "err", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	s = startAppServer(t, addr, &restartableApp{})
+	t.Cleanup(func() { _ = s.Stop() })
+
+	select {
+	case info := <-handshakes:
+		require.EqualValues(t, 0, info.LastBlockHeight)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no handshake on reconnecting")
+	}
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "consensus call not retried after reconnecting")
+	}
+
+	require.Eventually(t, func() bool {
+		_, err := appConns.Query().Echo(context.Background(), "hello")
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, appConns.Mempool().Error())
+}
+
+func TestAppConns_ReconnectBetweenFinalizeBlockAndCommit(t *testing.T) {
+	testCases := map[string]struct {
+		committed bool // whether the application committed the block before going away
+	}{
+		"block lost":      {committed: false},
+		"block committed": {committed: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			addr := fmt.Sprintf("unix:///tmp/reconnect_%v.sock", cmtrand.Str(6))
+			s := startAppServer(t, addr, &restartableApp{})
+			handshakes := make(chan *abci.InfoResponse, 1)
+			appConns := startReconnectingAppConns(t, addr, handshakes)
+
+			_, err := appConns.Consensus().FinalizeBlock(context.Background(), &abci.FinalizeBlockRequest{Height: 1})
+			require.NoError(t, err)
+			require.NoError(t, s.Stop())
+
+			restarted := &restartableApp{}
+			if tc.committed {
+				restarted.height = 1
+			}
+			s = startAppServer(t, addr, restarted)
+			t.Cleanup(func() { _ = s.Stop() })
+
+			res, err := appConns.Consensus().Commit(context.Background())
+			require.NoError(t, err)
+
+			restarted.mtx.Lock()
+			defer restarted.mtx.Unlock()
+			if tc.committed {
+				// Only the response to Commit was lost: the block is not
+				// finalized again and the application not resynced.
+				require.Empty(t, restarted.finalized)
+				require.Zero(t, restarted.commits)
+				require.Zero(t, res.RetainHeight)
+				require.Empty(t, handshakes)
+			} else {
+				require.Equal(t, []int64{1}, restarted.finalized)
+				require.Equal(t, 1, restarted.commits)
+				require.EqualValues(t, 1, res.RetainHeight)
+				require.Len(t, handshakes, 1)
+			}
+		})
+	}
+}
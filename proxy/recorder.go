@@ -0,0 +1,432 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	abcicli "github.com/cometbft/cometbft/v2/abci/client"
+	abcitypes "github.com/cometbft/cometbft/v2/abci/types"
+	auto "github.com/cometbft/cometbft/v2/internal/autofile"
+	"github.com/cometbft/cometbft/v2/internal/framing"
+	cmtos "github.com/cometbft/cometbft/v2/internal/os"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	"github.com/cometbft/cometbft/v2/libs/service"
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+	"github.com/cometbft/cometbft/v2/types"
+	cmttime "github.com/cometbft/cometbft/v2/types/time"
+)
+
+const (
+	// recorderFlushInterval is the interval at which the recording is flushed
+	// and synced to disk.
+	recorderFlushInterval = 2 * time.Second
+
+	// maxRecordedCallBytes is the maximum size of an encoded call. A call
+	// holds a request and its response, both of which are bounded by the size
+	// of a block in practice. It guards the decoder against allocating huge
+	// buffers when a length is corrupted.
+	maxRecordedCallBytes = 2*types.MaxBlockSizeBytes + 1<<20
+)
+
+// ErrCorruptRecording is returned when a call of a recording cannot be decoded.
+var ErrCorruptRecording = errors.New("corrupt ABCI recording")
+
+// Recorder writes the calls made to the application, with their responses, to
+// a rotating log, so that they can be replayed against a fresh instance of the
+// application to find where it diverges (see Replay). The oldest files of the
+// log are deleted once it reaches the total size limit of the group.
+//
+// The recording is flushed and synced to disk every 2s and once when stopped.
+type Recorder struct {
+	service.BaseService
+
+	group       *auto.Group
+	flushTicker *time.Ticker
+}
+
+// NewRecorder returns a recorder writing to file, rotated as configured by
+// groupOptions.
+func NewRecorder(file string, groupOptions ...func(*auto.Group)) (*Recorder, error) {
+	if err := cmtos.EnsureDir(filepath.Dir(file), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to ensure ABCI recording directory is in place: %w", err)
+	}
+	group, err := auto.OpenGroup(file, groupOptions...)
+	if err != nil {
+		return nil, err
+	}
+	rec := &Recorder{group: group}
+	rec.BaseService = *service.NewBaseService(nil, "ABCIRecorder", rec)
+	return rec, nil
+}
+
+func (rec *Recorder) SetLogger(l log.Logger) {
+	rec.BaseService.Logger = l
+	rec.group.SetLogger(l)
+}
+
+func (rec *Recorder) OnStart() error {
+	if err := rec.group.Start(); err != nil {
+		return err
+	}
+	rec.flushTicker = time.NewTicker(recorderFlushInterval)
+	go rec.processFlushTicks()
+	return nil
+}
+
+func (rec *Recorder) processFlushTicks() {
+	for {
+		select {
+		case <-rec.flushTicker.C:
+			if err := rec.FlushAndSync(); err != nil {
+				rec.Logger.Error("Periodic ABCI recording flush failed", "err", err)
+			}
+		case <-rec.Quit():
+			return
+		}
+	}
+}
+
+// FlushAndSync flushes and fsync's the recording to disk.
+func (rec *Recorder) FlushAndSync() error {
+	return rec.group.FlushAndSync()
+}
+
+func (rec *Recorder) OnStop() {
+	rec.flushTicker.Stop()
+	if err := rec.FlushAndSync(); err != nil {
+		rec.Logger.Error("Error flushing the ABCI recording", "err", err)
+	}
+	if err := rec.group.Stop(); err != nil {
+		rec.Logger.Error("Error stopping the ABCI recording", "err", err)
+	}
+	rec.group.Close()
+}
+
+// Wait waits for the recording to be closed.
+func (rec *Recorder) Wait() {
+	rec.group.Wait()
+}
+
+// Record appends call to the recording. It does not sync the recording to
+// disk.
+func (rec *Recorder) Record(call *abcitypes.RecordedCall) error {
+	msg, err := encodeRecordedCall(call)
+	if err != nil {
+		return err
+	}
+	// The group serializes writes, so calls are not interleaved.
+	_, err = rec.group.Write(msg)
+	return err
+}
+
+// record records a call made on conn at start. If the call failed, err is
+// recorded as an exception instead of res.
+func (rec *Recorder) record(conn string, start time.Time, req *abcitypes.Request, res *abcitypes.Response, err error) {
+	rec.recordCall(newRecordedCall(conn, start, req, res, err))
+}
+
+// recordCall records call. Recording errors are logged, not returned: they
+// must not fail the calls to the application.
+func (rec *Recorder) recordCall(call *abcitypes.RecordedCall) {
+	if err := rec.Record(call); err != nil {
+		rec.Logger.Error("Failed to record ABCI call", "connection", call.Connection, "err", err)
+	}
+}
+
+func newRecordedCall(conn string, start time.Time, req *abcitypes.Request, res *abcitypes.Response, err error) *abcitypes.RecordedCall {
+	if err != nil {
+		res = abcitypes.ToExceptionResponse(err.Error())
+	}
+	return &abcitypes.RecordedCall{
+		Time:       start,
+		Duration:   cmttime.Since(start),
+		Connection: conn,
+		Request:    req,
+		Response:   res,
+	}
+}
+
+// encodeRecordedCall encodes call as the consensus WAL encodes its messages:
+// 4 bytes CRC sum + 4 bytes length + the protobuf encoding of call.
+func encodeRecordedCall(call *abcitypes.RecordedCall) ([]byte, error) {
+	data, err := proto.Marshal(call)
+	if err != nil {
+		return nil, err
+	}
+	return framing.Encode(data, maxRecordedCallBytes)
+}
+
+// RecordingReader reads the calls of a recording, from the oldest to the
+// newest, across all the files of the group.
+type RecordingReader struct {
+	group *auto.Group
+	rd    *auto.GroupReader
+}
+
+// OpenRecording opens the recording written to file by a Recorder.
+func OpenRecording(file string) (*RecordingReader, error) {
+	if _, err := os.Stat(file); err != nil {
+		return nil, err
+	}
+	group, err := auto.OpenGroup(file)
+	if err != nil {
+		return nil, err
+	}
+	rd, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		group.Close()
+		return nil, err
+	}
+	return &RecordingReader{group: group, rd: rd}, nil
+}
+
+// Next returns the next call of the recording, or io.EOF once all the calls
+// were read. A call cut short, e.g. because the node crashed while writing it,
+// is reported as ErrCorruptRecording.
+func (r *RecordingReader) Next() (*abcitypes.RecordedCall, error) {
+	data, err := framing.Read(r.rd, maxRecordedCallBytes)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("%w: %v", ErrCorruptRecording, err)
+	}
+
+	call := new(abcitypes.RecordedCall)
+	if err := proto.Unmarshal(data, call); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode data: %v", ErrCorruptRecording, err)
+	}
+	return call, nil
+}
+
+// Close closes the recording.
+func (r *RecordingReader) Close() error {
+	err := r.rd.Close()
+	r.group.Close()
+	return err
+}
+
+// ------------------------------------------------
+// Recording wrappers of the connections to the application
+
+type recordingAppConnConsensus struct {
+	AppConnConsensus
+	rec *Recorder
+}
+
+// NewRecordingAppConnConsensus returns a connection recording the calls made
+// on conn with rec.
+func NewRecordingAppConnConsensus(conn AppConnConsensus, rec *Recorder) AppConnConsensus {
+	return &recordingAppConnConsensus{AppConnConsensus: conn, rec: rec}
+}
+
+func (app *recordingAppConnConsensus) InitChain(ctx context.Context, req *abcitypes.InitChainRequest) (*abcitypes.InitChainResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnConsensus.InitChain(ctx, req)
+	app.rec.record(connConsensus, start, abcitypes.ToInitChainRequest(req), abcitypes.ToInitChainResponse(res), err)
+	return res, err
+}
+
+func (app *recordingAppConnConsensus) PrepareProposal(ctx context.Context, req *abcitypes.PrepareProposalRequest) (*abcitypes.PrepareProposalResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnConsensus.PrepareProposal(ctx, req)
+	app.rec.record(connConsensus, start, abcitypes.ToPrepareProposalRequest(req), abcitypes.ToPrepareProposalResponse(res), err)
+	return res, err
+}
+
+func (app *recordingAppConnConsensus) ProcessProposal(ctx context.Context, req *abcitypes.ProcessProposalRequest) (*abcitypes.ProcessProposalResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnConsensus.ProcessProposal(ctx, req)
+	app.rec.record(connConsensus, start, abcitypes.ToProcessProposalRequest(req), abcitypes.ToProcessProposalResponse(res), err)
+	return res, err
+}
+
+func (app *recordingAppConnConsensus) ExtendVote(ctx context.Context, req *abcitypes.ExtendVoteRequest) (*abcitypes.ExtendVoteResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnConsensus.ExtendVote(ctx, req)
+	app.rec.record(connConsensus, start, abcitypes.ToExtendVoteRequest(req), abcitypes.ToExtendVoteResponse(res), err)
+	return res, err
+}
+
+func (app *recordingAppConnConsensus) VerifyVoteExtension(ctx context.Context, req *abcitypes.VerifyVoteExtensionRequest) (*abcitypes.VerifyVoteExtensionResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnConsensus.VerifyVoteExtension(ctx, req)
+	app.rec.record(connConsensus, start, abcitypes.ToVerifyVoteExtensionRequest(req), abcitypes.ToVerifyVoteExtensionResponse(res), err)
+	return res, err
+}
+
+func (app *recordingAppConnConsensus) FinalizeBlock(ctx context.Context, req *abcitypes.FinalizeBlockRequest) (*abcitypes.FinalizeBlockResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnConsensus.FinalizeBlock(ctx, req)
+	app.rec.record(connConsensus, start, abcitypes.ToFinalizeBlockRequest(req), abcitypes.ToFinalizeBlockResponse(res), err)
+	return res, err
+}
+
+func (app *recordingAppConnConsensus) Commit(ctx context.Context) (*abcitypes.CommitResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnConsensus.Commit(ctx)
+	app.rec.record(connConsensus, start, abcitypes.ToCommitRequest(), abcitypes.ToCommitResponse(res), err)
+	return res, err
+}
+
+// recordingAppConnMempool records the calls in the order they are made, which
+// replaying them relies on, even though the responses to CheckTxAsync may
+// come in a different order: each call is given a sequence number when it is
+// made, and the calls are recorded in the order of their sequence numbers.
+type recordingAppConnMempool struct {
+	AppConnMempool
+	rec *Recorder
+
+	mtx     cmtsync.Mutex
+	nextSeq uint64                             // sequence number of the next call made
+	recSeq  uint64                             // sequence number of the next call recorded
+	pending map[uint64]*abcitypes.RecordedCall // calls ended but not recorded yet
+}
+
+// NewRecordingAppConnMempool returns a connection recording the calls made on
+// conn with rec.
+func NewRecordingAppConnMempool(conn AppConnMempool, rec *Recorder) AppConnMempool {
+	return &recordingAppConnMempool{
+		AppConnMempool: conn,
+		rec:            rec,
+		pending:        make(map[uint64]*abcitypes.RecordedCall),
+	}
+}
+
+// begin returns the sequence number of a new call.
+func (app *recordingAppConnMempool) begin() uint64 {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	seq := app.nextSeq
+	app.nextSeq++
+	return seq
+}
+
+// end records the call with sequence number seq, after the calls made before
+// it. If some of them did not end yet, it is recorded by the last one ending.
+func (app *recordingAppConnMempool) end(seq uint64, call *abcitypes.RecordedCall) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.pending[seq] = call
+	for {
+		call, ok := app.pending[app.recSeq]
+		if !ok {
+			return
+		}
+		delete(app.pending, app.recSeq)
+		app.recSeq++
+		app.rec.recordCall(call)
+	}
+}
+
+func (app *recordingAppConnMempool) Flush(ctx context.Context) error {
+	seq, start := app.begin(), cmttime.Now()
+	err := app.AppConnMempool.Flush(ctx)
+	app.end(seq, newRecordedCall(connMempool, start, abcitypes.ToFlushRequest(), abcitypes.ToFlushResponse(), err))
+	return err
+}
+
+func (app *recordingAppConnMempool) CheckTx(ctx context.Context, req *abcitypes.CheckTxRequest) (*abcitypes.CheckTxResponse, error) {
+	seq, start := app.begin(), cmttime.Now()
+	res, err := app.AppConnMempool.CheckTx(ctx, req)
+	app.end(seq, newRecordedCall(connMempool, start, abcitypes.ToCheckTxRequest(req), abcitypes.ToCheckTxResponse(res), err))
+	return res, err
+}
+
+// CheckTxAsync records the call once the application responded, without
+// blocking the caller.
+func (app *recordingAppConnMempool) CheckTxAsync(ctx context.Context, req *abcitypes.CheckTxRequest) (*abcicli.ReqRes, error) {
+	seq, start := app.begin(), cmttime.Now()
+	reqRes, err := app.AppConnMempool.CheckTxAsync(ctx, req)
+	if err != nil {
+		app.end(seq, newRecordedCall(connMempool, start, abcitypes.ToCheckTxRequest(req), nil, err))
+		return nil, err
+	}
+	go func() {
+		reqRes.Wait()
+		app.end(seq, newRecordedCall(connMempool, start, reqRes.Request, reqRes.Response, nil))
+	}()
+	return reqRes, nil
+}
+
+type recordingAppConnQuery struct {
+	AppConnQuery
+	rec *Recorder
+}
+
+// NewRecordingAppConnQuery returns a connection recording the calls made on
+// conn with rec.
+func NewRecordingAppConnQuery(conn AppConnQuery, rec *Recorder) AppConnQuery {
+	return &recordingAppConnQuery{AppConnQuery: conn, rec: rec}
+}
+
+func (app *recordingAppConnQuery) Echo(ctx context.Context, msg string) (*abcitypes.EchoResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnQuery.Echo(ctx, msg)
+	var recRes *abcitypes.Response
+	if err == nil {
+		recRes = abcitypes.ToEchoResponse(res.Message)
+	}
+	app.rec.record(connQuery, start, abcitypes.ToEchoRequest(msg), recRes, err)
+	return res, err
+}
+
+func (app *recordingAppConnQuery) Info(ctx context.Context, req *abcitypes.InfoRequest) (*abcitypes.InfoResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnQuery.Info(ctx, req)
+	app.rec.record(connQuery, start, abcitypes.ToInfoRequest(req), abcitypes.ToInfoResponse(res), err)
+	return res, err
+}
+
+func (app *recordingAppConnQuery) Query(ctx context.Context, req *abcitypes.QueryRequest) (*abcitypes.QueryResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnQuery.Query(ctx, req)
+	app.rec.record(connQuery, start, abcitypes.ToQueryRequest(req), abcitypes.ToQueryResponse(res), err)
+	return res, err
+}
+
+type recordingAppConnSnapshot struct {
+	AppConnSnapshot
+	rec *Recorder
+}
+
+// NewRecordingAppConnSnapshot returns a connection recording the calls made on
+// conn with rec.
+func NewRecordingAppConnSnapshot(conn AppConnSnapshot, rec *Recorder) AppConnSnapshot {
+	return &recordingAppConnSnapshot{AppConnSnapshot: conn, rec: rec}
+}
+
+func (app *recordingAppConnSnapshot) ListSnapshots(ctx context.Context, req *abcitypes.ListSnapshotsRequest) (*abcitypes.ListSnapshotsResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnSnapshot.ListSnapshots(ctx, req)
+	app.rec.record(connSnapshot, start, abcitypes.ToListSnapshotsRequest(req), abcitypes.ToListSnapshotsResponse(res), err)
+	return res, err
+}
+
+func (app *recordingAppConnSnapshot) OfferSnapshot(ctx context.Context, req *abcitypes.OfferSnapshotRequest) (*abcitypes.OfferSnapshotResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnSnapshot.OfferSnapshot(ctx, req)
+	app.rec.record(connSnapshot, start, abcitypes.ToOfferSnapshotRequest(req), abcitypes.ToOfferSnapshotResponse(res), err)
+	return res, err
+}
+
+func (app *recordingAppConnSnapshot) LoadSnapshotChunk(ctx context.Context, req *abcitypes.LoadSnapshotChunkRequest) (*abcitypes.LoadSnapshotChunkResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnSnapshot.LoadSnapshotChunk(ctx, req)
+	app.rec.record(connSnapshot, start, abcitypes.ToLoadSnapshotChunkRequest(req), abcitypes.ToLoadSnapshotChunkResponse(res), err)
+	return res, err
+}
+
+func (app *recordingAppConnSnapshot) ApplySnapshotChunk(ctx context.Context, req *abcitypes.ApplySnapshotChunkRequest) (*abcitypes.ApplySnapshotChunkResponse, error) {
+	start := cmttime.Now()
+	res, err := app.AppConnSnapshot.ApplySnapshotChunk(ctx, req)
+	app.rec.record(connSnapshot, start, abcitypes.ToApplySnapshotChunkRequest(req), abcitypes.ToApplySnapshotChunkResponse(res), err)
+	return res, err
+}
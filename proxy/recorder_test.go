@@ -0,0 +1,188 @@
+package proxy
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/cometbft/cometbft/v2/abci/client"
+	"github.com/cometbft/cometbft/v2/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
+	"github.com/cometbft/cometbft/v2/proxy/mocks"
+)
+
+// record makes a few calls to a kvstore application through connections
+// recorded to file.
+func record(t *testing.T, file string) {
+	t.Helper()
+	ctx := context.Background()
+
+	rec, err := NewRecorder(file)
+	require.NoError(t, err)
+	require.NoError(t, rec.Start())
+
+	appConns := NewAppConns(NewLocalClientCreator(kvstore.NewInMemoryApplication()), NopMetrics(), WithRecorder(rec))
+	require.NoError(t, appConns.Start())
+
+	_, err = appConns.Query().Info(ctx, InfoRequest)
+	require.NoError(t, err)
+	_, err = appConns.Consensus().InitChain(ctx, &abci.InitChainRequest{ChainId: "test-chain"})
+	require.NoError(t, err)
+	_, err = appConns.Mempool().CheckTx(ctx, &abci.CheckTxRequest{Tx: []byte("a=1"), Type: abci.CHECK_TX_TYPE_CHECK})
+	require.NoError(t, err)
+	_, err = appConns.Consensus().FinalizeBlock(ctx, &abci.FinalizeBlockRequest{Height: 1, Txs: [][]byte{[]byte("a=1")}})
+	require.NoError(t, err)
+	_, err = appConns.Consensus().Commit(ctx)
+	require.NoError(t, err)
+	_, err = appConns.Query().Query(ctx, &abci.QueryRequest{Data: []byte("a")})
+	require.NoError(t, err)
+
+	require.NoError(t, appConns.Stop())
+	require.NoError(t, rec.Stop())
+	rec.Wait()
+}
+
+func newKVStoreClient(t *testing.T) abcicli.Client {
+	t.Helper()
+	client := abcicli.NewLocalClient(new(cmtsync.Mutex), kvstore.NewInMemoryApplication())
+	require.NoError(t, client.Start())
+	t.Cleanup(func() { _ = client.Stop() })
+	return client
+}
+
+func replay(t *testing.T, file string, client abcicli.Client, connections []string) (int, *Divergence) {
+	t.Helper()
+	rd, err := OpenRecording(file)
+	require.NoError(t, err)
+	defer rd.Close()
+	replayed, div, err := Replay(context.Background(), rd, client, connections)
+	require.NoError(t, err)
+	return replayed, div
+}
+
+func TestRecorder(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "abci", "recording")
+	record(t, file)
+
+	rd, err := OpenRecording(file)
+	require.NoError(t, err)
+	defer rd.Close()
+	var (
+		connections []string
+		methods     []string
+	)
+	for {
+		call, err := rd.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.NotNil(t, call.Response)
+		assert.Nil(t, call.Response.GetException())
+		assert.False(t, call.Time.IsZero())
+		connections = append(connections, call.Connection)
+		methods = append(methods, requestName(call.Request))
+	}
+	assert.Equal(t, []string{connQuery, connConsensus, connMempool, connConsensus, connConsensus, connQuery}, connections)
+	assert.Equal(t, []string{"Info", "InitChain", "CheckTx", "FinalizeBlock", "Commit", "Query"}, methods)
+
+	// A recording cut short is corrupt.
+	stat, err := os.Stat(file)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(file, stat.Size()-1))
+	rd, err = OpenRecording(file)
+	require.NoError(t, err)
+	defer rd.Close()
+	for err == nil {
+		_, err = rd.Next()
+	}
+	require.ErrorIs(t, err, ErrCorruptRecording)
+
+	_, err = OpenRecording(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
+
+func TestRecorderMempoolCallOrder(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "recording")
+	rec, err := NewRecorder(file)
+	require.NoError(t, err)
+	require.NoError(t, rec.Start())
+
+	txs := [][]byte{[]byte("a=1"), []byte("b=2"), []byte("c=3")}
+	reqResps := make([]*abcicli.ReqRes, len(txs))
+	conn := &mocks.AppConnMempool{}
+	for i, tx := range txs {
+		req := &abci.CheckTxRequest{Tx: tx, Type: abci.CHECK_TX_TYPE_CHECK}
+		reqResps[i] = abcicli.NewReqRes(abci.ToCheckTxRequest(req))
+		conn.On("CheckTxAsync", mock.Anything, req).Return(reqResps[i], nil)
+	}
+	recConn := NewRecordingAppConnMempool(conn, rec)
+	for _, tx := range txs {
+		_, err := recConn.CheckTxAsync(context.Background(), &abci.CheckTxRequest{Tx: tx, Type: abci.CHECK_TX_TYPE_CHECK})
+		require.NoError(t, err)
+	}
+
+	// The application responds in the reverse order of the calls.
+	for i := len(reqResps) - 1; i >= 0; i-- {
+		reqResps[i].Response = abci.ToCheckTxResponse(&abci.CheckTxResponse{Code: abci.CodeTypeOK})
+		reqResps[i].Done()
+	}
+	require.Eventually(t, func() bool {
+		app := recConn.(*recordingAppConnMempool)
+		app.mtx.Lock()
+		defer app.mtx.Unlock()
+		return app.recSeq == uint64(len(txs))
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, rec.Stop())
+	rec.Wait()
+
+	// The calls are recorded in the order they were made.
+	rd, err := OpenRecording(file)
+	require.NoError(t, err)
+	defer rd.Close()
+	for _, tx := range txs {
+		call, err := rd.Next()
+		require.NoError(t, err)
+		assert.Equal(t, tx, call.Request.GetCheckTx().Tx)
+	}
+	_, err = rd.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestReplay(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "recording")
+	record(t, file)
+
+	// A fresh application responds as the recorded one.
+	replayed, div := replay(t, file, newKVStoreClient(t), DefaultReplayedConnections)
+	assert.Equal(t, 3, replayed)
+	assert.Nil(t, div)
+
+	replayed, div = replay(t, file, newKVStoreClient(t), []string{connConsensus, connMempool, connQuery, connSnapshot})
+	assert.Equal(t, 6, replayed)
+	assert.Nil(t, div)
+
+	// An application in a different state diverges, as soon as it reports its
+	// app hash.
+	client := newKVStoreClient(t)
+	_, err := client.FinalizeBlock(context.Background(), &abci.FinalizeBlockRequest{Height: 1, Txs: [][]byte{[]byte("b=2")}})
+	require.NoError(t, err)
+	_, err = client.Commit(context.Background(), &abci.CommitRequest{})
+	require.NoError(t, err)
+
+	replayed, div = replay(t, file, client, DefaultReplayedConnections)
+	require.NotNil(t, div)
+	assert.Equal(t, 1, replayed)
+	assert.Equal(t, 1, div.Index)
+	assert.Equal(t, "InitChain", requestName(div.Call.Request))
+	assert.NotEqual(t, div.Call.Response.GetInitChain().AppHash, div.Response.GetInitChain().AppHash)
+	assert.Contains(t, div.String(), "call #1 (InitChain on the consensus connection")
+}
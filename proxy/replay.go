@@ -0,0 +1,140 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	abcicli "github.com/cometbft/cometbft/v2/abci/client"
+	abcitypes "github.com/cometbft/cometbft/v2/abci/types"
+)
+
+// DefaultReplayedConnections are the connections whose calls are replayed by
+// default: those changing the state of the application.
+var DefaultReplayedConnections = []string{connConsensus, connSnapshot}
+
+// Divergence is the first call of a recording to which the replayed
+// application responded differently.
+type Divergence struct {
+	Index    int                     // index of the call in the recording
+	Call     *abcitypes.RecordedCall // call as recorded
+	Response *abcitypes.Response     // response of the replayed application
+}
+
+func (d *Divergence) String() string {
+	return fmt.Sprintf("call #%d (%s on the %s connection at %v) diverged:\n  recorded: %v\n  replayed: %v",
+		d.Index, requestName(d.Call.Request), d.Call.Connection, d.Call.Time, d.Call.Response, d.Response)
+}
+
+// Replay sends the calls of the recording made on the given connections, in
+// the order they were recorded, to the application behind client, which is
+// expected to be in the state the recorded application was in when the
+// recording started. It returns the number of calls replayed and the first
+// call to which the application responded differently, or nil if there was
+// none. Calls that failed are recorded as exceptions, and match if the
+// replayed call fails with the same error.
+//
+// Replay stops at the first divergence, or when the application stops
+// responding.
+func Replay(ctx context.Context, rec *RecordingReader, client abcicli.Client, connections []string) (int, *Divergence, error) {
+	replayed := 0
+	for index := 0; ; index++ {
+		call, err := rec.Next()
+		if errors.Is(err, io.EOF) {
+			return replayed, nil, nil
+		}
+		if err != nil {
+			return replayed, nil, fmt.Errorf("reading call #%d: %w", index, err)
+		}
+		if !slices.Contains(connections, call.Connection) {
+			continue
+		}
+
+		res, err := replayCall(ctx, client, call.Request)
+		if err != nil {
+			if clientErr := client.Error(); clientErr != nil {
+				return replayed, nil, fmt.Errorf("replaying call #%d: %w", index, clientErr)
+			}
+			res = abcitypes.ToExceptionResponse(err.Error())
+		}
+		replayed++
+		if !proto.Equal(res, call.Response) {
+			return replayed, &Divergence{Index: index, Call: call, Response: res}, nil
+		}
+	}
+}
+
+// replayCall sends req to the application.
+func replayCall(ctx context.Context, client abcicli.Client, req *abcitypes.Request) (*abcitypes.Response, error) {
+	switch r := req.Value.(type) {
+	case *abcitypes.Request_Echo:
+		res, err := client.Echo(ctx, r.Echo.Message)
+		if err != nil {
+			return nil, err
+		}
+		return abcitypes.ToEchoResponse(res.Message), nil
+	case *abcitypes.Request_Flush:
+		if err := client.Flush(ctx); err != nil {
+			return nil, err
+		}
+		return abcitypes.ToFlushResponse(), nil
+	case *abcitypes.Request_Info:
+		res, err := client.Info(ctx, r.Info)
+		return abcitypes.ToInfoResponse(res), err
+	case *abcitypes.Request_InitChain:
+		res, err := client.InitChain(ctx, r.InitChain)
+		return abcitypes.ToInitChainResponse(res), err
+	case *abcitypes.Request_Query:
+		res, err := client.Query(ctx, r.Query)
+		return abcitypes.ToQueryResponse(res), err
+	case *abcitypes.Request_CheckTx:
+		res, err := client.CheckTx(ctx, r.CheckTx)
+		return abcitypes.ToCheckTxResponse(res), err
+	case *abcitypes.Request_Commit:
+		res, err := client.Commit(ctx, r.Commit)
+		return abcitypes.ToCommitResponse(res), err
+	case *abcitypes.Request_ListSnapshots:
+		res, err := client.ListSnapshots(ctx, r.ListSnapshots)
+		return abcitypes.ToListSnapshotsResponse(res), err
+	case *abcitypes.Request_OfferSnapshot:
+		res, err := client.OfferSnapshot(ctx, r.OfferSnapshot)
+		return abcitypes.ToOfferSnapshotResponse(res), err
+	case *abcitypes.Request_LoadSnapshotChunk:
+		res, err := client.LoadSnapshotChunk(ctx, r.LoadSnapshotChunk)
+		return abcitypes.ToLoadSnapshotChunkResponse(res), err
+	case *abcitypes.Request_ApplySnapshotChunk:
+		res, err := client.ApplySnapshotChunk(ctx, r.ApplySnapshotChunk)
+		return abcitypes.ToApplySnapshotChunkResponse(res), err
+	case *abcitypes.Request_PrepareProposal:
+		res, err := client.PrepareProposal(ctx, r.PrepareProposal)
+		return abcitypes.ToPrepareProposalResponse(res), err
+	case *abcitypes.Request_ProcessProposal:
+		res, err := client.ProcessProposal(ctx, r.ProcessProposal)
+		return abcitypes.ToProcessProposalResponse(res), err
+	case *abcitypes.Request_ExtendVote:
+		res, err := client.ExtendVote(ctx, r.ExtendVote)
+		return abcitypes.ToExtendVoteResponse(res), err
+	case *abcitypes.Request_VerifyVoteExtension:
+		res, err := client.VerifyVoteExtension(ctx, r.VerifyVoteExtension)
+		return abcitypes.ToVerifyVoteExtensionResponse(res), err
+	case *abcitypes.Request_FinalizeBlock:
+		res, err := client.FinalizeBlock(ctx, r.FinalizeBlock)
+		return abcitypes.ToFinalizeBlockResponse(res), err
+	default:
+		return nil, fmt.Errorf("unknown request type %T", req.Value)
+	}
+}
+
+// requestName returns the name of the ABCI method called by req.
+func requestName(req *abcitypes.Request) string {
+	if req == nil || req.Value == nil {
+		return "unknown"
+	}
+	return strings.TrimPrefix(reflect.TypeOf(req.Value).Elem().Name(), "Request_")
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/cometbft/cometbft/v2/internal/test"
 	"github.com/cometbft/cometbft/v2/privval"
 	"github.com/cometbft/cometbft/v2/rpc/client"
+	ctypes "github.com/cometbft/cometbft/v2/rpc/core/types"
 	rpctest "github.com/cometbft/cometbft/v2/rpc/test"
 	"github.com/cometbft/cometbft/v2/types"
 )
@@ -127,11 +128,15 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 		correct, fakes := makeEvidences(t, pv, chainID, ts)
 		t.Logf("client %d", i)
 
+		status, err := c.Status(context.Background())
+		require.NoError(t, err)
+		broadcastHeight := status.SyncInfo.LatestBlockHeight
+
 		result, err := c.BroadcastEvidence(context.Background(), correct)
 		require.NoError(t, err, "BroadcastEvidence(%s) failed", correct)
 		assert.Equal(t, correct.Hash(), result.Hash, "expected result hash to match evidence hash")
 
-		status, err := c.Status(context.Background())
+		status, err = c.Status(context.Background())
 		require.NoError(t, err)
 		err = client.WaitForHeight(c, status.SyncInfo.LatestBlockHeight+2, nil)
 		require.NoError(t, err)
@@ -151,6 +156,25 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 		require.EqualValues(t, ed25519.KeyType, v.PubKeyType, "Stored PubKeyType not equal with expected, value %v", string(qres.Value))
 		require.Equal(t, int64(9), v.Power, "Stored Power not equal with expected, value %v", string(qres.Value))
 
+		// Blocks are produced quickly: look for the evidence in all the blocks
+		// committed since it was broadcast, 20 at a time.
+		status, err = c.Status(context.Background())
+		require.NoError(t, err)
+		found := false
+		for minHeight := broadcastHeight; !found && minHeight <= status.SyncInfo.LatestBlockHeight; minHeight += 20 {
+			committed, err := c.CommittedEvidence(context.Background(), minHeight, minHeight+19)
+			require.NoError(t, err)
+			found = containsEvidence(committed, correct)
+		}
+		assert.True(t, found, "expected evidence to be committed")
+
+		pending, err := c.PendingEvidence(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, pending.Total, pending.Count)
+		for _, ev := range pending.Evidence {
+			assert.NotEqual(t, correct.Hash(), ev.Hash(), "expected committed evidence not to be pending")
+		}
+
 		for _, fake := range fakes {
 			_, err := c.BroadcastEvidence(context.Background(), fake)
 			require.Error(t, err, "BroadcastEvidence(%s) succeeded, but the evidence was fake", fake)
@@ -158,6 +182,15 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 	}
 }
 
+func containsEvidence(res *ctypes.ResultCommittedEvidence, ev types.Evidence) bool {
+	for _, committed := range res.Evidence {
+		if bytes.Equal(committed.Evidence.Hash(), ev.Hash()) {
+			return true
+		}
+	}
+	return false
+}
+
 func TestBroadcastEmptyEvidence(t *testing.T) {
 	for _, c := range GetClients() {
 		_, err := c.BroadcastEvidence(context.Background(), nil)
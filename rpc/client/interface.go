@@ -138,12 +138,15 @@ type MempoolClient interface {
 	UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error)
 	NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirmedTxs, error)
 	CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error)
+	TxStatus(ctx context.Context, hash []byte) (*ctypes.ResultTxStatus, error)
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
-// behavior.
+// behavior, and for querying the pending and committed evidence.
 type EvidenceClient interface {
 	BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error)
+	PendingEvidence(ctx context.Context, limit *int) (*ctypes.ResultPendingEvidence, error)
+	CommittedEvidence(ctx context.Context, minHeight, maxHeight int64) (*ctypes.ResultCommittedEvidence, error)
 }
 
 // RemoteClient is a Client, which can also return the remote network address.
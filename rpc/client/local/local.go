@@ -114,6 +114,10 @@ func (c *Local) UnconfirmedTx(_ context.Context, hash []byte) (*ctypes.ResultUnc
 	return c.env.UnconfirmedTx(c.ctx, hash)
 }
 
+func (c *Local) TxStatus(_ context.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	return c.env.TxStatus(c.ctx, hash)
+}
+
 func (c *Local) UnconfirmedTxs(_ context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error) {
 	return c.env.UnconfirmedTxs(c.ctx, limit)
 }
@@ -228,6 +232,17 @@ func (c *Local) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes
 	return c.env.BroadcastEvidence(c.ctx, ev)
 }
 
+func (c *Local) PendingEvidence(_ context.Context, limit *int) (*ctypes.ResultPendingEvidence, error) {
+	return c.env.PendingEvidence(c.ctx, limit)
+}
+
+func (c *Local) CommittedEvidence(
+	_ context.Context,
+	minHeight, maxHeight int64,
+) (*ctypes.ResultCommittedEvidence, error) {
+	return c.env.CommittedEvidence(c.ctx, minHeight, maxHeight)
+}
+
 func (c *Local) Subscribe(
 	ctx context.Context,
 	subscriber,
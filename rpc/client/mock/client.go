@@ -181,3 +181,11 @@ func (c Client) Validators(_ context.Context, height *int64, page, perPage *int)
 func (c Client) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return c.env.BroadcastEvidence(&rpctypes.Context{}, ev)
 }
+
+func (c Client) PendingEvidence(_ context.Context, limit *int) (*ctypes.ResultPendingEvidence, error) {
+	return c.env.PendingEvidence(&rpctypes.Context{}, limit)
+}
+
+func (c Client) CommittedEvidence(_ context.Context, minHeight, maxHeight int64) (*ctypes.ResultCommittedEvidence, error) {
+	return c.env.CommittedEvidence(&rpctypes.Context{}, minHeight, maxHeight)
+}
@@ -394,6 +394,33 @@ func TestUnconfirmedTx(t *testing.T) {
 	mempool.Flush()
 }
 
+func TestTxStatus(t *testing.T) {
+	for i, c := range GetClients() {
+		_, _, tx := MakeTxKV()
+		bres, err := c.BroadcastTxCommit(context.Background(), tx)
+		require.NoError(t, err, "%d: %+v", i, err)
+
+		mc := c.(client.MempoolClient)
+		res, err := mc.TxStatus(context.Background(), types.Tx(tx).Hash())
+		require.NoError(t, err)
+		assert.EqualValues(t, types.Tx(tx).Hash(), res.Hash)
+		eventTypes := make([]string, 0, len(res.Events))
+		for _, e := range res.Events {
+			eventTypes = append(eventTypes, e.Type)
+		}
+		// The tx may have been rechecked before being included.
+		require.GreaterOrEqual(t, len(eventTypes), 5)
+		assert.Equal(t, []string{types.MempoolTxReceived, types.MempoolTxChecked, types.MempoolTxAdded}, eventTypes[:3])
+		assert.Equal(t, []string{types.MempoolTxIncluded, types.MempoolTxRemoved}, eventTypes[len(eventTypes)-2:])
+		assert.Equal(t, bres.Height, res.Events[len(res.Events)-2].Height)
+
+		// An unknown transaction has no events.
+		res, err = mc.TxStatus(context.Background(), types.Tx("unknown").Hash())
+		require.NoError(t, err)
+		assert.Empty(t, res.Events)
+	}
+}
+
 func TestUnconfirmedTxs(t *testing.T) {
 	_, _, tx := MakeTxKV()
 
@@ -10,3 +10,25 @@ func (env *Environment) UnsafeFlushMempool(*rpctypes.Context) (*ctypes.ResultUns
 	env.Mempool.Flush()
 	return &ctypes.ResultUnsafeFlushMempool{}, nil
 }
+
+// pendingEvidenceRemover is implemented by the evidence pools which can remove
+// pending evidence, like evidence.Pool.
+type pendingEvidenceRemover interface {
+	RemovePendingEvidence(hash []byte) error
+}
+
+// UnsafeRemovePendingEvidence removes the pending evidence with the given hash
+// from the evidence pool.
+func (env *Environment) UnsafeRemovePendingEvidence(
+	_ *rpctypes.Context,
+	hash []byte,
+) (*ctypes.ResultUnsafeRemovePendingEvidence, error) {
+	evpool, ok := env.EvidencePool.(pendingEvidenceRemover)
+	if !ok {
+		return nil, ErrPendingEvidenceRemoval
+	}
+	if err := evpool.RemovePendingEvidence(hash); err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultUnsafeRemovePendingEvidence{}, nil
+}
@@ -0,0 +1,16 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cometbft/cometbft/v2/rpc/jsonrpc/types"
+	sm "github.com/cometbft/cometbft/v2/state"
+)
+
+func TestUnsafeRemovePendingEvidenceNotSupported(t *testing.T) {
+	env := &Environment{EvidencePool: sm.EmptyEvidencePool{}}
+	_, err := env.UnsafeRemovePendingEvidence(&rpctypes.Context{}, []byte{0x01})
+	require.ErrorIs(t, err, ErrPendingEvidenceRemoval)
+}
@@ -98,11 +98,12 @@ type Environment struct {
 	P2PTransport     transport
 
 	// objects
-	PubKey       crypto.PubKey
-	TxIndexer    txindex.TxIndexer
-	BlockIndexer indexer.BlockIndexer
-	EventBus     *types.EventBus // thread safe
-	Mempool      mempl.Mempool
+	PubKey        crypto.PubKey
+	TxIndexer     txindex.TxIndexer
+	BlockIndexer  indexer.BlockIndexer
+	EventBus      *types.EventBus // thread safe
+	Mempool       mempl.Mempool
+	MempoolTracer *mempl.TxTracer // nil if tracing is disabled
 
 	Logger log.Logger
 
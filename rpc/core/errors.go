@@ -18,6 +18,7 @@ var (
 	ErrGenesisRespSize         = errors.New("genesis response is too large, please use the genesis_chunked API instead")
 	ErrChunkNotInitialized     = errors.New("genesis chunks are not initialized")
 	ErrNoChunks                = errors.New("genesis file is small, therefore there are no chunks to serve. Please use the /genesis API instead")
+	ErrPendingEvidenceRemoval  = errors.New("the evidence pool cannot remove pending evidence")
 )
 
 type ErrMaxSubscription struct {
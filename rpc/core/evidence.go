@@ -31,3 +31,59 @@ func (env *Environment) BroadcastEvidence(
 
 	return &ctypes.ResultBroadcastEvidence{Hash: ev.Hash()}, nil
 }
+
+// PendingEvidence gets the evidence which is pending in the evidence pool,
+// waiting to be committed, from oldest to newest (maximum ?limit entries),
+// including their number.
+// More: https://docs.cometbft.com/main/rpc/#/Info/pending_evidence
+func (env *Environment) PendingEvidence(_ *rpctypes.Context, limitPtr *int) (*ctypes.ResultPendingEvidence, error) {
+	// reuse per_page validator
+	limit := env.validatePerPage(limitPtr)
+
+	evList, _ := env.EvidencePool.PendingEvidence(-1)
+	total := len(evList)
+	if len(evList) > limit {
+		evList = evList[:limit]
+	}
+	return &ctypes.ResultPendingEvidence{
+		Count:    len(evList),
+		Total:    total,
+		Evidence: evList,
+	}, nil
+}
+
+// CommittedEvidence gets the evidence committed in the blocks for
+// minHeight <= height <= maxHeight, at most 20 blocks at a time.
+// More: https://docs.cometbft.com/main/rpc/#/Info/committed_evidence
+func (env *Environment) CommittedEvidence(
+	_ *rpctypes.Context,
+	minHeight, maxHeight int64,
+) (*ctypes.ResultCommittedEvidence, error) {
+	const limit int64 = 20
+	var err error
+	minHeight, maxHeight, err = filterMinMax(
+		env.BlockStore.Base(),
+		env.BlockStore.Height(),
+		minHeight,
+		maxHeight,
+		limit)
+	if err != nil {
+		return nil, err
+	}
+
+	evList := []ctypes.CommittedEvidence{}
+	for height := minHeight; height <= maxHeight; height++ {
+		block, _ := env.BlockStore.LoadBlock(height)
+		if block == nil {
+			continue
+		}
+		for _, ev := range block.Evidence.Evidence {
+			evList = append(evList, ctypes.CommittedEvidence{Height: height, Evidence: ev})
+		}
+	}
+
+	return &ctypes.ResultCommittedEvidence{
+		LastHeight: env.BlockStore.Height(),
+		Evidence:   evList,
+	}, nil
+}
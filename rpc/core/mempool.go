@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	abci "github.com/cometbft/cometbft/v2/abci/types"
@@ -14,6 +15,7 @@ import (
 var (
 	ErrEndpointClosedCatchingUp = errors.New("endpoint is closed while node is catching up")
 	ErrorEmptyTxHash            = errors.New("transaction hash cannot be empty")
+	ErrTxTracingDisabled        = errors.New("mempool transaction tracing is disabled")
 )
 
 // -----------------------------------------------------------------------------
@@ -57,9 +59,13 @@ func (env *Environment) BroadcastTxSync(ctx *rpctypes.Context, tx types.Tx) (*ct
 		select {
 		case <-ctx.Context().Done():
 		default:
-			if reqRes.Error() != nil {
+			switch {
+			case reqRes.Error() != nil:
 				resErrCh <- reqRes.Error()
-			} else {
+			case reqRes.Response.GetCheckTx() == nil:
+				// The connection to the application was lost before it responded.
+				resErrCh <- ErrConfirmationNotReceived
+			default:
 				resCh <- reqRes.Response.GetCheckTx()
 			}
 		}
@@ -127,9 +133,13 @@ func (env *Environment) BroadcastTxCommit(ctx *rpctypes.Context, tx types.Tx) (*
 		select {
 		case <-ctx.Context().Done():
 		default:
-			if reqRes.Error() != nil {
+			switch {
+			case reqRes.Error() != nil:
 				resErrCh <- reqRes.Error()
-			} else {
+			case reqRes.Response.GetCheckTx() == nil:
+				// The connection to the application was lost before it responded.
+				resErrCh <- ErrConfirmationNotReceived
+			default:
 				checkTxResCh <- reqRes.Response.GetCheckTx()
 			}
 		}
@@ -196,6 +206,30 @@ func (env *Environment) UnconfirmedTx(_ *rpctypes.Context, hash []byte) (*ctypes
 	}, nil
 }
 
+// TxStatus returns the events of the lifecycle of a transaction in the
+// mempool (received, checked, added to a lane, rechecked, rejected or removed,
+// included in a block), if the transaction is among the last ones traced.
+func (env *Environment) TxStatus(_ *rpctypes.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	if len(hash) == 0 {
+		return nil, ErrorEmptyTxHash
+	}
+	if len(hash) != types.TxKeySize {
+		return nil, fmt.Errorf("transaction hash must be %d bytes long, got %d", types.TxKeySize, len(hash))
+	}
+	if env.MempoolTracer == nil {
+		return nil, ErrTxTracingDisabled
+	}
+
+	events := env.MempoolTracer.Events(types.TxKey(hash))
+	if events == nil {
+		events = []types.MempoolTxEvent{}
+	}
+	return &ctypes.ResultTxStatus{
+		Hash:   hash,
+		Events: events,
+	}, nil
+}
+
 // UnconfirmedTxs gets unconfirmed transactions (maximum ?limit entries)
 // including their number.
 // More: https://docs.cometbft.com/main/rpc/#/Info/unconfirmed_txs
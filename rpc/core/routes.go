@@ -38,6 +38,7 @@ func (env *Environment) GetRoutes() RoutesMap {
 		"consensus_state":      rpc.NewRPCFunc(env.GetConsensusState, ""),
 		"consensus_params":     rpc.NewRPCFunc(env.ConsensusParams, "height", rpc.Cacheable("height")),
 		"unconfirmed_tx":       rpc.NewRPCFunc(env.UnconfirmedTx, "hash"),
+		"tx_status":            rpc.NewRPCFunc(env.TxStatus, "hash"),
 		"unconfirmed_txs":      rpc.NewRPCFunc(env.UnconfirmedTxs, "limit"),
 		"num_unconfirmed_txs":  rpc.NewRPCFunc(env.NumUnconfirmedTxs, ""),
 
@@ -52,6 +53,8 @@ func (env *Environment) GetRoutes() RoutesMap {
 
 		// evidence API
 		"broadcast_evidence": rpc.NewRPCFunc(env.BroadcastEvidence, "evidence"),
+		"pending_evidence":   rpc.NewRPCFunc(env.PendingEvidence, "limit"),
+		"committed_evidence": rpc.NewRPCFunc(env.CommittedEvidence, "min_height,max_height"),
 	}
 }
 
@@ -61,4 +64,5 @@ func (env *Environment) AddUnsafeRoutes(routes RoutesMap) {
 	routes["dial_seeds"] = rpc.NewRPCFunc(env.UnsafeDialSeeds, "seeds")
 	routes["dial_peers"] = rpc.NewRPCFunc(env.UnsafeDialPeers, "peers,persistent,unconditional,private")
 	routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(env.UnsafeFlushMempool, "")
+	routes["unsafe_remove_pending_evidence"] = rpc.NewRPCFunc(env.UnsafeRemovePendingEvidence, "hash")
 }
@@ -221,6 +221,12 @@ type ResultUnconfirmedTx struct {
 	Tx types.Tx `json:"tx"`
 }
 
+// Events of the lifecycle of a transaction in the mempool.
+type ResultTxStatus struct {
+	Hash   bytes.HexBytes         `json:"hash"`
+	Events []types.MempoolTxEvent `json:"events"`
+}
+
 // List of mempool txs.
 type ResultUnconfirmedTxs struct {
 	Count      int        `json:"n_txs"`
@@ -244,13 +250,33 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// List of pending evidence.
+type ResultPendingEvidence struct {
+	Count    int              `json:"n_evidence"`
+	Total    int              `json:"total"`
+	Evidence []types.Evidence `json:"evidence"`
+}
+
+// Evidence committed in a block.
+type CommittedEvidence struct {
+	Height   int64          `json:"height"`
+	Evidence types.Evidence `json:"evidence"`
+}
+
+// List of evidence committed in a range of blocks.
+type ResultCommittedEvidence struct {
+	LastHeight int64               `json:"last_height"`
+	Evidence   []CommittedEvidence `json:"evidence"`
+}
+
 // empty results.
 type (
-	ResultUnsafeFlushMempool struct{}
-	ResultUnsafeProfile      struct{}
-	ResultSubscribe          struct{}
-	ResultUnsubscribe        struct{}
-	ResultHealth             struct{}
+	ResultUnsafeFlushMempool          struct{}
+	ResultUnsafeRemovePendingEvidence struct{}
+	ResultUnsafeProfile               struct{}
+	ResultSubscribe                   struct{}
+	ResultUnsubscribe                 struct{}
+	ResultHealth                      struct{}
 )
 
 // Event data from a subscription.
@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	"github.com/cosmos/gogoproto/grpc"
 
@@ -40,6 +42,13 @@ type LatestHeightResult struct {
 	Error  error
 }
 
+// LightBlockResult type used in GetLightBlocks and sent to the client via a
+// channel.
+type LightBlockResult struct {
+	LightBlock *types.LightBlock
+	Error      error
+}
+
 type getLatestHeightConfig struct {
 	chSize uint
 }
@@ -63,6 +72,15 @@ type BlockServiceClient interface {
 	// GetLatestHeight provides sends the latest committed block height to the
 	// resulting output channel as blocks are committed.
 	GetLatestHeight(ctx context.Context, opts ...GetLatestHeightOption) (<-chan LatestHeightResult, error)
+
+	// GetLightBlock attempts to retrieve the light block associated with the
+	// given height, or the latest one if height is 0.
+	GetLightBlock(ctx context.Context, height int64) (*types.LightBlock, error)
+
+	// GetLightBlocks sends the light blocks from fromHeight to toHeight,
+	// inclusive, to the resulting output channel, which is closed once the
+	// server ends the stream. The server may end it before toHeight.
+	GetLightBlocks(ctx context.Context, fromHeight, toHeight int64) (<-chan LightBlockResult, error)
 }
 
 type blockServiceClient struct {
@@ -129,6 +147,56 @@ func (c *blockServiceClient) GetLatestHeight(ctx context.Context, opts ...GetLat
 	return resultCh, nil
 }
 
+// GetLightBlock implements BlockServiceClient GetLightBlock.
+func (c *blockServiceClient) GetLightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	res, err := c.client.GetLightBlock(ctx, &blocksvc.GetLightBlockRequest{
+		Height: height,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.LightBlockFromProto(res.LightBlock)
+}
+
+// GetLightBlocks implements BlockServiceClient GetLightBlocks.
+func (c *blockServiceClient) GetLightBlocks(ctx context.Context, fromHeight, toHeight int64) (<-chan LightBlockResult, error) {
+	lightBlocksClient, err := c.client.GetLightBlocks(ctx, &blocksvc.GetLightBlocksRequest{
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	})
+	if err != nil {
+		return nil, ErrLightBlockStreamSetup{Source: err}
+	}
+
+	resultCh := make(chan LightBlockResult)
+	go func(client blocksvc.BlockService_GetLightBlocksClient) {
+		defer close(resultCh)
+		for {
+			response, err := client.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			var res LightBlockResult
+			if err != nil {
+				res.Error = ErrLightBlockStreamReceive{Source: err}
+			} else {
+				res.LightBlock, res.Error = types.LightBlockFromProto(response.LightBlock)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case resultCh <- res:
+			}
+			if res.Error != nil {
+				return
+			}
+		}
+	}(lightBlocksClient)
+
+	return resultCh, nil
+}
+
 type disabledBlockServiceClient struct{}
 
 func newDisabledBlockServiceClient() BlockServiceClient {
@@ -149,3 +217,13 @@ func (*disabledBlockServiceClient) GetLatestBlock(context.Context) (*Block, erro
 func (*disabledBlockServiceClient) GetLatestHeight(context.Context, ...GetLatestHeightOption) (<-chan LatestHeightResult, error) {
 	panic("block service client is disabled")
 }
+
+// GetLightBlock implements BlockServiceClient GetLightBlock - disabled client.
+func (*disabledBlockServiceClient) GetLightBlock(context.Context, int64) (*types.LightBlock, error) {
+	panic("block service client is disabled")
+}
+
+// GetLightBlocks implements BlockServiceClient GetLightBlocks - disabled client.
+func (*disabledBlockServiceClient) GetLightBlocks(context.Context, int64, int64) (<-chan LightBlockResult, error) {
+	panic("block service client is disabled")
+}
@@ -47,3 +47,75 @@ func (e ErrDial) Error() string {
 func (e ErrDial) Unwrap() error {
 	return e.Source
 }
+
+type ErrTxResultStreamSetup struct {
+	Source error
+}
+
+func (e ErrTxResultStreamSetup) Error() string {
+	return "error getting a stream for transaction results: " + e.Source.Error()
+}
+
+func (e ErrTxResultStreamSetup) Unwrap() error {
+	return e.Source
+}
+
+type ErrTxResultStreamReceive struct {
+	Source error
+}
+
+func (e ErrTxResultStreamReceive) Error() string {
+	return "error receiving a transaction result from a stream: " + e.Source.Error()
+}
+
+func (e ErrTxResultStreamReceive) Unwrap() error {
+	return e.Source
+}
+
+type ErrEventStreamSetup struct {
+	Source error
+}
+
+func (e ErrEventStreamSetup) Error() string {
+	return "error getting a stream for events: " + e.Source.Error()
+}
+
+func (e ErrEventStreamSetup) Unwrap() error {
+	return e.Source
+}
+
+type ErrEventStreamReceive struct {
+	Source error
+}
+
+func (e ErrEventStreamReceive) Error() string {
+	return "error receiving an event from a stream: " + e.Source.Error()
+}
+
+func (e ErrEventStreamReceive) Unwrap() error {
+	return e.Source
+}
+
+type ErrLightBlockStreamSetup struct {
+	Source error
+}
+
+func (e ErrLightBlockStreamSetup) Error() string {
+	return "error getting a stream for light blocks: " + e.Source.Error()
+}
+
+func (e ErrLightBlockStreamSetup) Unwrap() error {
+	return e.Source
+}
+
+type ErrLightBlockStreamReceive struct {
+	Source error
+}
+
+func (e ErrLightBlockStreamReceive) Error() string {
+	return "error receiving a light block from a stream: " + e.Source.Error()
+}
+
+func (e ErrLightBlockStreamReceive) Unwrap() error {
+	return e.Source
+}
@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	pbsvc "github.com/cometbft/cometbft/api/cometbft/services/event/v1"
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	cmtjson "github.com/cometbft/cometbft/v2/libs/json"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// Event type used in Subscribe and sent to the client via a channel.
+type Event struct {
+	// The type of the event, i.e. the value of the tm.event key.
+	Type string
+	// The height of the block the event belongs to, or 0 if the event is not
+	// related to a committed block.
+	Height int64
+	// The composite keys of the event, along with their values.
+	Keys map[string][]string
+	// Whether the event was replayed from the block store of the node.
+	Replayed bool
+	// The data of the event, e.g. types.EventDataNewBlock or
+	// types.EventDataTx.
+	Data types.TMEventData
+
+	Error error
+}
+
+type subscribeConfig struct {
+	fromHeight int64
+	chSize     uint
+}
+
+type SubscribeOption func(*subscribeConfig)
+
+// SubscribeFromHeight requests the events of the blocks from the given height
+// on to be replayed before live events are sent. This allows resuming a
+// subscription without missing events.
+func SubscribeFromHeight(height int64) SubscribeOption {
+	return func(opts *subscribeConfig) {
+		opts.fromHeight = height
+	}
+}
+
+// SubscribeChannelSize allows control over the channel size. If not used or
+// the channel size is set to 0, an unbuffered channel will be created.
+func SubscribeChannelSize(sz uint) SubscribeOption {
+	return func(opts *subscribeConfig) {
+		opts.chSize = sz
+	}
+}
+
+// EventServiceClient allows subscribing to the events of a CometBFT node.
+type EventServiceClient interface {
+	// Subscribe sends the events matching the given query to the resulting
+	// output channel. See the documentation of the subscribe JSON-RPC
+	// endpoint for the syntax of queries.
+	//
+	// The channel is closed after an event with an error is sent. If the
+	// client did not keep up with the events, the error has the
+	// codes.ResourceExhausted gRPC status code, and the subscription can be
+	// resumed with SubscribeFromHeight.
+	Subscribe(ctx context.Context, query string, opts ...SubscribeOption) (<-chan Event, error)
+}
+
+type eventServiceClient struct {
+	client pbsvc.EventServiceClient
+}
+
+func newEventServiceClient(conn grpc.ClientConn) EventServiceClient {
+	return &eventServiceClient{
+		client: pbsvc.NewEventServiceClient(conn),
+	}
+}
+
+// Subscribe implements EventServiceClient.
+func (c *eventServiceClient) Subscribe(ctx context.Context, query string, opts ...SubscribeOption) (<-chan Event, error) {
+	cfg := &subscribeConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	stream, err := c.client.Subscribe(ctx, &pbsvc.SubscribeRequest{Query: query, FromHeight: cfg.fromHeight})
+	if err != nil {
+		return nil, ErrEventStreamSetup{Source: err}
+	}
+	resultCh := make(chan Event, cfg.chSize)
+
+	go func(stream pbsvc.EventService_SubscribeClient) {
+		defer close(resultCh)
+		for {
+			var event Event
+			response, err := stream.Recv()
+			switch {
+			case errors.Is(err, io.EOF):
+				return
+			case err != nil:
+				err = ErrEventStreamReceive{Source: err}
+			default:
+				event, err = eventFromProto(response)
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+				case resultCh <- Event{Error: err}:
+				}
+				return
+			}
+			// Events cannot be skipped, so this blocks until the client reads
+			// the previous events. The server terminates the subscription if
+			// the client does not keep up.
+			select {
+			case <-ctx.Done():
+				return
+			case resultCh <- event:
+			}
+		}
+	}(stream)
+
+	return resultCh, nil
+}
+
+func eventFromProto(res *pbsvc.SubscribeResponse) (Event, error) {
+	event := Event{
+		Type:     res.Type,
+		Height:   res.Height,
+		Keys:     make(map[string][]string, len(res.Keys)),
+		Replayed: res.Replayed,
+	}
+	for _, key := range res.Keys {
+		event.Keys[key.Key] = key.Values
+	}
+
+	switch data := res.Data.(type) {
+	case *pbsvc.SubscribeResponse_NewBlock:
+		block, err := blockFromProto(data.NewBlock.BlockId, data.NewBlock.Block)
+		if err != nil {
+			return event, err
+		}
+		newBlock := types.EventDataNewBlock{Block: block.Block, BlockID: *block.BlockID}
+		if data.NewBlock.ResultFinalizeBlock != nil {
+			newBlock.ResultFinalizeBlock = *data.NewBlock.ResultFinalizeBlock
+		}
+		event.Data = newBlock
+	case *pbsvc.SubscribeResponse_NewBlockHeader:
+		header, err := types.HeaderFromProto(data.NewBlockHeader)
+		if err != nil {
+			return event, err
+		}
+		event.Data = types.EventDataNewBlockHeader{Header: header}
+	case *pbsvc.SubscribeResponse_NewBlockEvents:
+		events := make([]abci.Event, len(data.NewBlockEvents.Events))
+		for i, ev := range data.NewBlockEvents.Events {
+			events[i] = *ev
+		}
+		event.Data = types.EventDataNewBlockEvents{
+			Height: data.NewBlockEvents.Height,
+			Events: events,
+			NumTxs: data.NewBlockEvents.NumTxs,
+		}
+	case *pbsvc.SubscribeResponse_NewEvidence:
+		evidence, err := types.EvidenceFromProto(data.NewEvidence.Evidence)
+		if err != nil {
+			return event, err
+		}
+		event.Data = types.EventDataNewEvidence{Height: data.NewEvidence.Height, Evidence: evidence}
+	case *pbsvc.SubscribeResponse_Tx:
+		if data.Tx == nil {
+			return event, errors.New("missing transaction result")
+		}
+		event.Data = types.EventDataTx{TxResult: *data.Tx}
+	case *pbsvc.SubscribeResponse_Json:
+		var eventData types.TMEventData
+		if err := cmtjson.Unmarshal(data.Json, &eventData); err != nil {
+			return event, err
+		}
+		event.Data = eventData
+	default:
+		return event, fmt.Errorf("unexpected event data type: %T", data)
+	}
+	return event, nil
+}
+
+type disabledEventServiceClient struct{}
+
+func newDisabledEventServiceClient() EventServiceClient {
+	return &disabledEventServiceClient{}
+}
+
+// Subscribe implements EventServiceClient - disabled client.
+func (*disabledEventServiceClient) Subscribe(context.Context, string, ...SubscribeOption) (<-chan Event, error) {
+	panic("event service client is disabled")
+}
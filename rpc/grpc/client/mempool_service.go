@@ -0,0 +1,216 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	pbsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// BroadcastTxResult contains the results of a transaction broadcast via the
+// CometBFT MempoolService gRPC API.
+type BroadcastTxResult struct {
+	Hash    []byte                `json:"hash"`
+	CheckTx *abci.CheckTxResponse `json:"check_tx"`
+	// Only set by BroadcastTxCommit, if the transaction passed CheckTx.
+	TxResult *abci.ExecTxResult `json:"tx_result"`
+	Height   int64              `json:"height"`
+}
+
+// UnconfirmedTxs contains transactions of the mempool of a CometBFT node.
+type UnconfirmedTxs struct {
+	Count      int        `json:"n_txs"`
+	Total      int        `json:"total"`
+	TotalBytes int64      `json:"total_bytes"`
+	Txs        []types.Tx `json:"txs"`
+}
+
+// TxResultEvent type used in SubscribeTxResult and sent to the client via a
+// channel.
+type TxResultEvent struct {
+	Hash     []byte
+	TxResult *abci.TxResult
+	Error    error
+}
+
+type subscribeTxResultConfig struct {
+	chSize uint
+}
+
+type SubscribeTxResultOption func(*subscribeTxResultConfig)
+
+// SubscribeTxResultChannelSize allows control over the channel size. If not
+// used or the channel size is set to 0, an unbuffered channel will be created.
+func SubscribeTxResultChannelSize(sz uint) SubscribeTxResultOption {
+	return func(opts *subscribeTxResultConfig) {
+		opts.chSize = sz
+	}
+}
+
+// MempoolServiceClient allows submitting transactions to a CometBFT node and
+// inspecting its mempool.
+type MempoolServiceClient interface {
+	// BroadcastTxSync adds the transaction to the mempool of the node, and
+	// returns the result of CheckTx.
+	BroadcastTxSync(ctx context.Context, tx types.Tx) (*BroadcastTxResult, error)
+
+	// BroadcastTxCommit adds the transaction to the mempool of the node, and
+	// returns once the transaction is committed, with the results of CheckTx
+	// and of its execution. If the transaction does not pass CheckTx, it
+	// returns right away with the result of CheckTx.
+	BroadcastTxCommit(ctx context.Context, tx types.Tx) (*BroadcastTxResult, error)
+
+	// CheckTx checks the transaction with the application, without adding it
+	// to the mempool.
+	CheckTx(ctx context.Context, tx types.Tx) (*abci.CheckTxResponse, error)
+
+	// UnconfirmedTxs returns up to limit transactions of the mempool. If limit
+	// is zero, the default limit of the node is used.
+	UnconfirmedTxs(ctx context.Context, limit int) (*UnconfirmedTxs, error)
+
+	// SubscribeTxResult sends the results of the transactions committed from
+	// now on to the resulting output channel. If hash is not empty, only the
+	// result of the transaction with this hash is sent, after which the
+	// channel is closed.
+	SubscribeTxResult(ctx context.Context, hash []byte, opts ...SubscribeTxResultOption) (<-chan TxResultEvent, error)
+}
+
+type mempoolServiceClient struct {
+	client pbsvc.MempoolServiceClient
+}
+
+func newMempoolServiceClient(conn grpc.ClientConn) MempoolServiceClient {
+	return &mempoolServiceClient{
+		client: pbsvc.NewMempoolServiceClient(conn),
+	}
+}
+
+// BroadcastTxSync implements MempoolServiceClient.
+func (c *mempoolServiceClient) BroadcastTxSync(ctx context.Context, tx types.Tx) (*BroadcastTxResult, error) {
+	return c.broadcastTx(ctx, tx, pbsvc.BroadcastMode_BROADCAST_MODE_SYNC)
+}
+
+// BroadcastTxCommit implements MempoolServiceClient.
+func (c *mempoolServiceClient) BroadcastTxCommit(ctx context.Context, tx types.Tx) (*BroadcastTxResult, error) {
+	return c.broadcastTx(ctx, tx, pbsvc.BroadcastMode_BROADCAST_MODE_COMMIT)
+}
+
+func (c *mempoolServiceClient) broadcastTx(ctx context.Context, tx types.Tx, mode pbsvc.BroadcastMode) (*BroadcastTxResult, error) {
+	res, err := c.client.BroadcastTx(ctx, &pbsvc.BroadcastTxRequest{Tx: tx, Mode: mode})
+	if err != nil {
+		return nil, err
+	}
+	return &BroadcastTxResult{
+		Hash:     res.Hash,
+		CheckTx:  res.CheckTx,
+		TxResult: res.TxResult,
+		Height:   res.Height,
+	}, nil
+}
+
+// CheckTx implements MempoolServiceClient.
+func (c *mempoolServiceClient) CheckTx(ctx context.Context, tx types.Tx) (*abci.CheckTxResponse, error) {
+	res, err := c.client.CheckTx(ctx, &pbsvc.CheckTxRequest{Tx: tx})
+	if err != nil {
+		return nil, err
+	}
+	return res.CheckTx, nil
+}
+
+// UnconfirmedTxs implements MempoolServiceClient.
+func (c *mempoolServiceClient) UnconfirmedTxs(ctx context.Context, limit int) (*UnconfirmedTxs, error) {
+	res, err := c.client.UnconfirmedTxs(ctx, &pbsvc.UnconfirmedTxsRequest{Limit: int64(limit)})
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]types.Tx, len(res.Txs))
+	for i, tx := range res.Txs {
+		txs[i] = tx
+	}
+	return &UnconfirmedTxs{
+		Count:      int(res.Count),
+		Total:      int(res.Total),
+		TotalBytes: res.TotalBytes,
+		Txs:        txs,
+	}, nil
+}
+
+// SubscribeTxResult implements MempoolServiceClient.
+func (c *mempoolServiceClient) SubscribeTxResult(ctx context.Context, hash []byte, opts ...SubscribeTxResultOption) (<-chan TxResultEvent, error) {
+	stream, err := c.client.SubscribeTxResult(ctx, &pbsvc.SubscribeTxResultRequest{Hash: hash})
+	if err != nil {
+		return nil, ErrTxResultStreamSetup{Source: err}
+	}
+
+	cfg := &subscribeTxResultConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	resultCh := make(chan TxResultEvent, cfg.chSize)
+
+	go func(stream pbsvc.MempoolService_SubscribeTxResultClient) {
+		defer close(resultCh)
+		for {
+			response, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					// The server closed the stream after sending the result
+					// of the requested transaction.
+					return
+				}
+				res := TxResultEvent{Error: ErrTxResultStreamReceive{Source: err}}
+				select {
+				case <-ctx.Done():
+				case resultCh <- res:
+				}
+				return
+			}
+			// Unlike heights, results cannot be skipped, so this blocks until
+			// the client reads the previous results.
+			res := TxResultEvent{Hash: response.Hash, TxResult: response.TxResult}
+			select {
+			case <-ctx.Done():
+				return
+			case resultCh <- res:
+			}
+		}
+	}(stream)
+
+	return resultCh, nil
+}
+
+type disabledMempoolServiceClient struct{}
+
+func newDisabledMempoolServiceClient() MempoolServiceClient {
+	return &disabledMempoolServiceClient{}
+}
+
+// BroadcastTxSync implements MempoolServiceClient - disabled client.
+func (*disabledMempoolServiceClient) BroadcastTxSync(context.Context, types.Tx) (*BroadcastTxResult, error) {
+	panic("mempool service client is disabled")
+}
+
+// BroadcastTxCommit implements MempoolServiceClient - disabled client.
+func (*disabledMempoolServiceClient) BroadcastTxCommit(context.Context, types.Tx) (*BroadcastTxResult, error) {
+	panic("mempool service client is disabled")
+}
+
+// CheckTx implements MempoolServiceClient - disabled client.
+func (*disabledMempoolServiceClient) CheckTx(context.Context, types.Tx) (*abci.CheckTxResponse, error) {
+	panic("mempool service client is disabled")
+}
+
+// UnconfirmedTxs implements MempoolServiceClient - disabled client.
+func (*disabledMempoolServiceClient) UnconfirmedTxs(context.Context, int) (*UnconfirmedTxs, error) {
+	panic("mempool service client is disabled")
+}
+
+// SubscribeTxResult implements MempoolServiceClient - disabled client.
+func (*disabledMempoolServiceClient) SubscribeTxResult(context.Context, []byte, ...SubscribeTxResultOption) (<-chan TxResultEvent, error) {
+	panic("mempool service client is disabled")
+}
@@ -4,18 +4,27 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 
 	pbblocksvc "github.com/cometbft/cometbft/api/cometbft/services/block/v2"
 	brs "github.com/cometbft/cometbft/api/cometbft/services/block_results/v2"
+	pbeventsvc "github.com/cometbft/cometbft/api/cometbft/services/event/v1"
+	pbmempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
 	pbversionsvc "github.com/cometbft/cometbft/api/cometbft/services/version/v1"
+	"github.com/cometbft/cometbft/v2/internal/rpcsub"
 	"github.com/cometbft/cometbft/v2/libs/log"
+	mempl "github.com/cometbft/cometbft/v2/mempool"
+	"github.com/cometbft/cometbft/v2/proxy"
 	grpcerr "github.com/cometbft/cometbft/v2/rpc/grpc/errors"
 	"github.com/cometbft/cometbft/v2/rpc/grpc/server/services/blockresultservice"
 	"github.com/cometbft/cometbft/v2/rpc/grpc/server/services/blockservice"
+	"github.com/cometbft/cometbft/v2/rpc/grpc/server/services/eventservice"
+	"github.com/cometbft/cometbft/v2/rpc/grpc/server/services/mempoolservice"
 	"github.com/cometbft/cometbft/v2/rpc/grpc/server/services/versionservice"
 	sm "github.com/cometbft/cometbft/v2/state"
+	"github.com/cometbft/cometbft/v2/state/txindex"
 	"github.com/cometbft/cometbft/v2/store"
 	"github.com/cometbft/cometbft/v2/types"
 )
@@ -29,6 +38,8 @@ type serverBuilder struct {
 	versionService      pbversionsvc.VersionServiceServer
 	blockService        pbblocksvc.BlockServiceServer
 	blockResultsService brs.BlockResultsServiceServer
+	mempoolService      pbmempoolsvc.MempoolServiceServer
+	eventService        pbeventsvc.EventServiceServer
 	logger              log.Logger
 	grpcOpts            []grpc.ServerOption
 }
@@ -62,9 +73,9 @@ func WithVersionService() Option {
 }
 
 // WithBlockService enables the block service on the CometBFT server.
-func WithBlockService(store *store.BlockStore, eventBus *types.EventBus, logger log.Logger) Option {
+func WithBlockService(store *store.BlockStore, stateStore sm.Store, eventBus *types.EventBus, logger log.Logger) Option {
 	return func(b *serverBuilder) {
-		b.blockService = blockservice.New(store, eventBus, logger)
+		b.blockService = blockservice.New(store, stateStore, eventBus, logger)
 	}
 }
 
@@ -74,6 +85,42 @@ func WithBlockResultsService(bs *store.BlockStore, ss sm.Store, logger log.Logge
 	}
 }
 
+// WithMempoolService enables the mempool service on the CometBFT server. In the
+// commit broadcast mode, transactions are waited for up to commitTimeout. The
+// subscriptions to transaction results are limited by subscriptions.
+func WithMempoolService(
+	reactor mempoolservice.Reactor,
+	mempool mempl.Mempool,
+	proxyApp proxy.AppConnMempool,
+	eventBus *types.EventBus,
+	subscriptions *rpcsub.Limiter,
+	commitTimeout time.Duration,
+	logger log.Logger,
+) Option {
+	return func(b *serverBuilder) {
+		b.mempoolService = mempoolservice.New(reactor, mempool, proxyApp, eventBus, subscriptions, commitTimeout, logger)
+	}
+}
+
+// WithEventService enables the event service on the CometBFT server. Up to
+// bufferSize events are buffered for each subscription, and subscriptions can
+// replay the events of up to maxReplayHeights blocks (or of any number of
+// blocks if 0). The subscriptions are limited by subscriptions.
+func WithEventService(
+	eventBus *types.EventBus,
+	blockStore sm.BlockStore,
+	stateStore sm.Store,
+	txIndexer txindex.TxIndexer,
+	subscriptions *rpcsub.Limiter,
+	bufferSize int,
+	maxReplayHeights int64,
+	logger log.Logger,
+) Option {
+	return func(b *serverBuilder) {
+		b.eventService = eventservice.New(eventBus, blockStore, stateStore, txIndexer, subscriptions, bufferSize, maxReplayHeights, logger)
+	}
+}
+
 // WithLogger enables logging using the given logger. If not specified, the
 // gRPC server does not log anything.
 func WithLogger(logger log.Logger) Option {
@@ -114,6 +161,14 @@ func Serve(listener net.Listener, opts ...Option) error {
 		brs.RegisterBlockResultsServiceServer(server, b.blockResultsService)
 		b.logger.Debug("Registered block results service")
 	}
+	if b.mempoolService != nil {
+		pbmempoolsvc.RegisterMempoolServiceServer(server, b.mempoolService)
+		b.logger.Debug("Registered mempool service")
+	}
+	if b.eventService != nil {
+		pbeventsvc.RegisterEventServiceServer(server, b.eventService)
+		b.logger.Debug("Registered event service")
+	}
 	b.logger.Info("serve", "msg", fmt.Sprintf("Starting gRPC server on %s", listener.Addr()))
 	return server.Serve(b.listener)
 }
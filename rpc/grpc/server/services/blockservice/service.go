@@ -12,22 +12,29 @@ import (
 	"github.com/cometbft/cometbft/v2/internal/rpctrace"
 	"github.com/cometbft/cometbft/v2/libs/log"
 	cmtpubsub "github.com/cometbft/cometbft/v2/libs/pubsub"
+	sm "github.com/cometbft/cometbft/v2/state"
 	"github.com/cometbft/cometbft/v2/store"
 	"github.com/cometbft/cometbft/v2/types"
 )
 
+// maxLightBlocksPerStream is the maximum number of light blocks sent in
+// response to a GetLightBlocks request.
+const maxLightBlocksPerStream = 100
+
 type blockServiceServer struct {
-	store    *store.BlockStore
-	eventBus *types.EventBus
-	logger   log.Logger
+	store      *store.BlockStore
+	stateStore sm.Store
+	eventBus   *types.EventBus
+	logger     log.Logger
 }
 
 // New creates a new CometBFT version service server.
-func New(store *store.BlockStore, eventBus *types.EventBus, logger log.Logger) blocksvc.BlockServiceServer {
+func New(store *store.BlockStore, stateStore sm.Store, eventBus *types.EventBus, logger log.Logger) blocksvc.BlockServiceServer {
 	return &blockServiceServer{
-		store:    store,
-		eventBus: eventBus,
-		logger:   logger.With("service", "BlockService"),
+		store:      store,
+		stateStore: stateStore,
+		eventBus:   eventBus,
+		logger:     logger.With("service", "BlockService"),
 	}
 }
 
@@ -124,6 +131,115 @@ func (s *blockServiceServer) GetLatestHeight(_ *blocksvc.GetLatestHeightRequest,
 	}
 }
 
+// GetLightBlock implements v1.BlockServiceServer GetLightBlock method.
+func (s *blockServiceServer) GetLightBlock(_ context.Context, req *blocksvc.GetLightBlockRequest) (*blocksvc.GetLightBlockResponse, error) {
+	logger := s.logger.With("endpoint", "GetLightBlock")
+	height := req.Height
+	if height == 0 {
+		height = s.store.Height()
+		if height == 0 {
+			return nil, status.Error(codes.OutOfRange, "No block committed yet")
+		}
+	}
+	if err := validateLightBlockHeight(height, s.store.Base(), s.store.Height()); err != nil {
+		return nil, err
+	}
+
+	lightBlock, err := s.getLightBlock(height, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blocksvc.GetLightBlockResponse{
+		LightBlock: lightBlock,
+	}, nil
+}
+
+// GetLightBlocks implements v1.BlockServiceServer GetLightBlocks method.
+func (s *blockServiceServer) GetLightBlocks(req *blocksvc.GetLightBlocksRequest, stream blocksvc.BlockService_GetLightBlocksServer) error {
+	logger := s.logger.With("endpoint", "GetLightBlocks")
+	if req.FromHeight > req.ToHeight {
+		return status.Errorf(codes.InvalidArgument, "From height %d is higher than to height %d", req.FromHeight, req.ToHeight)
+	}
+	latestHeight := s.store.Height()
+	if err := validateLightBlockHeight(req.FromHeight, s.store.Base(), latestHeight); err != nil {
+		return err
+	}
+	// The heights past the latest one are not an error: the stream ends at the
+	// latest height, for the caller to request the following ones later.
+	toHeight := min(req.ToHeight, req.FromHeight+maxLightBlocksPerStream-1, latestHeight)
+
+	for height := req.FromHeight; height <= toHeight; height++ {
+		lightBlock, err := s.getLightBlock(height, logger)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&blocksvc.GetLightBlocksResponse{LightBlock: lightBlock}); err != nil {
+			logger.Error("Failed to stream light block", "err", err, "height", height)
+			return status.Error(codes.Unavailable, "Cannot send stream response")
+		}
+	}
+	return nil
+}
+
+func (s *blockServiceServer) getLightBlock(height int64, logger log.Logger) (*ptypes.LightBlock, error) {
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return nil, status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+
+	blockMeta := s.store.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil, status.Errorf(codes.NotFound, "Light block not found for height %d", height)
+	}
+	// The commit of the latest block is only known from the votes this node
+	// saw, as the next block is not committed yet.
+	var commit *types.Commit
+	if height == s.store.Height() {
+		commit = s.store.LoadSeenCommit(height)
+	} else {
+		commit = s.store.LoadBlockCommit(height)
+	}
+	if commit == nil {
+		return nil, status.Errorf(codes.NotFound, "Commit not found for height %d", height)
+	}
+	vals, err := s.stateStore.LoadValidators(height)
+	if err != nil {
+		logger.Error("Error loading the validator set", "err", err, "height", height, "traceID", traceID)
+		return nil, status.Errorf(codes.NotFound, "Validator set not found for height %d (see logs for trace ID: %s)", height, traceID)
+	}
+
+	lightBlock := &types.LightBlock{
+		SignedHeader: &types.SignedHeader{
+			Header: &blockMeta.Header,
+			Commit: commit,
+		},
+		ValidatorSet: vals,
+	}
+	lbp, err := lightBlock.ToProto()
+	if err != nil {
+		logger.Error("Error attempting to convert light block to its Protobuf representation", "err", err, "traceID", traceID)
+		return nil, status.Errorf(codes.Internal, "Failed to load light block from store (see logs for trace ID: %s)", traceID)
+	}
+	return lbp, nil
+}
+
+// validateLightBlockHeight is validateBlockHeight with distinct codes for the
+// pruned heights and the heights not committed yet, as light clients handle
+// them differently.
+func validateLightBlockHeight(height, baseHeight, latestHeight int64) error {
+	switch {
+	case height <= 0:
+		return status.Error(codes.InvalidArgument, "Height cannot be zero or negative")
+	case height < baseHeight:
+		return status.Errorf(codes.NotFound, "Requested height %d is below base height %d", height, baseHeight)
+	case height > latestHeight:
+		return status.Errorf(codes.OutOfRange, "Requested height %d is higher than latest height %d", height, latestHeight)
+	}
+	return nil
+}
+
 func validateBlockHeight(height, baseHeight, latestHeight int64) error {
 	switch {
 	case height <= 0:
@@ -0,0 +1,378 @@
+package eventservice
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbsvc "github.com/cometbft/cometbft/api/cometbft/services/event/v1"
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/internal/rpcsub"
+	"github.com/cometbft/cometbft/v2/internal/rpctrace"
+	cmtjson "github.com/cometbft/cometbft/v2/libs/json"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	cmtpubsub "github.com/cometbft/cometbft/v2/libs/pubsub"
+	cmtquery "github.com/cometbft/cometbft/v2/libs/pubsub/query"
+	sm "github.com/cometbft/cometbft/v2/state"
+	"github.com/cometbft/cometbft/v2/state/txindex"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+type eventServiceServer struct {
+	eventBus         *types.EventBus
+	blockStore       sm.BlockStore
+	stateStore       sm.Store
+	txIndexer        txindex.TxIndexer
+	subscriptions    *rpcsub.Limiter
+	bufferSize       int
+	maxReplayHeights int64
+	logger           log.Logger
+}
+
+// New creates a new CometBFT event service server.
+//
+// Up to bufferSize events are buffered for each subscription, beyond which
+// the subscription is terminated. Subscriptions can replay the events of up
+// to maxReplayHeights blocks, or of any number of blocks if maxReplayHeights
+// is 0. The results of the transactions are loaded from txIndexer if the
+// FinalizeBlock responses are not persisted in stateStore. The subscriptions
+// are limited by subscriptions.
+func New(
+	eventBus *types.EventBus,
+	blockStore sm.BlockStore,
+	stateStore sm.Store,
+	txIndexer txindex.TxIndexer,
+	subscriptions *rpcsub.Limiter,
+	bufferSize int,
+	maxReplayHeights int64,
+	logger log.Logger,
+) pbsvc.EventServiceServer {
+	return &eventServiceServer{
+		eventBus:         eventBus,
+		blockStore:       blockStore,
+		stateStore:       stateStore,
+		txIndexer:        txIndexer,
+		subscriptions:    subscriptions,
+		bufferSize:       bufferSize,
+		maxReplayHeights: maxReplayHeights,
+		logger:           logger.With("service", "EventService"),
+	}
+}
+
+// Subscribe implements v1.EventServiceServer.
+func (s *eventServiceServer) Subscribe(req *pbsvc.SubscribeRequest, stream pbsvc.EventService_SubscribeServer) error {
+	logger := s.logger.With("endpoint", "Subscribe")
+
+	q, err := cmtquery.New(req.Query)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid query: %v", err)
+	}
+	if req.FromHeight < 0 {
+		return status.Error(codes.InvalidArgument, "Height cannot be negative")
+	}
+
+	client := rpcsub.PeerAddr(stream.Context())
+	if err := s.subscriptions.Acquire(client); err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	defer s.subscriptions.Release(client)
+
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+	logger = logger.With("traceID", traceID)
+
+	// nextHeight is the height of the first block whose events have not been
+	// replayed, or 0 if no events are replayed.
+	var nextHeight int64
+	if req.FromHeight > 0 {
+		lastHeight, err := s.lastHeight(logger)
+		if err != nil {
+			return err
+		}
+		if err := s.validateFromHeight(req.FromHeight, lastHeight); err != nil {
+			return err
+		}
+		// Replay most of the blocks before subscribing, so that the
+		// subscription does not fill up while replaying.
+		if nextHeight, err = s.replay(stream, q, req.FromHeight, lastHeight, logger); err != nil {
+			return err
+		}
+	}
+
+	// The trace ID is reused as a unique subscriber ID
+	sub, err := s.eventBus.Subscribe(stream.Context(), traceID, q, s.bufferSize)
+	if err != nil {
+		logger.Error("Cannot subscribe to events", "err", err)
+		return status.Errorf(codes.Internal, "Cannot subscribe to events (see logs for trace ID: %s)", traceID)
+	}
+	defer func() {
+		if err := s.eventBus.Unsubscribe(context.Background(), traceID, q); err != nil && !errors.Is(err, cmtpubsub.ErrSubscriptionNotFound) {
+			logger.Error("Error unsubscribing from events", "err", err)
+		}
+	}()
+
+	if nextHeight > 0 {
+		// Replay the blocks committed in the meantime. Their events may also
+		// have been published to the subscription, in which case they are
+		// skipped below.
+		lastHeight, err := s.lastHeight(logger)
+		if err != nil {
+			return err
+		}
+		if nextHeight, err = s.replay(stream, q, nextHeight, lastHeight, logger); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case msg := <-sub.Out():
+			res, err := newResponse(eventType(msg.Events()), msg.Data(), msg.Events())
+			if err != nil {
+				logger.Error("Failed to convert event", "err", err)
+				return status.Errorf(codes.Internal, "Failed to convert event (see logs for trace ID: %s)", traceID)
+			}
+			if isReplayable(res.Type) && res.Height < nextHeight {
+				continue
+			}
+			if err := stream.Send(res); err != nil {
+				logger.Error("Failed to stream event", "err", err, "height", res.Height)
+				return status.Errorf(codes.Unavailable, "Cannot send stream response (see logs for trace ID: %s)", traceID)
+			}
+		case <-sub.Canceled():
+			switch sub.Err() {
+			case cmtpubsub.ErrOutOfCapacity:
+				return status.Error(codes.ResourceExhausted, "Client is not keeping up with the events, resume the subscription from the height of the last event processed")
+			case cmtpubsub.ErrUnsubscribed:
+				return status.Error(codes.Canceled, "Subscription terminated")
+			case nil:
+				return status.Error(codes.Canceled, "Subscription canceled without errors")
+			default:
+				logger.Info("Subscription canceled with errors", "err", sub.Err())
+				return status.Errorf(codes.Canceled, "Subscription canceled with errors (see logs for trace ID: %s)", traceID)
+			}
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
+
+// lastHeight returns the height of the last block whose FinalizeBlock
+// response has been saved, which is the last block that can be replayed.
+func (s *eventServiceServer) lastHeight(logger log.Logger) (int64, error) {
+	state, err := s.stateStore.Load()
+	if err != nil {
+		logger.Error("Failed to load state", "err", err)
+		return 0, status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+	return state.LastBlockHeight, nil
+}
+
+func (s *eventServiceServer) validateFromHeight(fromHeight, lastHeight int64) error {
+	switch {
+	case fromHeight < s.blockStore.Base():
+		return status.Errorf(codes.OutOfRange, "Requested height %d is below base height %d", fromHeight, s.blockStore.Base())
+	case fromHeight > lastHeight+1:
+		return status.Errorf(codes.OutOfRange, "Requested height %d is higher than next height %d", fromHeight, lastHeight+1)
+	case s.maxReplayHeights > 0 && lastHeight-fromHeight+1 > s.maxReplayHeights:
+		return status.Errorf(codes.OutOfRange, "Cannot replay more than %d heights, from height %d to latest height %d",
+			s.maxReplayHeights, fromHeight, lastHeight)
+	}
+	return nil
+}
+
+// replay sends the events of the blocks from fromHeight to toHeight that
+// match q, in the order in which they are published, and returns the height
+// of the next block to replay.
+func (s *eventServiceServer) replay(
+	stream pbsvc.EventService_SubscribeServer,
+	q *cmtquery.Query,
+	fromHeight, toHeight int64,
+	logger log.Logger,
+) (int64, error) {
+	for height := fromHeight; height <= toHeight; height++ {
+		if err := stream.Context().Err(); err != nil {
+			return 0, status.FromContextError(err).Err()
+		}
+		events, err := s.blockEvents(height)
+		if err != nil {
+			logger.Error("Failed to load the events of a block", "err", err, "height", height)
+			return 0, status.Errorf(codes.FailedPrecondition, "Cannot replay the events at height %d: %v", height, err)
+		}
+		for _, event := range events {
+			keys := types.EventKeys(event.eventType, event.data)
+			match, err := q.Matches(keys)
+			if err != nil {
+				return 0, status.Errorf(codes.InvalidArgument, "Failed to match query: %v", err)
+			}
+			if !match {
+				continue
+			}
+			res, err := newResponse(event.eventType, event.data, keys)
+			if err != nil {
+				logger.Error("Failed to convert event", "err", err, "height", height)
+				return 0, status.Error(codes.Internal, "Internal server error - see logs for details")
+			}
+			res.Replayed = true
+			if err := stream.Send(res); err != nil {
+				logger.Error("Failed to stream event", "err", err, "height", height)
+				return 0, status.Error(codes.Unavailable, "Cannot send stream response")
+			}
+		}
+	}
+	return toHeight + 1, nil
+}
+
+type event struct {
+	eventType string
+	data      types.TMEventData
+}
+
+// blockEvents returns the events published when the block at the given
+// height was committed. If the FinalizeBlock response of the block is not
+// persisted, only its NewBlockHeader, NewEvidence and Tx events are returned,
+// with the results of the transactions loaded from the transaction indexer.
+func (s *eventServiceServer) blockEvents(height int64) ([]event, error) {
+	block, blockMeta := s.blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, errors.New("block not found")
+	}
+
+	res, err := s.stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil && !errors.Is(err, sm.ErrFinalizeBlockResponsesNotPersisted) {
+		return nil, err
+	}
+
+	events := make([]event, 0, 3+len(block.Evidence.Evidence)+len(block.Txs))
+	if res != nil {
+		events = append(events, event{types.EventNewBlock, types.EventDataNewBlock{
+			Block:               block,
+			BlockID:             blockMeta.BlockID,
+			ResultFinalizeBlock: *res,
+		}})
+	}
+	events = append(events, event{types.EventNewBlockHeader, types.EventDataNewBlockHeader{Header: block.Header}})
+	if res != nil {
+		events = append(events, event{types.EventNewBlockEvents, types.EventDataNewBlockEvents{
+			Height: height,
+			Events: res.Events,
+			NumTxs: int64(len(block.Txs)),
+		}})
+	}
+	for _, ev := range block.Evidence.Evidence {
+		events = append(events, event{types.EventNewEvidence, types.EventDataNewEvidence{Height: height, Evidence: ev}})
+	}
+	for i, tx := range block.Txs {
+		txResult, err := s.txResult(height, i, tx, res)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event{types.EventTx, types.EventDataTx{TxResult: *txResult}})
+	}
+	return events, nil
+}
+
+// txResult returns the result of the i-th transaction of the block at the
+// given height, from the FinalizeBlock response of the block if any, or from
+// the transaction indexer.
+func (s *eventServiceServer) txResult(height int64, i int, tx types.Tx, res *abci.FinalizeBlockResponse) (*abci.TxResult, error) {
+	if res != nil {
+		if i >= len(res.TxResults) {
+			return nil, errors.New("missing transaction results in FinalizeBlock response")
+		}
+		return &abci.TxResult{Height: height, Index: uint32(i), Tx: tx, Result: *res.TxResults[i]}, nil
+	}
+	txResult, err := s.txIndexer.Get(tx.Hash())
+	if err != nil {
+		return nil, err
+	}
+	// The indexer only keeps the last result of transactions included in
+	// several blocks.
+	if txResult == nil || txResult.Height != height || txResult.Index != uint32(i) {
+		return nil, errors.New("transaction results are neither persisted nor indexed")
+	}
+	return txResult, nil
+}
+
+// isReplayable reports whether events of the given type are replayed.
+func isReplayable(eventType string) bool {
+	switch eventType {
+	case types.EventNewBlock, types.EventNewBlockHeader, types.EventNewBlockEvents, types.EventNewEvidence, types.EventTx:
+		return true
+	}
+	return false
+}
+
+func eventType(keys map[string][]string) string {
+	if values := keys[types.EventTypeKey]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// newResponse converts an event to its Protobuf representation.
+func newResponse(eventType string, data any, keys map[string][]string) (*pbsvc.SubscribeResponse, error) {
+	res := &pbsvc.SubscribeResponse{
+		Type: eventType,
+		Keys: make([]*pbsvc.EventKey, 0, len(keys)),
+	}
+	for key, values := range keys {
+		res.Keys = append(res.Keys, &pbsvc.EventKey{Key: key, Values: values})
+	}
+	sort.Slice(res.Keys, func(i, j int) bool { return res.Keys[i].Key < res.Keys[j].Key })
+
+	switch data := data.(type) {
+	case types.EventDataNewBlock:
+		block, err := data.Block.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		blockID := data.BlockID.ToProto()
+		res.Height = data.Block.Height
+		res.Data = &pbsvc.SubscribeResponse_NewBlock{NewBlock: &pbsvc.NewBlock{
+			BlockId:             &blockID,
+			Block:               block,
+			ResultFinalizeBlock: &data.ResultFinalizeBlock,
+		}}
+	case types.EventDataNewBlockHeader:
+		res.Height = data.Header.Height
+		res.Data = &pbsvc.SubscribeResponse_NewBlockHeader{NewBlockHeader: data.Header.ToProto()}
+	case types.EventDataNewBlockEvents:
+		res.Height = data.Height
+		events := make([]*abci.Event, len(data.Events))
+		for i := range data.Events {
+			events[i] = &data.Events[i]
+		}
+		res.Data = &pbsvc.SubscribeResponse_NewBlockEvents{NewBlockEvents: &pbsvc.NewBlockEvents{
+			Height: data.Height,
+			Events: events,
+			NumTxs: data.NumTxs,
+		}}
+	case types.EventDataNewEvidence:
+		evidence, err := types.EvidenceToProto(data.Evidence)
+		if err != nil {
+			return nil, err
+		}
+		res.Height = data.Height
+		res.Data = &pbsvc.SubscribeResponse_NewEvidence{NewEvidence: &pbsvc.NewEvidence{
+			Height:   data.Height,
+			Evidence: evidence,
+		}}
+	case types.EventDataTx:
+		res.Height = data.Height
+		res.Data = &pbsvc.SubscribeResponse_Tx{Tx: &data.TxResult}
+	default:
+		bz, err := cmtjson.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		res.Data = &pbsvc.SubscribeResponse_Json{Json: bz}
+	}
+	return res, nil
+}
@@ -0,0 +1,291 @@
+package mempoolservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abcipb "github.com/cometbft/cometbft/api/cometbft/abci/v2"
+	pbsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
+	abcicli "github.com/cometbft/cometbft/v2/abci/client"
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/internal/rpcsub"
+	"github.com/cometbft/cometbft/v2/internal/rpctrace"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	cmtpubsub "github.com/cometbft/cometbft/v2/libs/pubsub"
+	cmtquery "github.com/cometbft/cometbft/v2/libs/pubsub/query"
+	mempl "github.com/cometbft/cometbft/v2/mempool"
+	"github.com/cometbft/cometbft/v2/p2p"
+	"github.com/cometbft/cometbft/v2/proxy"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+const (
+	defaultLimit = 30
+	maxLimit     = 100
+)
+
+// Reactor is the part of the mempool reactor used by the mempool service to
+// add transactions to the mempool.
+type Reactor interface {
+	// WaitSync reports whether the node is still catching up, in which case
+	// transactions cannot be added to the mempool.
+	WaitSync() bool
+	// TryAddTx adds a transaction to the mempool. A nil sender means that the
+	// transaction comes from a client.
+	TryAddTx(tx types.Tx, sender p2p.Peer) (*abcicli.ReqRes, error)
+}
+
+type mempoolServiceServer struct {
+	reactor       Reactor
+	mempool       mempl.Mempool
+	proxyApp      proxy.AppConnMempool
+	eventBus      *types.EventBus
+	subscriptions *rpcsub.Limiter
+	commitTimeout time.Duration
+	logger        log.Logger
+}
+
+// New creates a new CometBFT mempool service server. In the commit broadcast
+// mode, BroadcastTx waits up to commitTimeout for the transaction to be
+// committed. The subscriptions to transaction results, including the ones of
+// the commit broadcast mode, are limited by subscriptions.
+func New(
+	reactor Reactor,
+	mempool mempl.Mempool,
+	proxyApp proxy.AppConnMempool,
+	eventBus *types.EventBus,
+	subscriptions *rpcsub.Limiter,
+	commitTimeout time.Duration,
+	logger log.Logger,
+) pbsvc.MempoolServiceServer {
+	return &mempoolServiceServer{
+		reactor:       reactor,
+		mempool:       mempool,
+		proxyApp:      proxyApp,
+		eventBus:      eventBus,
+		subscriptions: subscriptions,
+		commitTimeout: commitTimeout,
+		logger:        logger.With("service", "MempoolService"),
+	}
+}
+
+// BroadcastTx implements v1.MempoolServiceServer.
+func (s *mempoolServiceServer) BroadcastTx(ctx context.Context, req *pbsvc.BroadcastTxRequest) (*pbsvc.BroadcastTxResponse, error) {
+	logger := s.logger.With("endpoint", "BroadcastTx")
+	if req.Mode != pbsvc.BroadcastMode_BROADCAST_MODE_SYNC && req.Mode != pbsvc.BroadcastMode_BROADCAST_MODE_COMMIT {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid broadcast mode %s", req.Mode)
+	}
+	if len(req.Tx) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Transaction cannot be empty")
+	}
+	if s.reactor.WaitSync() {
+		return nil, status.Error(codes.Unavailable, "Cannot broadcast transactions while the node is catching up")
+	}
+	tx := types.Tx(req.Tx)
+
+	// Subscribe before adding the transaction to the mempool, so as not to
+	// miss its result.
+	var txSub types.Subscription
+	if req.Mode == pbsvc.BroadcastMode_BROADCAST_MODE_COMMIT {
+		client := rpcsub.PeerAddr(ctx)
+		if err := s.subscriptions.Acquire(client); err != nil {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		defer s.subscriptions.Release(client)
+
+		traceID, err := rpctrace.New()
+		if err != nil {
+			logger.Error("Error generating RPC trace ID", "err", err)
+			return nil, status.Error(codes.Internal, "Internal server error - see logs for details")
+		}
+		q := types.EventQueryTxFor(tx)
+		// The trace ID is reused as a unique subscriber ID
+		txSub, err = s.eventBus.Subscribe(ctx, traceID, q)
+		if err != nil {
+			logger.Error("Cannot subscribe to transaction events", "err", err, "traceID", traceID)
+			return nil, status.Errorf(codes.Internal, "Cannot subscribe to transaction events (see logs for trace ID: %s)", traceID)
+		}
+		defer func() {
+			if err := s.eventBus.Unsubscribe(context.Background(), traceID, q); err != nil && !errors.Is(err, cmtpubsub.ErrSubscriptionNotFound) {
+				logger.Error("Error unsubscribing from transaction events", "err", err, "traceID", traceID)
+			}
+		}()
+	}
+
+	checkTxRes, err := s.addTx(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	res := &pbsvc.BroadcastTxResponse{
+		Hash:    tx.Hash(),
+		CheckTx: checkTxRes,
+	}
+	if txSub == nil || checkTxRes.Code != abci.CodeTypeOK {
+		return res, nil
+	}
+
+	timer := time.NewTimer(s.commitTimeout)
+	defer timer.Stop()
+	select {
+	case msg := <-txSub.Out():
+		txEvent := msg.Data().(types.EventDataTx)
+		res.TxResult = &txEvent.Result
+		res.Height = txEvent.Height
+		return res, nil
+	case <-txSub.Canceled():
+		logger.Info("Transaction subscription canceled", "err", txSub.Err())
+		return nil, status.Error(codes.Unavailable, "Subscription canceled before the transaction was committed")
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case <-timer.C:
+		return nil, status.Errorf(codes.DeadlineExceeded, "Timed out waiting for the transaction to be committed after %s", s.commitTimeout)
+	}
+}
+
+// addTx adds tx to the mempool, and returns the result of CheckTx.
+func (s *mempoolServiceServer) addTx(ctx context.Context, tx types.Tx) (*abcipb.CheckTxResponse, error) {
+	reqRes, err := s.reactor.TryAddTx(tx, nil)
+	if err != nil {
+		return nil, addTxError(err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		// The ABCI client guarantees that it will eventually call
+		// reqRes.Done(), even in the case of error.
+		reqRes.Wait()
+		close(done)
+	}()
+	select {
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case <-done:
+	}
+	if err := reqRes.Error(); err != nil {
+		s.logger.Error("Error checking transaction", "err", err, "tx", tx.Hash())
+		return nil, status.Errorf(codes.Internal, "Error checking transaction: %v", err)
+	}
+	return reqRes.Response.GetCheckTx(), nil
+}
+
+// addTxError converts an error returned by the mempool to a gRPC status.
+func addTxError(err error) error {
+	switch {
+	case errors.Is(err, mempl.ErrTxInCache), errors.Is(err, mempl.ErrTxInMempool):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.As(err, &mempl.ErrMempoolIsFull{}), errors.As(err, &mempl.ErrLaneIsFull{}),
+		errors.Is(err, mempl.ErrRecheckFull):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.As(err, &mempl.ErrTxTooLarge{}), errors.As(err, &mempl.ErrPreCheck{}):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "Cannot add transaction to the mempool: %v", err)
+	}
+}
+
+// CheckTx implements v1.MempoolServiceServer.
+func (s *mempoolServiceServer) CheckTx(ctx context.Context, req *pbsvc.CheckTxRequest) (*pbsvc.CheckTxResponse, error) {
+	if len(req.Tx) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Transaction cannot be empty")
+	}
+	res, err := s.proxyApp.CheckTx(ctx, &abci.CheckTxRequest{Tx: req.Tx, Type: abci.CHECK_TX_TYPE_CHECK})
+	if err != nil {
+		s.logger.Error("Error checking transaction", "endpoint", "CheckTx", "err", err)
+		return nil, status.Errorf(codes.Internal, "Error checking transaction: %v", err)
+	}
+	return &pbsvc.CheckTxResponse{CheckTx: res}, nil
+}
+
+// UnconfirmedTxs implements v1.MempoolServiceServer.
+func (s *mempoolServiceServer) UnconfirmedTxs(_ context.Context, req *pbsvc.UnconfirmedTxsRequest) (*pbsvc.UnconfirmedTxsResponse, error) {
+	limit := int(req.Limit)
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "Limit cannot be negative")
+	case limit == 0:
+		limit = defaultLimit
+	case limit > maxLimit:
+		limit = maxLimit
+	}
+
+	txs := s.mempool.ReapMaxTxs(limit)
+	res := &pbsvc.UnconfirmedTxsResponse{
+		Count:      int64(len(txs)),
+		Total:      int64(s.mempool.Size()),
+		TotalBytes: s.mempool.SizeBytes(),
+		Txs:        make([][]byte, len(txs)),
+	}
+	for i, tx := range txs {
+		res.Txs[i] = tx
+	}
+	return res, nil
+}
+
+// SubscribeTxResult implements v1.MempoolServiceServer.
+func (s *mempoolServiceServer) SubscribeTxResult(req *pbsvc.SubscribeTxResultRequest, stream pbsvc.MempoolService_SubscribeTxResultServer) error {
+	logger := s.logger.With("endpoint", "SubscribeTxResult")
+
+	client := rpcsub.PeerAddr(stream.Context())
+	if err := s.subscriptions.Acquire(client); err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	defer s.subscriptions.Release(client)
+
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+
+	q := types.EventQueryTx
+	if len(req.Hash) > 0 {
+		q = cmtquery.MustCompile(fmt.Sprintf("%s='%s' AND %s='%X'", types.EventTypeKey, types.EventTx, types.TxHashKey, req.Hash))
+	}
+	// The trace ID is reused as a unique subscriber ID
+	sub, err := s.eventBus.Subscribe(stream.Context(), traceID, q)
+	if err != nil {
+		logger.Error("Cannot subscribe to transaction events", "err", err, "traceID", traceID)
+		return status.Errorf(codes.Internal, "Cannot subscribe to transaction events (see logs for trace ID: %s)", traceID)
+	}
+	defer func() {
+		if err := s.eventBus.Unsubscribe(context.Background(), traceID, q); err != nil && !errors.Is(err, cmtpubsub.ErrSubscriptionNotFound) {
+			logger.Error("Error unsubscribing from transaction events", "err", err, "traceID", traceID)
+		}
+	}()
+
+	for {
+		select {
+		case msg := <-sub.Out():
+			txEvent := msg.Data().(types.EventDataTx)
+			txResult := txEvent.TxResult
+			res := &pbsvc.SubscribeTxResultResponse{
+				Hash:     types.Tx(txResult.Tx).Hash(),
+				TxResult: &txResult,
+			}
+			if err := stream.Send(res); err != nil {
+				logger.Error("Failed to stream transaction result", "err", err, "traceID", traceID)
+				return status.Errorf(codes.Unavailable, "Cannot send stream response (see logs for trace ID: %s)", traceID)
+			}
+			if len(req.Hash) > 0 {
+				return nil
+			}
+		case <-sub.Canceled():
+			switch sub.Err() {
+			case cmtpubsub.ErrUnsubscribed:
+				return status.Error(codes.Canceled, "Subscription terminated")
+			case nil:
+				return status.Error(codes.Canceled, "Subscription canceled without errors")
+			default:
+				logger.Info("Subscription canceled with errors", "err", sub.Err(), "traceID", traceID)
+				return status.Errorf(codes.Canceled, "Subscription canceled with errors (see logs for trace ID: %s)", traceID)
+			}
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
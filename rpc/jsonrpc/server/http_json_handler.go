@@ -18,7 +18,7 @@ import (
 // HTTP + JSON handler
 
 // jsonrpc calls grab the given method's function info and runs reflect.Call.
-func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.HandlerFunc {
+func makeJSONRPCHandler(funcMap map[string]*RPCFunc, rateLimiter *RateLimiter, logger log.Logger) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		b, err := io.ReadAll(r.Body)
 		if err != nil {
@@ -61,6 +61,7 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 		// 2. Any RPC request doesn't allow to be cached.
 		// 3. Any RPC request has the height argument and the value is 0 (the default).
 		cache := true
+		rateLimited := 0
 		for _, req := range requests {
 			request := req
 			// A Notification is a Request object without an "id" member.
@@ -87,6 +88,12 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 				cache = false
 				continue
 			}
+			if err := rateLimiter.Allow(r.RemoteAddr, request.Method); err != nil {
+				responses = append(responses, types.RPCRateLimitError(request.ID, err))
+				rateLimited++
+				cache = false
+				continue
+			}
 			ctx := &types.Context{JSONReq: &request, HTTPReq: r}
 			args := []reflect.Value{reflect.ValueOf(ctx)}
 			if len(request.Params) > 0 {
@@ -115,6 +122,15 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 			responses = append(responses, types.NewRPCSuccessResponse(request.ID, result))
 		}
 
+		// If the only call was rejected by the rate limiter, report it with the
+		// corresponding HTTP status code.
+		if len(responses) == 1 && rateLimited == 1 {
+			if wErr := WriteRPCResponseHTTPError(w, http.StatusTooManyRequests, responses[0]); wErr != nil {
+				logger.Error("failed to write response", "err", wErr)
+			}
+			return
+		}
+
 		if len(responses) > 0 {
 			var wErr error
 			if cache {
@@ -0,0 +1,23 @@
+package server
+
+import (
+	"github.com/cometbft/cometbft/v2/libs/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "rpc"
+)
+
+//go:generate go run ../../../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of calls rejected by the rate limiter, by method and by the limit
+	// that was hit (ip or method).
+	ThrottledCalls metrics.Counter `metrics_labels:"method, limit"`
+
+	// Number of remote IPs tracked by the rate limiter.
+	Clients metrics.Gauge `metrics_name:"rate_limited_clients"`
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// limiterSweepInterval is how often the per-client buckets of the clients that
+// have been idle long enough to refill their buckets are dropped.
+const limiterSweepInterval = time.Minute
+
+// RateLimit is a token bucket rate limit: Rate tokens are added to the bucket
+// per second, up to Burst tokens.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimiterConfig is the configuration of a RateLimiter.
+type RateLimiterConfig struct {
+	// Limit on the calls of each remote IP. Each call consumes the cost of its
+	// method. A zero Rate disables the limit.
+	PerIP RateLimit
+	// Limits on the calls of the given methods, shared by all clients. Each
+	// call consumes a single token.
+	Methods map[string]RateLimit
+	// Cost of the calls of the given methods. Methods not in the map cost 1.
+	// Costs higher than the burst of the per-IP limit are capped to it.
+	MethodCosts map[string]int
+}
+
+// ErrRateLimited is returned when a call is rejected by a RateLimiter.
+type ErrRateLimited struct {
+	Method   string
+	PerIP    bool // whether the per-IP or the per-method limit was hit
+	RemoteIP string
+}
+
+func (e ErrRateLimited) Error() string {
+	if e.PerIP {
+		return fmt.Sprintf("rate limit exceeded for %s (calling %s)", e.RemoteIP, e.Method)
+	}
+	return "rate limit exceeded for method " + e.Method
+}
+
+// tokenBucket is the state of a token bucket.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// refill adds the tokens accumulated since the last refill.
+func (b *tokenBucket) refill(limit RateLimit, now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * limit.Rate
+	if b.tokens > float64(limit.Burst) {
+		b.tokens = float64(limit.Burst)
+	}
+	b.last = now
+}
+
+// RateLimiter limits the rate of the RPC calls, per remote IP and per method,
+// with token buckets. It is safe for concurrent use. A nil *RateLimiter
+// allows all calls.
+type RateLimiter struct {
+	config  RateLimiterConfig
+	metrics *Metrics
+	now     func() time.Time
+
+	mtx       sync.Mutex
+	clients   map[string]*tokenBucket
+	methods   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+// NewRateLimiter returns a RateLimiter enforcing config. If metrics is nil,
+// no metrics are recorded.
+func NewRateLimiter(config RateLimiterConfig, metrics *Metrics) *RateLimiter {
+	if metrics == nil {
+		metrics = NopMetrics()
+	}
+	rl := &RateLimiter{
+		config:  config,
+		metrics: metrics,
+		now:     time.Now,
+		clients: make(map[string]*tokenBucket),
+		methods: make(map[string]*tokenBucket),
+	}
+	rl.lastSweep = rl.now()
+	return rl
+}
+
+// Allow consumes the tokens of a call of method by the client at remoteAddr
+// (either an IP or a host:port address). It returns ErrRateLimited if the call
+// must be rejected, in which case no tokens are consumed.
+func (rl *RateLimiter) Allow(remoteAddr, method string) error {
+	if rl == nil {
+		return nil
+	}
+
+	ip := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		ip = host
+	}
+
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	now := rl.now()
+	if now.Sub(rl.lastSweep) >= limiterSweepInterval {
+		rl.sweep(now)
+	}
+
+	var client *tokenBucket
+	cost := 0.0
+	if rl.config.PerIP.Rate > 0 {
+		client = rl.clients[ip]
+		if client == nil {
+			client = &tokenBucket{tokens: float64(rl.config.PerIP.Burst), last: now}
+			rl.clients[ip] = client
+			rl.metrics.Clients.Set(float64(len(rl.clients)))
+		}
+		client.refill(rl.config.PerIP, now)
+		cost = min(float64(rl.methodCost(method)), float64(rl.config.PerIP.Burst))
+		if client.tokens < cost {
+			rl.metrics.ThrottledCalls.With("method", method, "limit", "ip").Add(1)
+			return ErrRateLimited{Method: method, PerIP: true, RemoteIP: ip}
+		}
+	}
+
+	if limit, ok := rl.config.Methods[method]; ok && limit.Rate > 0 {
+		bucket := rl.methods[method]
+		if bucket == nil {
+			bucket = &tokenBucket{tokens: float64(limit.Burst), last: now}
+			rl.methods[method] = bucket
+		}
+		bucket.refill(limit, now)
+		if bucket.tokens < 1 {
+			rl.metrics.ThrottledCalls.With("method", method, "limit", "method").Add(1)
+			return ErrRateLimited{Method: method}
+		}
+		bucket.tokens--
+	}
+
+	if client != nil {
+		client.tokens -= cost
+	}
+	return nil
+}
+
+// methodCost returns the number of per-IP tokens consumed by a call of
+// method.
+func (rl *RateLimiter) methodCost(method string) int {
+	if cost, ok := rl.config.MethodCosts[method]; ok {
+		return cost
+	}
+	return 1
+}
+
+// sweep drops the buckets of the clients whose buckets are full, which are the
+// same as new buckets.
+func (rl *RateLimiter) sweep(now time.Time) {
+	for ip, client := range rl.clients {
+		client.refill(rl.config.PerIP, now)
+		if client.tokens >= float64(rl.config.PerIP.Burst) {
+			delete(rl.clients, ip)
+		}
+	}
+	rl.lastSweep = now
+	rl.metrics.Clients.Set(float64(len(rl.clients)))
+}
@@ -0,0 +1,186 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/v2/libs/log"
+	"github.com/cometbft/cometbft/v2/rpc/jsonrpc/types"
+)
+
+// newTestRateLimiter returns a rate limiter whose clock is advanced by the
+// returned function.
+func newTestRateLimiter(config RateLimiterConfig) (*RateLimiter, func(time.Duration)) {
+	now := time.Unix(1_700_000_000, 0)
+	rl := NewRateLimiter(config, nil)
+	rl.now = func() time.Time { return now }
+	rl.lastSweep = now
+	return rl, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestRateLimiterPerIP(t *testing.T) {
+	rl, advance := newTestRateLimiter(RateLimiterConfig{
+		PerIP:       RateLimit{Rate: 2, Burst: 4},
+		MethodCosts: map[string]int{"tx_search": 3, "huge": 100},
+	})
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, rl.Allow("1.2.3.4:1000", "status"))
+	}
+	err := rl.Allow("1.2.3.4:1001", "status")
+	var e ErrRateLimited
+	require.ErrorAs(t, err, &e)
+	assert.True(t, e.PerIP)
+	assert.Equal(t, "1.2.3.4", e.RemoteIP)
+
+	// Other clients have their own buckets.
+	require.NoError(t, rl.Allow("5.6.7.8:1000", "status"))
+
+	// Tokens are added at the configured rate.
+	advance(500 * time.Millisecond)
+	require.NoError(t, rl.Allow("1.2.3.4:1000", "status"))
+	require.Error(t, rl.Allow("1.2.3.4:1000", "status"))
+
+	// Expensive methods consume several tokens, and rejected calls do not
+	// consume any.
+	advance(time.Second)
+	require.Error(t, rl.Allow("1.2.3.4:1000", "tx_search"))
+	require.NoError(t, rl.Allow("1.2.3.4:1000", "status"))
+	advance(time.Second)
+	require.NoError(t, rl.Allow("1.2.3.4:1000", "tx_search"))
+
+	// Costs higher than the burst are capped, so that the method can still
+	// be called.
+	advance(time.Hour)
+	require.NoError(t, rl.Allow("1.2.3.4:1000", "huge"))
+	require.Error(t, rl.Allow("1.2.3.4:1000", "status"))
+}
+
+func TestRateLimiterPerMethod(t *testing.T) {
+	rl, advance := newTestRateLimiter(RateLimiterConfig{
+		Methods: map[string]RateLimit{"tx_search": {Rate: 1, Burst: 2}},
+	})
+
+	require.NoError(t, rl.Allow("1.2.3.4:1000", "tx_search"))
+	require.NoError(t, rl.Allow("5.6.7.8:1000", "tx_search"))
+
+	// The limit is shared by all the clients.
+	err := rl.Allow("9.9.9.9:1000", "tx_search")
+	var e ErrRateLimited
+	require.ErrorAs(t, err, &e)
+	assert.False(t, e.PerIP)
+	assert.Equal(t, "tx_search", e.Method)
+
+	// Other methods are not limited.
+	for i := 0; i < 100; i++ {
+		require.NoError(t, rl.Allow("9.9.9.9:1000", "status"))
+	}
+
+	advance(time.Second)
+	require.NoError(t, rl.Allow("9.9.9.9:1000", "tx_search"))
+}
+
+func TestRateLimiterSweep(t *testing.T) {
+	rl, advance := newTestRateLimiter(RateLimiterConfig{
+		PerIP: RateLimit{Rate: 1, Burst: 10},
+	})
+
+	require.NoError(t, rl.Allow("1.2.3.4:1000", "status"))
+	require.NoError(t, rl.Allow("5.6.7.8:1000", "status"))
+	assert.Len(t, rl.clients, 2)
+
+	// The buckets of the idle clients are dropped once they are full.
+	advance(limiterSweepInterval)
+	require.NoError(t, rl.Allow("5.6.7.8:1000", "status"))
+	assert.Len(t, rl.clients, 1)
+	assert.Contains(t, rl.clients, "5.6.7.8")
+}
+
+func TestRateLimiterNil(t *testing.T) {
+	var rl *RateLimiter
+	require.NoError(t, rl.Allow("1.2.3.4:1000", "status"))
+}
+
+func TestRateLimitedHandlers(t *testing.T) {
+	funcMap := map[string]*RPCFunc{
+		"c": NewRPCFunc(func(_ *types.Context, _ string, _ int) (string, error) { return "foo", nil }, "s,i"),
+	}
+	rl := NewRateLimiter(RateLimiterConfig{PerIP: RateLimit{Rate: 0.001, Burst: 2}}, nil)
+	mux := http.NewServeMux()
+	RegisterRPCFuncs(mux, funcMap, log.NewLogger(new(bytes.Buffer)), WithRateLimiter(rl))
+
+	call := func(req *http.Request) (int, *types.RPCResponse) {
+		req.RemoteAddr = "1.2.3.4:1000"
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		res := rec.Result()
+		defer res.Body.Close()
+		blob, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		recv := new(types.RPCResponse)
+		require.NoError(t, json.Unmarshal(blob, recv), "blob: %s", blob)
+		return res.StatusCode, recv
+	}
+	jsonReq := func() *http.Request {
+		return httptest.NewRequest(http.MethodPost, "http://localhost/",
+			strings.NewReader(`{"jsonrpc": "2.0", "method": "c", "id": "0", "params": ["a", "10"]}`))
+	}
+	uriReq := func() *http.Request {
+		return httptest.NewRequest(http.MethodGet, "http://localhost/c?s=\"a\"&i=10", nil)
+	}
+
+	code, res := call(jsonReq())
+	assert.Equal(t, http.StatusOK, code)
+	assert.Nil(t, res.Error)
+	code, res = call(uriReq())
+	assert.Equal(t, http.StatusOK, code)
+	assert.Nil(t, res.Error)
+
+	for _, req := range []*http.Request{jsonReq(), uriReq()} {
+		code, res = call(req)
+		assert.Equal(t, http.StatusTooManyRequests, code)
+		require.NotNil(t, res.Error)
+		assert.Equal(t, -32005, res.Error.Code)
+	}
+}
+
+func TestRateLimitedWebsocket(t *testing.T) {
+	funcMap := map[string]*RPCFunc{
+		"c": NewWSRPCFunc(func(_ *types.Context, _ string, _ int) (string, error) { return "foo", nil }, "s,i"),
+	}
+	rl := NewRateLimiter(RateLimiterConfig{PerIP: RateLimit{Rate: 0.001, Burst: 1}}, nil)
+	wm := NewWebsocketManager(funcMap, RateLimiting(rl))
+	wm.SetLogger(log.TestingLogger())
+	mux := http.NewServeMux()
+	mux.HandleFunc("/websocket", wm.WebsocketHandler)
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	c, dialResp, err := websocket.DefaultDialer.Dial("ws://"+s.Listener.Addr().String()+"/websocket", nil)
+	require.NoError(t, err)
+	defer dialResp.Body.Close()
+	defer c.Close()
+
+	req, err := types.MapToRequest(types.JSONRPCStringID("ws"), "c", map[string]any{"s": "a", "i": 10})
+	require.NoError(t, err)
+
+	require.NoError(t, c.WriteJSON(req))
+	var resp types.RPCResponse
+	require.NoError(t, c.ReadJSON(&resp))
+	require.Nil(t, resp.Error)
+
+	require.NoError(t, c.WriteJSON(req))
+	require.NoError(t, c.ReadJSON(&resp))
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, -32005, resp.Error.Code)
+}
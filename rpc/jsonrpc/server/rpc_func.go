@@ -9,21 +9,41 @@ import (
 	"github.com/cometbft/cometbft/v2/libs/log"
 )
 
+// RegisterOption sets an optional parameter of the handlers registered by
+// RegisterRPCFuncs.
+type RegisterOption func(*handlerOptions)
+
+type handlerOptions struct {
+	rateLimiter *RateLimiter
+}
+
+// WithRateLimiter rejects the calls that exceed the limits of rl.
+func WithRateLimiter(rl *RateLimiter) RegisterOption {
+	return func(o *handlerOptions) {
+		o.rateLimiter = rl
+	}
+}
+
 // RegisterRPCFuncs adds a route for each function in the funcMap, as well as
 // general jsonrpc and websocket handlers for all functions. "result" is the
 // interface on which the result objects are registered, and is popualted with
 // every RPCResponse.
-func RegisterRPCFuncs(mux *http.ServeMux, funcMap map[string]*RPCFunc, logger log.Logger) {
+func RegisterRPCFuncs(mux *http.ServeMux, funcMap map[string]*RPCFunc, logger log.Logger, options ...RegisterOption) {
+	opts := &handlerOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+
 	// HTTP endpoints
 	for funcName, rpcFunc := range funcMap {
-		mux.HandleFunc("/"+funcName, makeHTTPHandler(rpcFunc, logger))
-		mux.HandleFunc("/v1/"+funcName, makeHTTPHandler(rpcFunc, logger))
+		mux.HandleFunc("/"+funcName, makeHTTPHandler(funcName, rpcFunc, opts.rateLimiter, logger))
+		mux.HandleFunc("/v1/"+funcName, makeHTTPHandler(funcName, rpcFunc, opts.rateLimiter, logger))
 	}
 
 	// JSONRPC endpoints
-	mux.HandleFunc("/", handleInvalidJSONRPCPaths(makeJSONRPCHandler(funcMap, logger)))
-	mux.HandleFunc("/v1", handleInvalidJSONRPCPaths(makeJSONRPCHandler(funcMap, logger)))
-	mux.HandleFunc("/v1/", handleInvalidJSONRPCPaths(makeJSONRPCHandler(funcMap, logger)))
+	mux.HandleFunc("/", handleInvalidJSONRPCPaths(makeJSONRPCHandler(funcMap, opts.rateLimiter, logger)))
+	mux.HandleFunc("/v1", handleInvalidJSONRPCPaths(makeJSONRPCHandler(funcMap, opts.rateLimiter, logger)))
+	mux.HandleFunc("/v1/", handleInvalidJSONRPCPaths(makeJSONRPCHandler(funcMap, opts.rateLimiter, logger)))
 }
 
 type Option func(*RPCFunc)
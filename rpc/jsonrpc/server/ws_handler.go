@@ -135,6 +135,9 @@ type wsConnection struct {
 	// callback which is called upon disconnect
 	onDisconnect func(remoteAddr string)
 
+	// limits the rate of the calls, if not nil
+	rateLimiter *RateLimiter
+
 	ctx    context.Context
 	cancel context.CancelFunc
 }
@@ -216,6 +219,14 @@ func ReadLimit(readLimit int64) func(*wsConnection) {
 	}
 }
 
+// RateLimiting rejects the calls that exceed the limits of rl.
+// It should only be used in the constructor - not Goroutine-safe.
+func RateLimiting(rl *RateLimiter) func(*wsConnection) {
+	return func(wsc *wsConnection) {
+		wsc.rateLimiter = rl
+	}
+}
+
 // OnStart implements service.Service by starting the read and write routines. It
 // blocks until there's some error.
 func (wsc *wsConnection) OnStart() error {
@@ -362,6 +373,13 @@ func (wsc *wsConnection) readRoutine() {
 				continue
 			}
 
+			if err := wsc.rateLimiter.Allow(wsc.remoteAddr, request.Method); err != nil {
+				if err := wsc.WriteRPCResponse(writeCtx, types.RPCRateLimitError(request.ID, err)); err != nil {
+					wsc.Logger.Error("Error writing RPC response", "err", err)
+				}
+				continue
+			}
+
 			ctx := &types.Context{JSONReq: &request, WSConn: wsc}
 			args := []reflect.Value{reflect.ValueOf(ctx)}
 			if len(request.Params) > 0 {
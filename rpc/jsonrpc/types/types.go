@@ -246,6 +246,10 @@ func RPCServerError(id jsonrpcid, err error) RPCResponse {
 	return NewRPCErrorResponse(id, -32000, "Server error", err.Error())
 }
 
+func RPCRateLimitError(id jsonrpcid, err error) RPCResponse {
+	return NewRPCErrorResponse(id, -32005, "Rate limit exceeded", err.Error())
+}
+
 // ----------------------------------------
 
 // WSRPCConnection represents a websocket connection.
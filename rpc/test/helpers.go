@@ -94,6 +94,7 @@ func createConfig() *cfg.Config {
 	// Set pruning interval to a value lower than the default for some of the
 	// tests that rely on pruning to occur quickly
 	c.Storage.Pruning.Interval = 100 * time.Millisecond
+	c.Mempool.TxTraceSize = 1000
 	return c
 }
 
@@ -9,8 +9,10 @@ import (
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/crypto/bls12381"
 	"github.com/cometbft/cometbft/v2/internal/fail"
 	"github.com/cometbft/cometbft/v2/libs/log"
+	cmtsync "github.com/cometbft/cometbft/v2/libs/sync"
 	"github.com/cometbft/cometbft/v2/mempool"
 	"github.com/cometbft/cometbft/v2/proxy"
 	"github.com/cometbft/cometbft/v2/types"
@@ -46,6 +48,10 @@ type BlockExecutor struct {
 	// 1-element cache of validated blocks
 	lastValidatedBlock *types.Block
 
+	// the latest execution of a proposed block started before it is decided
+	optimisticMtx cmtsync.Mutex
+	optimistic    *optimisticExecution
+
 	logger log.Logger
 
 	metrics *Metrics
@@ -136,6 +142,15 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxReapBytes, maxGas)
 	commit := lastExtCommit.ToCommit()
+	if height > state.InitialHeight &&
+		state.ConsensusParams.Feature.BlsAggregatedCommitsEnabled(height) &&
+		allKeysAreBls12381(state.LastValidators) {
+		aggregated, err := commit.AggregateSignatures()
+		if err != nil {
+			return nil, err
+		}
+		commit = aggregated
+	}
 	block := state.MakeBlock(height, txs, commit, evidence, proposerAddr)
 	rpp, err := blockExec.proxyApp.PrepareProposal(
 		ctx,
@@ -170,6 +185,14 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 	return state.MakeBlock(height, txl, commit, evidence, proposerAddr), nil
 }
 
+// allKeysAreBls12381 returns true if all the validators of vals have
+// BLS12-381 keys, in which case the signatures of their commits can be
+// aggregated.
+func allKeysAreBls12381(vals *types.ValidatorSet) bool {
+	return !vals.IsNilOrEmpty() && vals.AllKeysHaveSameType() &&
+		vals.Validators[0].PubKey.Type() == bls12381.KeyType
+}
+
 func (blockExec *BlockExecutor) ProcessProposal(
 	block *types.Block,
 	state State,
@@ -234,17 +257,7 @@ func (blockExec *BlockExecutor) ApplyBlock(
 }
 
 func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, block *types.Block, syncingToHeight int64) (State, error) {
-	abciResponse, err := blockExec.proxyApp.FinalizeBlock(context.TODO(), &abci.FinalizeBlockRequest{
-		Hash:               block.Hash(),
-		NextValidatorsHash: block.NextValidatorsHash,
-		ProposerAddress:    block.ProposerAddress,
-		Height:             block.Height,
-		Time:               block.Time,
-		DecidedLastCommit:  buildLastCommitInfoFromStore(block, blockExec.store, state.InitialHeight),
-		Misbehavior:        block.Evidence.Evidence.ToABCI(),
-		Txs:                block.Txs.ToSliceOfBytes(),
-		SyncingToHeight:    syncingToHeight,
-	})
+	abciResponse, err := blockExec.finalizeBlock(state, block, syncingToHeight)
 	if err != nil {
 		blockExec.logger.Error("Error in proxyAppConn.FinalizeBlock", "err", err)
 		return state, err
@@ -3,6 +3,7 @@ package state_test
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -79,6 +80,80 @@ func TestApplyBlock(t *testing.T) {
 	assert.EqualValues(t, 1, state.Version.Consensus.App, "App version wasn't updated")
 }
 
+// finalizeRecordingApp records the hashes of the blocks it is sent
+// FinalizeBlock for, and returns them as app hashes.
+type finalizeRecordingApp struct {
+	testApp
+
+	mtx    sync.Mutex
+	hashes [][]byte
+}
+
+func (app *finalizeRecordingApp) FinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+	app.mtx.Lock()
+	app.hashes = append(app.hashes, req.Hash)
+	app.mtx.Unlock()
+	resp, err := app.testApp.FinalizeBlock(ctx, req)
+	resp.AppHash = req.Hash
+	return resp, err
+}
+
+func TestApplyBlockOptimisticExecution(t *testing.T) {
+	testCases := map[string]struct {
+		decided bool // whether the block executed optimistically is decided
+	}{
+		"decided block":     {decided: true},
+		"not decided block": {decided: false},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			app := &finalizeRecordingApp{}
+			proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app), proxy.NopMetrics())
+			require.NoError(t, proxyApp.Start())
+			defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+			state, stateDB, _ := makeState(1, 1, chainID)
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				DiscardABCIResponses: false,
+			})
+			mp := &mpmocks.Mempool{}
+			mp.On("Lock").Return()
+			mp.On("Unlock").Return()
+			mp.On("PreUpdate").Return()
+			mp.On("FlushAppConn", mock.Anything).Return(nil)
+			mp.On("Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+				mp, sm.EmptyEvidencePool{}, store.NewBlockStore(dbm.NewMemDB()))
+
+			block := makeBlock(state, 1, new(types.Commit))
+			proposed := block
+			if !tc.decided {
+				proposed = state.MakeBlock(1, test.MakeNTxs(1, 3), new(types.Commit), nil,
+					state.Validators.GetProposer().Address)
+			}
+			blockExec.ExecuteBlockOptimistically(state, proposed)
+			// Executing the same block again is a no-op.
+			blockExec.ExecuteBlockOptimistically(state, proposed)
+
+			bps, err := block.MakePartSet(testPartSize)
+			require.NoError(t, err)
+			blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: bps.Header()}
+			state, err = blockExec.ApplyBlock(state, blockID, block, block.Height)
+			require.NoError(t, err)
+
+			// The result of the decided block is used.
+			require.EqualValues(t, block.Hash(), state.AppHash)
+			app.mtx.Lock()
+			defer app.mtx.Unlock()
+			if tc.decided {
+				require.Equal(t, [][]byte{block.Hash()}, app.hashes)
+			} else {
+				require.Equal(t, [][]byte{proposed.Hash(), block.Hash()}, app.hashes)
+			}
+		})
+	}
+}
+
 // TestFinalizeBlockDecidedLastCommit ensures we correctly send the
 // DecidedLastCommit to the application. The test ensures that the
 // DecidedLastCommit properly reflects which validators signed the preceding
@@ -0,0 +1,107 @@
+// Package sqlsink implements the helpers shared by the SQL event sinks, i.e.
+// the PostgreSQL (psql) and SQLite (sqlite) sinks.
+package sqlsink
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/libs/pubsub/query/syntax"
+)
+
+// QueryArgs accumulates the positional arguments of a SQL statement.
+type QueryArgs struct {
+	// Values are the arguments, in the order of their placeholders.
+	Values []any
+
+	prefix string
+}
+
+// NewQueryArgs returns an empty list of arguments, whose placeholders are
+// prefix followed by their position, e.g. "$" for PostgreSQL and "?" for
+// SQLite.
+func NewQueryArgs(prefix string) *QueryArgs {
+	return &QueryArgs{prefix: prefix}
+}
+
+// Add appends v to the arguments and returns its placeholder.
+func (qa *QueryArgs) Add(v any) string {
+	qa.Values = append(qa.Values, v)
+	return qa.prefix + strconv.Itoa(len(qa.Values))
+}
+
+// IsNumberComparison reports whether c compares a value with a number.
+func IsNumberComparison(c syntax.Condition) bool {
+	return c.Arg != nil && c.Arg.Type == syntax.TNumber && SQLOperator(c.Op) != ""
+}
+
+// SQLOperator returns the SQL operator for a comparison operator of the query
+// language, or "" if op is not a comparison.
+func SQLOperator(op syntax.Token) string {
+	switch op {
+	case syntax.TEq:
+		return "="
+	case syntax.TLt:
+		return "<"
+	case syntax.TLeq:
+		return "<="
+	case syntax.TGt:
+		return ">"
+	case syntax.TGeq:
+		return ">="
+	default:
+		return ""
+	}
+}
+
+// RunInTransaction executes query in a fresh database transaction.
+// If query reports an error, the transaction is rolled back and the
+// error from query is reported to the caller.
+// Otherwise, the result of committing the transaction is returned.
+func RunInTransaction(db *sql.DB, query func(*sql.Tx) error) error {
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := query(dbtx); err != nil {
+		_ = dbtx.Rollback() // report the initial error, not the rollback
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// MakeIndexedEvent constructs an event from the specified composite key and
+// value. If the key has the form "type.name", the event will have a single
+// attribute with that name and the value; otherwise the event will have only
+// a type and no attributes.
+func MakeIndexedEvent(compositeKey, value string) abci.Event {
+	i := strings.Index(compositeKey, ".")
+	if i < 0 {
+		return abci.Event{Type: compositeKey}
+	}
+	return abci.Event{Type: compositeKey[:i], Attributes: []abci.EventAttribute{
+		{Key: compositeKey[i+1:], Value: value, Index: true},
+	}}
+}
+
+// ValidatePage checks that page is within the pages of perPage results
+// needed for totalCount results. The first page is always valid, even if
+// there are no results.
+func ValidatePage(page, perPage, totalCount int) (int, error) {
+	if perPage < 1 {
+		return 1, fmt.Errorf("zero or negative perPage: %d", perPage)
+	}
+
+	pages := ((totalCount - 1) / perPage) + 1
+	if pages == 0 {
+		pages = 1 // one page (even if it's empty)
+	}
+	if page <= 0 || page > pages {
+		return 1, fmt.Errorf("page should be within [1, %d] range, given %d", pages, page)
+	}
+
+	return page, nil
+}
@@ -0,0 +1,40 @@
+package sqlsink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryArgs(t *testing.T) {
+	args := NewQueryArgs("$")
+	assert.Equal(t, "$1", args.Add("a"))
+	assert.Equal(t, "$2", args.Add(2))
+	assert.Equal(t, []any{"a", 2}, args.Values)
+
+	assert.Equal(t, "?1", NewQueryArgs("?").Add("a"))
+}
+
+func TestValidatePage(t *testing.T) {
+	testCases := []struct {
+		page, perPage, totalCount int
+		valid                     bool
+	}{
+		{1, 10, 0, true},
+		{1, 10, 10, true},
+		{2, 10, 10, false},
+		{2, 10, 11, true},
+		{0, 10, 10, false},
+		{1, 0, 10, false},
+	}
+	for _, tc := range testCases {
+		page, err := ValidatePage(tc.page, tc.perPage, tc.totalCount)
+		if tc.valid {
+			require.NoError(t, err, tc)
+			assert.Equal(t, tc.page, page)
+		} else {
+			require.Error(t, err, tc)
+		}
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -16,6 +15,8 @@ import (
 	abci "github.com/cometbft/cometbft/v2/abci/types"
 	"github.com/cometbft/cometbft/v2/internal/rand"
 	"github.com/cometbft/cometbft/v2/libs/pubsub/query"
+	"github.com/cometbft/cometbft/v2/state/indexer/sink/internal/sqlsink"
+	"github.com/cometbft/cometbft/v2/state/txindex"
 	"github.com/cometbft/cometbft/v2/types"
 )
 
@@ -102,24 +103,8 @@ func WithTableAttributes(tableAttributes string) EventSinkOption {
 // This is exported to support testing.
 func (es *EventSink) DB() *sql.DB { return es.store }
 
-// runInTransaction executes query in a fresh database transaction.
-// If query reports an error, the transaction is rolled back and the
-// error from query is reported to the caller.
-// Otherwise, the result of committing the transaction is returned.
-func runInTransaction(db *sql.DB, query func(*sql.Tx) error) error {
-	dbtx, err := db.Begin()
-	if err != nil {
-		return err
-	}
-	if err := query(dbtx); err != nil {
-		_ = dbtx.Rollback() // report the initial error, not the rollback
-		return err
-	}
-	return dbtx.Commit()
-}
-
 func runBulkInsert(db *sql.DB, tableName string, columns []string, inserts [][]any) error {
-	return runInTransaction(db, func(tx *sql.Tx) error {
+	return sqlsink.RunInTransaction(db, func(tx *sql.Tx) error {
 		stmt, err := tx.Prepare(pq.CopyIn(tableName, columns...))
 		if err != nil {
 			return fmt.Errorf("preparing bulk insert statement: %w", err)
@@ -171,20 +156,6 @@ func bulkInsertEvents(blockID, txID int64, events []abci.Event) (eventInserts, a
 	return eventInserts, attrInserts
 }
 
-// makeIndexedEvent constructs an event from the specified composite key and
-// value. If the key has the form "type.name", the event will have a single
-// attribute with that name and the value; otherwise the event will have only
-// a type and no attributes.
-func makeIndexedEvent(compositeKey, value string) abci.Event {
-	i := strings.Index(compositeKey, ".")
-	if i < 0 {
-		return abci.Event{Type: compositeKey}
-	}
-	return abci.Event{Type: compositeKey[:i], Attributes: []abci.EventAttribute{
-		{Key: compositeKey[i+1:], Value: value, Index: true},
-	}}
-}
-
 // IndexBlockEvents indexes the specified block header, part of the
 // indexer.EventSink interface.
 func (es *EventSink) IndexBlockEvents(h types.EventDataNewBlockEvents) error {
@@ -207,7 +178,7 @@ INSERT INTO `+es.tableBlocks+` (height, chain_id, created_at)
 	}
 
 	// Insert the special block meta-event for height.
-	events := append([]abci.Event{makeIndexedEvent(types.BlockHeightKey, strconv.FormatInt(h.Height, 10))}, h.Events...)
+	events := append([]abci.Event{sqlsink.MakeIndexedEvent(types.BlockHeightKey, strconv.FormatInt(h.Height, 10))}, h.Events...)
 	// Insert all the block events. Order is important here,
 	eventInserts, attrInserts := bulkInsertEvents(blockID, 0, events)
 	if err := runBulkInsert(es.store, es.tableEvents, eventInsertColumns, eventInserts); err != nil {
@@ -279,8 +250,8 @@ func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
 		txrInserts = append(txrInserts, []any{txID, blockIDs[i], txr.Index, ts, txHash, resultData})
 		// Insert the special transaction meta-events for hash and height.
 		events := append([]abci.Event{
-			makeIndexedEvent(types.TxHashKey, txHash),
-			makeIndexedEvent(types.TxHeightKey, strconv.FormatInt(txr.Height, 10)),
+			sqlsink.MakeIndexedEvent(types.TxHashKey, txHash),
+			sqlsink.MakeIndexedEvent(types.TxHeightKey, strconv.FormatInt(txr.Height, 10)),
 		},
 			txr.Result.Events...,
 		)
@@ -300,24 +271,146 @@ func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
 	return nil
 }
 
-// SearchBlockEvents is not implemented by this sink, and reports an error for all queries.
-func (*EventSink) SearchBlockEvents(_ context.Context, _ *query.Query) ([]int64, error) {
-	return nil, errors.New("block search is not supported via the postgres event sink")
+// SearchBlockEvents returns the heights of the blocks whose events match q,
+// in ascending order. It is part of the indexer.EventSink interface.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	args := sqlsink.NewQueryArgs("$")
+	filter, err := es.blockFilter(q, args)
+	if err != nil {
+		return nil, fmt.Errorf("translating query: %w", err)
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT b.height FROM `+es.tableBlocks+` b
+WHERE `+filter+`
+ORDER BY b.height ASC;
+`, args.Values...)
+	if err != nil {
+		return nil, fmt.Errorf("searching blocks: %w", err)
+	}
+	defer rows.Close()
+
+	heights := make([]int64, 0)
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, fmt.Errorf("scanning block height: %w", err)
+		}
+		heights = append(heights, height)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("searching blocks: %w", err)
+	}
+	return heights, nil
 }
 
-// SearchTxEvents is not implemented by this sink, and reports an error for all queries.
-func (*EventSink) SearchTxEvents(_ context.Context, _ *query.Query) ([]*abci.TxResult, error) {
-	return nil, errors.New("tx search is not supported via the postgres event sink")
+// SearchTxEvents returns the results of the transactions whose events match
+// q, ordered by height and index. It is part of the indexer.EventSink
+// interface.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	results, _, err := es.searchTxEvents(ctx, q, txindex.Pagination{})
+	return results, err
 }
 
-// GetTxByHash is not implemented by this sink, and reports an error for all queries.
-func (*EventSink) GetTxByHash(_ []byte) (*abci.TxResult, error) {
-	return nil, errors.New("getTxByHash is not supported via the postgres event sink")
+// searchTxEvents returns the page of results of the transactions whose events
+// match q selected by pagSettings, together with the total number of matching
+// transactions.
+func (es *EventSink) searchTxEvents(
+	ctx context.Context,
+	q *query.Query,
+	pagSettings txindex.Pagination,
+) ([]*abci.TxResult, int, error) {
+	args := sqlsink.NewQueryArgs("$")
+	filter, err := es.txFilter(q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("translating query: %w", err)
+	}
+	from := `
+FROM ` + es.tableTxResults + ` txr JOIN ` + es.tableBlocks + ` b ON b.rowid = txr.block_id
+WHERE ` + filter
+
+	var totalCount int
+	if err := es.store.QueryRowContext(ctx, `SELECT COUNT(*)`+from+`;`, args.Values...).Scan(&totalCount); err != nil {
+		return nil, 0, fmt.Errorf("counting txs: %w", err)
+	}
+
+	order := "ASC"
+	if pagSettings.OrderDesc {
+		order = "DESC"
+	}
+	stmt := `SELECT txr.tx_result` + from + `
+ORDER BY b.height ` + order + `, txr.index ` + order
+	if pagSettings.IsPaginated {
+		page, err := sqlsink.ValidatePage(pagSettings.Page, pagSettings.PerPage, totalCount)
+		if err != nil {
+			return nil, 0, err
+		}
+		stmt += "\nLIMIT " + args.Add(pagSettings.PerPage) + " OFFSET " + args.Add((page-1)*pagSettings.PerPage)
+	}
+
+	rows, err := es.store.QueryContext(ctx, stmt+";", args.Values...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("searching txs: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]*abci.TxResult, 0)
+	for rows.Next() {
+		var resultData []byte
+		if err := rows.Scan(&resultData); err != nil {
+			return nil, 0, fmt.Errorf("scanning tx_result: %w", err)
+		}
+		txr := new(abci.TxResult)
+		if err := proto.Unmarshal(resultData, txr); err != nil {
+			return nil, 0, fmt.Errorf("unmarshaling tx_result: %w", err)
+		}
+		results = append(results, txr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("searching txs: %w", err)
+	}
+	return results, totalCount, nil
+}
+
+// GetTxByHash returns the result of the transaction with the given hash, or
+// nil if it has not been indexed. It is part of the indexer.EventSink
+// interface.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, txindex.ErrorEmptyHash
+	}
+
+	var resultData []byte
+	err := es.store.QueryRow(`
+SELECT txr.tx_result
+FROM `+es.tableTxResults+` txr JOIN `+es.tableBlocks+` b ON b.rowid = txr.block_id
+WHERE b.chain_id = $1 AND txr.tx_hash = $2
+ORDER BY b.height DESC
+LIMIT 1;
+`, es.chainID, fmt.Sprintf("%X", hash)).Scan(&resultData)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("getting tx by hash: %w", err)
+	}
+
+	txr := new(abci.TxResult)
+	if err := proto.Unmarshal(resultData, txr); err != nil {
+		return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+	}
+	return txr, nil
 }
 
-// HasBlock is not implemented by this sink, and reports an error for all queries.
-func (*EventSink) HasBlock(_ int64) (bool, error) {
-	return false, errors.New("hasBlock is not supported via the postgres event sink")
+// HasBlock reports whether the block at the given height has been indexed. It
+// is part of the indexer.EventSink interface.
+func (es *EventSink) HasBlock(height int64) (bool, error) {
+	var has bool
+	if err := es.store.QueryRow(`
+SELECT EXISTS(SELECT 1 FROM `+es.tableBlocks+` WHERE height = $1 AND chain_id = $2);
+`, height, es.chainID).Scan(&has); err != nil {
+		return false, fmt.Errorf("checking block existence: %w", err)
+	}
+	return has, nil
 }
 
 // Stop closes the underlying PostgreSQL database.
@@ -23,6 +23,8 @@ import (
 
 	abci "github.com/cometbft/cometbft/v2/abci/types"
 	tmlog "github.com/cometbft/cometbft/v2/libs/log"
+	"github.com/cometbft/cometbft/v2/libs/pubsub/query"
+	"github.com/cometbft/cometbft/v2/state/indexer/sink/internal/sqlsink"
 	"github.com/cometbft/cometbft/v2/state/txindex"
 	"github.com/cometbft/cometbft/v2/types"
 )
@@ -147,13 +149,16 @@ func TestIndexing(t *testing.T) {
 		verifyBlock(t, indexer, 1)
 		verifyBlock(t, indexer, 2)
 
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(1) })
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(2) })
+		has, err := indexer.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, has)
+		has, err = indexer.HasBlock(2)
+		require.NoError(t, err)
+		assert.False(t, has)
 
-		verifyNotImplemented(t, "block search", func() (bool, error) {
-			v, err := indexer.SearchBlockEvents(context.Background(), nil)
-			return v != nil, err
-		})
+		heights, err := indexer.SearchBlockEvents(context.Background(), query.MustCompile("end_event.foo = 100"))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, heights)
 
 		require.NoError(t, verifyTimeStamp(indexer.tableBlocks))
 
@@ -166,9 +171,9 @@ func TestIndexing(t *testing.T) {
 		require.Nil(t, err, "event sink creation")
 
 		txResult := txResultWithEvents([]abci.Event{
-			makeIndexedEvent("account.number", "1"),
-			makeIndexedEvent("account.owner", "Ivan"),
-			makeIndexedEvent("account.owner", "Yulieta"),
+			sqlsink.MakeIndexedEvent("account.number", "1"),
+			sqlsink.MakeIndexedEvent("account.owner", "Ivan"),
+			sqlsink.MakeIndexedEvent("account.owner", "Yulieta"),
 
 			{Type: "", Attributes: []abci.EventAttribute{
 				{
@@ -187,14 +192,14 @@ func TestIndexing(t *testing.T) {
 		require.NoError(t, verifyTimeStamp(indexer.tableTxResults))
 		require.NoError(t, verifyTimeStamp(viewTxEvents))
 
-		verifyNotImplemented(t, "getTxByHash", func() (bool, error) {
-			txr, err := indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
-			return txr != nil, err
-		})
-		verifyNotImplemented(t, "tx search", func() (bool, error) {
-			txr, err := indexer.SearchTxEvents(context.Background(), nil)
-			return txr != nil, err
-		})
+		txr, err = indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+
+		txrs, err := indexer.SearchTxEvents(context.Background(), query.MustCompile("account.owner = 'Yulieta'"))
+		require.NoError(t, err)
+		require.Len(t, txrs, 1)
+		assert.Equal(t, txResult, txrs[0])
 
 		// try to insert the duplicate tx events.
 		err = indexer.IndexTxEvents([]*abci.TxResult{txResult})
@@ -253,6 +258,150 @@ func TestIndexing(t *testing.T) {
 	})
 }
 
+func TestSearch(t *testing.T) {
+	// Use a separate chain, so that the data indexed by other tests does not
+	// interfere with the results.
+	indexer, err := NewEventSink("", "test-search-chainID", WithStore(testDB()))
+	require.NoError(t, err)
+
+	ts := func(s string) string { return s + "T12:00:00Z" }
+	for h, day := range map[int64]string{1: "2024-01-01", 2: "2024-06-01", 3: "2025-01-01"} {
+		require.NoError(t, indexer.IndexBlockEvents(types.EventDataNewBlockEvents{
+			Height: h,
+			Events: []abci.Event{
+				sqlsink.MakeIndexedEvent("reward.amount", fmt.Sprint(h*10)),
+				sqlsink.MakeIndexedEvent("reward.time", ts(day)),
+				sqlsink.MakeIndexedEvent("reward.day", day),
+			},
+		}))
+	}
+
+	newTxResult := func(height int64, index uint32, events ...abci.Event) *abci.TxResult {
+		return &abci.TxResult{
+			Height: height,
+			Index:  index,
+			Tx:     types.Tx(fmt.Sprintf("tx-%d-%d", height, index)),
+			Result: abci.ExecTxResult{Code: abci.CodeTypeOK, Events: events},
+		}
+	}
+	txrs := []*abci.TxResult{
+		newTxResult(1, 0, sqlsink.MakeIndexedEvent("transfer.sender", "Ivan"), sqlsink.MakeIndexedEvent("transfer.amount", "5")),
+		newTxResult(1, 1, sqlsink.MakeIndexedEvent("transfer.sender", "Yulieta"), sqlsink.MakeIndexedEvent("transfer.amount", "15.5")),
+		newTxResult(2, 0, sqlsink.MakeIndexedEvent("transfer.sender", "Ivanka"), sqlsink.MakeIndexedEvent("transfer.amount", "not a number")),
+		newTxResult(3, 0, sqlsink.MakeIndexedEvent("message.action", "vote")),
+	}
+	for _, txr := range txrs {
+		require.NoError(t, indexer.IndexTxEvents([]*abci.TxResult{txr}))
+	}
+
+	t.Run("SearchTxEvents", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			want  []*abci.TxResult
+		}{
+			{"tx.height = 1", txrs[0:2]},
+			{"tx.height > 1", txrs[2:4]},
+			{"tx.height >= 1 AND tx.height <= 2", txrs[0:3]},
+			{fmt.Sprintf("tx.hash = '%x'", types.Tx(txrs[2].Tx).Hash()), txrs[2:3]},
+			{"transfer.sender = 'Ivan'", txrs[0:1]},
+			{"transfer.sender CONTAINS 'Ivan'", []*abci.TxResult{txrs[0], txrs[2]}},
+			{"transfer.sender EXISTS", txrs[0:3]},
+			{"transfer.amount > 5", txrs[1:2]},
+			{"transfer.amount <= 15.5", txrs[0:2]},
+			{"transfer.sender CONTAINS 'Ivan' AND tx.height = 2", txrs[2:3]},
+			{"transfer.sender = 'Ivan' AND message.action = 'vote'", nil},
+			{"message.action EXISTS", txrs[3:4]},
+			{"nothing.here EXISTS", nil},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.query, func(t *testing.T) {
+				got, err := indexer.SearchTxEvents(context.Background(), query.MustCompile(tc.query))
+				require.NoError(t, err)
+				if tc.want == nil {
+					assert.Empty(t, got)
+					return
+				}
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		txIndexer := indexer.TxIndexer()
+		q := query.MustCompile("tx.height >= 1")
+
+		got, total, err := txIndexer.Search(context.Background(), q, txindex.Pagination{
+			IsPaginated: true, Page: 1, PerPage: 3,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+		assert.Equal(t, txrs[0:3], got)
+
+		got, total, err = txIndexer.Search(context.Background(), q, txindex.Pagination{
+			IsPaginated: true, Page: 2, PerPage: 3,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+		assert.Equal(t, txrs[3:4], got)
+
+		got, total, err = txIndexer.Search(context.Background(), q, txindex.Pagination{
+			OrderDesc: true, IsPaginated: true, Page: 1, PerPage: 2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+		assert.Equal(t, []*abci.TxResult{txrs[3], txrs[2]}, got)
+
+		_, _, err = txIndexer.Search(context.Background(), q, txindex.Pagination{
+			IsPaginated: true, Page: 3, PerPage: 3,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("SearchBlockEvents", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			want  []int64
+		}{
+			{"block.height = 2", []int64{2}},
+			{"block.height < 3", []int64{1, 2}},
+			{"reward.amount >= 20", []int64{2, 3}},
+			{"reward.amount = 10 AND block.height = 1", []int64{1}},
+			{"reward.amount = 10 AND block.height = 2", []int64{}},
+			{"reward.time > TIME 2024-03-01T00:00:00Z", []int64{2, 3}},
+			{"reward.day < DATE 2024-06-01", []int64{1}},
+			{"reward.day CONTAINS '2024'", []int64{1, 2}},
+			{"transfer.sender EXISTS", []int64{}}, // tx events are not block events
+		}
+		for _, tc := range testCases {
+			t.Run(tc.query, func(t *testing.T) {
+				got, err := indexer.SearchBlockEvents(context.Background(), query.MustCompile(tc.query))
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+
+	t.Run("GetTxByHash", func(t *testing.T) {
+		txr, err := indexer.TxIndexer().Get(types.Tx(txrs[1].Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txrs[1], txr)
+
+		txr, err = indexer.TxIndexer().Get(types.Tx("unknown").Hash())
+		require.NoError(t, err)
+		assert.Nil(t, txr)
+	})
+
+	t.Run("HasBlock", func(t *testing.T) {
+		has, err := indexer.BlockIndexer().Has(3)
+		require.NoError(t, err)
+		assert.True(t, has)
+
+		has, err = indexer.BlockIndexer().Has(4)
+		require.NoError(t, err)
+		assert.False(t, has)
+	})
+}
+
 func TestStop(t *testing.T) {
 	indexer := &EventSink{store: testDB()}
 	require.NoError(t, indexer.Stop())
@@ -264,10 +413,10 @@ func newTestBlockEvents() types.EventDataNewBlockEvents {
 	return types.EventDataNewBlockEvents{
 		Height: 1,
 		Events: []abci.Event{
-			makeIndexedEvent("begin_event.proposer", "FCAA001"),
-			makeIndexedEvent("thingy.whatzit", "O.O"),
-			makeIndexedEvent("end_event.foo", "100"),
-			makeIndexedEvent("thingy.whatzit", "-.O"),
+			sqlsink.MakeIndexedEvent("begin_event.proposer", "FCAA001"),
+			sqlsink.MakeIndexedEvent("thingy.whatzit", "O.O"),
+			sqlsink.MakeIndexedEvent("end_event.foo", "100"),
+			sqlsink.MakeIndexedEvent("thingy.whatzit", "-.O"),
 		},
 	}
 }
@@ -362,20 +511,6 @@ SELECT type, height, chain_id FROM `+viewBlockEvents+`
 	}
 }
 
-// verifyNotImplemented calls f and verifies that it returns both a
-// false-valued flag and a non-nil error whose string matching the expected
-// "not supported" message with label prefixed.
-func verifyNotImplemented(t *testing.T, label string, f func() (bool, error)) {
-	t.Helper()
-	t.Logf("Verifying that %q reports it is not implemented", label)
-
-	want := label + " is not supported via the postgres event sink"
-	ok, err := f()
-	assert.False(t, ok)
-	require.Error(t, err)
-	assert.Equal(t, want, err.Error())
-}
-
 // waitForInterrupt blocks until a SIGINT is received by the process.
 func waitForInterrupt() {
 	ch := make(chan os.Signal, 1)
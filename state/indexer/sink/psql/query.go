@@ -0,0 +1,131 @@
+package psql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cometbft/cometbft/v2/libs/pubsub/query"
+	"github.com/cometbft/cometbft/v2/libs/pubsub/query/syntax"
+	"github.com/cometbft/cometbft/v2/state/indexer/sink/internal/sqlsink"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// Attribute values are stored as strings. Before comparing a value with a
+// number or a timestamp, we check that it has the right format, so that values
+// that cannot be cast do not make the whole query fail. Such values never
+// match the condition.
+const (
+	numberPattern = `^-?[0-9]+([.][0-9]+)?$`
+	datePattern   = `^[0-9]{4}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])`
+	timePattern   = datePattern + `T([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]([.][0-9]+)?(Z|[+-][0-9]{2}:[0-9]{2})$`
+)
+
+// txFilter returns a SQL boolean expression selecting the rows of the
+// tx_results table (aliased txr), joined with the blocks table (aliased b),
+// that match all the conditions of q.
+func (es *EventSink) txFilter(q *query.Query, args *sqlsink.QueryArgs) (string, error) {
+	filters := []string{"b.chain_id = " + args.Add(es.chainID)}
+	for _, c := range q.Syntax() {
+		var (
+			filter string
+			err    error
+		)
+		switch {
+		case c.Tag == types.TxHeightKey && sqlsink.IsNumberComparison(c):
+			filter = heightFilter("b.height", c, args)
+		case c.Tag == types.TxHashKey && c.Op == syntax.TEq && c.Arg.Type == syntax.TString:
+			// Hashes are indexed as upper case hex strings.
+			filter = "txr.tx_hash = " + args.Add(strings.ToUpper(c.Arg.Value()))
+		default:
+			filter, err = es.attributeFilter("e.tx_id = txr.rowid", c, args)
+		}
+		if err != nil {
+			return "", err
+		}
+		filters = append(filters, filter)
+	}
+	return strings.Join(filters, "\n  AND "), nil
+}
+
+// blockFilter returns a SQL boolean expression selecting the rows of the
+// blocks table (aliased b) that match all the conditions of q.
+func (es *EventSink) blockFilter(q *query.Query, args *sqlsink.QueryArgs) (string, error) {
+	filters := []string{"b.chain_id = " + args.Add(es.chainID)}
+	for _, c := range q.Syntax() {
+		var (
+			filter string
+			err    error
+		)
+		if c.Tag == types.BlockHeightKey && sqlsink.IsNumberComparison(c) {
+			filter = heightFilter("b.height", c, args)
+		} else {
+			filter, err = es.attributeFilter("e.block_id = b.rowid AND e.tx_id IS NULL", c, args)
+		}
+		if err != nil {
+			return "", err
+		}
+		filters = append(filters, filter)
+	}
+	return strings.Join(filters, "\n  AND "), nil
+}
+
+// attributeFilter returns a SQL boolean expression that holds if an event
+// selected by owner (a condition on the events table, aliased e) has an
+// indexed attribute matching c.
+func (es *EventSink) attributeFilter(owner string, c syntax.Condition, args *sqlsink.QueryArgs) (string, error) {
+	key := args.Add(c.Tag)
+	cmp, err := valueComparison("a.value", c, args)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`EXISTS (
+    SELECT 1 FROM %s e JOIN %s a ON a.event_id = e.rowid
+    WHERE %s AND a.composite_key = %s AND %s)`,
+		es.tableEvents, es.tableAttributes, owner, key, cmp), nil
+}
+
+// heightFilter compares column, which holds a height, with the number in c.
+func heightFilter(column string, c syntax.Condition, args *sqlsink.QueryArgs) string {
+	return fmt.Sprintf("%s %s %s::numeric", column, sqlsink.SQLOperator(c.Op), args.Add(c.Arg.Value()))
+}
+
+// valueComparison returns a SQL boolean expression comparing the string
+// expression expr with the argument of c.
+func valueComparison(expr string, c syntax.Condition, args *sqlsink.QueryArgs) (string, error) {
+	switch c.Op {
+	case syntax.TExists:
+		return "TRUE", nil
+	case syntax.TContains:
+		return fmt.Sprintf("strpos(%s, %s) > 0", expr, args.Add(c.Arg.Value())), nil
+	}
+
+	op := sqlsink.SQLOperator(c.Op)
+	if op == "" {
+		return "", fmt.Errorf("unsupported operator %v in condition %q", c.Op, c)
+	}
+
+	switch c.Arg.Type {
+	case syntax.TString:
+		return fmt.Sprintf("%s %s %s", expr, op, args.Add(c.Arg.Value())), nil
+	case syntax.TNumber:
+		if c.Arg.Number() == nil {
+			return "", fmt.Errorf("invalid number in condition %q", c)
+		}
+		return fmt.Sprintf("(CASE WHEN %[1]s ~ '%[2]s' THEN %[1]s::numeric END) %[3]s %[4]s::numeric",
+			expr, numberPattern, op, args.Add(c.Arg.Value())), nil
+	case syntax.TTime, syntax.TDate:
+		ts := c.Arg.Time()
+		if ts.IsZero() {
+			return "", fmt.Errorf("invalid time in condition %q", c)
+		}
+		// Dates without a time are interpreted as midnight UTC, as in the
+		// query language.
+		return fmt.Sprintf(`(CASE
+      WHEN %[1]s ~ '%[2]s$' THEN (%[1]s || 'T00:00:00Z')::timestamptz
+      WHEN %[1]s ~ '%[3]s' THEN %[1]s::timestamptz
+    END) %[4]s %[5]s::timestamptz`,
+			expr, datePattern, timePattern, op, args.Add(ts)), nil
+	default:
+		return "", fmt.Errorf("unsupported argument type %v in condition %q", c.Arg.Type, c)
+	}
+}
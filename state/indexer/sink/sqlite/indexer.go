@@ -0,0 +1,122 @@
+package sqlite
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/libs/log"
+	"github.com/cometbft/cometbft/v2/libs/pubsub/query"
+	"github.com/cometbft/cometbft/v2/state/indexer"
+	"github.com/cometbft/cometbft/v2/state/txindex"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+var (
+	_ indexer.BlockIndexer = BlockIndexer{}
+	_ txindex.TxIndexer    = TxIndexer{}
+)
+
+// TxIndexer returns a transaction indexer backed by es.
+func (es *EventSink) TxIndexer() TxIndexer {
+	return TxIndexer{sink: es}
+}
+
+// TxIndexer implements the txindex.TxIndexer interface by delegating
+// indexing operations to an underlying SQLite event sink.
+type TxIndexer struct{ sink *EventSink }
+
+// GetRetainHeight returns the retain height set by SetRetainHeight, as part
+// of TxIndexer.
+func (t TxIndexer) GetRetainHeight() (int64, error) {
+	return t.sink.getRetainHeight(retainHeightTx)
+}
+
+// SetRetainHeight sets the height below which transactions can be pruned, as
+// part of TxIndexer.
+func (t TxIndexer) SetRetainHeight(retainHeight int64) error {
+	return t.sink.setRetainHeight(retainHeightTx, retainHeight)
+}
+
+// Prune deletes the transactions below retainHeight, and returns the number
+// of heights pruned and the new retain height, as part of TxIndexer.
+func (t TxIndexer) Prune(retainHeight int64) (numPruned, newRetainHeight int64, err error) {
+	return t.sink.prune(retainHeightTx, "tx_results", "tx_id IS NOT NULL", retainHeight)
+}
+
+// AddBatch indexes a batch of transactions in SQLite, as part of TxIndexer.
+func (t TxIndexer) AddBatch(batch *txindex.Batch) error {
+	return t.sink.IndexTxEvents(batch.Ops)
+}
+
+// Index indexes a single transaction result in SQLite, as part of TxIndexer.
+func (t TxIndexer) Index(txr *abci.TxResult) error {
+	return t.sink.IndexTxEvents([]*abci.TxResult{txr})
+}
+
+// Get returns the result of the transaction with the given hash, or nil if it
+// has not been indexed, as part of TxIndexer.
+func (t TxIndexer) Get(hash []byte) (*abci.TxResult, error) {
+	return t.sink.GetTxByHash(hash)
+}
+
+// Search returns the requested page of results of the transactions matching
+// q, and the total number of matching transactions, as part of TxIndexer.
+func (t TxIndexer) Search(ctx context.Context, q *query.Query, pagSettings txindex.Pagination) ([]*abci.TxResult, int, error) {
+	return t.sink.searchTxEvents(ctx, q, pagSettings)
+}
+
+func (TxIndexer) SetLogger(log.Logger) {}
+
+// Close closes the indexer's underlying database. The caller is responsible for
+// calling Close when done with the indexer.
+func (t TxIndexer) Close() error {
+	return t.sink.Stop()
+}
+
+// BlockIndexer returns a block indexer backed by es.
+func (es *EventSink) BlockIndexer() BlockIndexer {
+	return BlockIndexer{sink: es}
+}
+
+// BlockIndexer implements the indexer.BlockIndexer interface by delegating
+// indexing operations to an underlying SQLite event sink.
+type BlockIndexer struct{ sink *EventSink }
+
+// SetRetainHeight sets the height below which block events can be pruned, as
+// part of BlockIndexer.
+func (b BlockIndexer) SetRetainHeight(retainHeight int64) error {
+	return b.sink.setRetainHeight(retainHeightBlock, retainHeight)
+}
+
+// GetRetainHeight returns the retain height set by SetRetainHeight, as part
+// of BlockIndexer.
+func (b BlockIndexer) GetRetainHeight() (int64, error) {
+	return b.sink.getRetainHeight(retainHeightBlock)
+}
+
+// Prune deletes the blocks and block events below retainHeight, and returns
+// the number of heights pruned and the new retain height, as part of
+// BlockIndexer. Transaction events are pruned by the TxIndexer.
+func (b BlockIndexer) Prune(retainHeight int64) (numPruned, newRetainHeight int64, err error) {
+	return b.sink.prune(retainHeightBlock, "blocks", "tx_id IS NULL", retainHeight)
+}
+
+// Has reports whether the block at the given height has been indexed, as
+// part of BlockIndexer.
+func (b BlockIndexer) Has(height int64) (bool, error) {
+	return b.sink.HasBlock(height)
+}
+
+// Index indexes block begin and end events for the specified block. It is
+// part of the BlockIndexer interface.
+func (b BlockIndexer) Index(block types.EventDataNewBlockEvents) error {
+	return b.sink.IndexBlockEvents(block)
+}
+
+// Search returns the heights of the blocks matching q, as part of
+// BlockIndexer.
+func (b BlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return b.sink.SearchBlockEvents(ctx, q)
+}
+
+func (BlockIndexer) SetLogger(log.Logger) {}
@@ -0,0 +1,126 @@
+package sqlite
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cometbft/cometbft/v2/libs/pubsub/query"
+	"github.com/cometbft/cometbft/v2/libs/pubsub/query/syntax"
+	"github.com/cometbft/cometbft/v2/state/indexer/sink/internal/sqlsink"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// txFilter returns a SQL boolean expression selecting the rows of the
+// tx_results table (aliased txr) that match all the conditions of q.
+func txFilter(q *query.Query, args *sqlsink.QueryArgs) (string, error) {
+	filters := []string{"1"}
+	for _, c := range q.Syntax() {
+		var (
+			filter string
+			err    error
+		)
+		switch {
+		case c.Tag == types.TxHeightKey && sqlsink.IsNumberComparison(c):
+			filter, err = heightFilter("txr.height", c, args)
+		case c.Tag == types.TxHashKey && c.Op == syntax.TEq && c.Arg.Type == syntax.TString:
+			// Hashes are indexed as upper case hex strings.
+			filter = "txr.tx_hash = " + args.Add(strings.ToUpper(c.Arg.Value()))
+		default:
+			filter, err = attributeFilter("e.tx_id = txr.rowid", c, args)
+		}
+		if err != nil {
+			return "", err
+		}
+		filters = append(filters, filter)
+	}
+	return strings.Join(filters, "\n  AND "), nil
+}
+
+// blockFilter returns a SQL boolean expression selecting the rows of the
+// blocks table (aliased b) that match all the conditions of q.
+func blockFilter(q *query.Query, args *sqlsink.QueryArgs) (string, error) {
+	filters := []string{"1"}
+	for _, c := range q.Syntax() {
+		var (
+			filter string
+			err    error
+		)
+		if c.Tag == types.BlockHeightKey && sqlsink.IsNumberComparison(c) {
+			filter, err = heightFilter("b.height", c, args)
+		} else {
+			filter, err = attributeFilter("e.height = b.height AND e.tx_id IS NULL", c, args)
+		}
+		if err != nil {
+			return "", err
+		}
+		filters = append(filters, filter)
+	}
+	return strings.Join(filters, "\n  AND "), nil
+}
+
+// attributeFilter returns a SQL boolean expression that holds if an event
+// selected by owner (a condition on the events table, aliased e) has an
+// indexed attribute matching c.
+func attributeFilter(owner string, c syntax.Condition, args *sqlsink.QueryArgs) (string, error) {
+	key := args.Add(c.Tag)
+	cmp, err := valueComparison(c, args)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`EXISTS (
+    SELECT 1 FROM events e JOIN attributes a ON a.event_id = e.rowid
+    WHERE %s AND a.composite_key = %s AND %s)`,
+		owner, key, cmp), nil
+}
+
+// heightFilter compares column, which holds a height, with the number in c.
+func heightFilter(column string, c syntax.Condition, args *sqlsink.QueryArgs) (string, error) {
+	f := c.Arg.Number()
+	if f == nil {
+		return "", fmt.Errorf("invalid number in condition %q", c)
+	}
+	v, _ := f.Float64()
+	return fmt.Sprintf("%s %s %s", column, sqlsink.SQLOperator(c.Op), args.Add(v)), nil
+}
+
+// valueComparison returns a SQL boolean expression comparing the attribute
+// (aliased a) with the argument of c.
+func valueComparison(c syntax.Condition, args *sqlsink.QueryArgs) (string, error) {
+	switch c.Op {
+	case syntax.TExists:
+		return "1", nil
+	case syntax.TContains:
+		return fmt.Sprintf("instr(a.value, %s) > 0", args.Add(c.Arg.Value())), nil
+	}
+
+	op := sqlsink.SQLOperator(c.Op)
+	if op == "" {
+		return "", fmt.Errorf("unsupported operator %v in condition %q", c.Op, c)
+	}
+
+	switch c.Arg.Type {
+	case syntax.TString:
+		return fmt.Sprintf("a.value %s %s", op, args.Add(c.Arg.Value())), nil
+	case syntax.TNumber:
+		f := c.Arg.Number()
+		if f == nil {
+			return "", fmt.Errorf("invalid number in condition %q", c)
+		}
+		v, _ := f.Float64()
+		return fmt.Sprintf("a.number_value %s %s", op, args.Add(v)), nil
+	case syntax.TTime, syntax.TDate:
+		ts := c.Arg.Time()
+		if ts.IsZero() {
+			return "", fmt.Errorf("invalid time in condition %q", c)
+		}
+		// As in the query language, DATE arguments are compared with values
+		// that are dates and TIME arguments with values that are timestamps.
+		column := "a.time_value"
+		if c.Arg.Type == syntax.TDate {
+			column = "a.date_value"
+		}
+		return fmt.Sprintf("%s %s %s", column, op, args.Add(ts.UnixNano())), nil
+	default:
+		return "", fmt.Errorf("unsupported argument type %v in condition %q", c.Arg.Type, c)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+  This file defines the database schema for the SQLite ("sqlite") event sink
+  implementation in CometBFT. Unlike the psql sink, the schema is installed by
+  the sink itself when it opens the database.
+ */
+
+-- The blocks table records the indexed blocks.
+-- The block record does not include its events or transactions (see tx_results).
+CREATE TABLE IF NOT EXISTS blocks (
+  height     INTEGER PRIMARY KEY,
+
+  -- When this block was logged into the sink, in UTC (RFC 3339).
+  created_at TEXT NOT NULL
+);
+
+-- The tx_results table records metadata about transaction results. Note that
+-- the events from a transaction are stored separately.
+CREATE TABLE IF NOT EXISTS tx_results (
+  rowid      INTEGER PRIMARY KEY,
+
+  -- The height of the block to which this transaction belongs.
+  height     INTEGER NOT NULL,
+  -- The sequential index of the transaction within the block.
+  "index"    INTEGER NOT NULL,
+  -- When this result record was logged into the sink, in UTC (RFC 3339).
+  created_at TEXT NOT NULL,
+  -- The hex-encoded hash of the transaction.
+  tx_hash    TEXT NOT NULL,
+  -- The protobuf wire encoding of the TxResult message.
+  tx_result  BLOB NOT NULL,
+
+  UNIQUE (height, "index")
+);
+
+CREATE INDEX IF NOT EXISTS idx_tx_results_hash ON tx_results(tx_hash);
+
+-- The events table records events. All events (both block and transaction) are
+-- associated with a height; transaction events also have a transaction ID.
+CREATE TABLE IF NOT EXISTS events (
+  rowid  INTEGER PRIMARY KEY,
+
+  -- The block and transaction this event belongs to.
+  -- If tx_id is NULL, this is a block event.
+  height INTEGER NOT NULL,
+  tx_id  INTEGER NULL REFERENCES tx_results(rowid),
+
+  -- The application-defined type label for the event.
+  type   TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_height ON events(height);
+CREATE INDEX IF NOT EXISTS idx_events_tx ON events(tx_id);
+
+-- The attributes table records event attributes. Besides the raw value, the
+-- value is stored as a number and as a timestamp (in Unix nanoseconds) when it
+-- can be parsed as such, so that range queries can use the indexes.
+CREATE TABLE IF NOT EXISTS attributes (
+  event_id      INTEGER NOT NULL REFERENCES events(rowid),
+  key           TEXT NOT NULL, -- bare key
+  composite_key TEXT NOT NULL, -- composed type.key
+  value         TEXT NULL,
+  number_value  REAL NULL,     -- value, if it is a number
+  date_value    INTEGER NULL,  -- value, if it is a DATE
+  time_value    INTEGER NULL   -- value, if it is a TIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_attributes_event ON attributes(event_id);
+CREATE INDEX IF NOT EXISTS idx_attributes_value ON attributes(composite_key, value);
+CREATE INDEX IF NOT EXISTS idx_attributes_number ON attributes(composite_key, number_value);
+
+-- The retain_heights table records the retain heights of the tx and block
+-- indexers, as set by the pruning service, and the heights they have been
+-- pruned to.
+CREATE TABLE IF NOT EXISTS retain_heights (
+  name               TEXT PRIMARY KEY, -- "tx" or "block"
+  retain_height      INTEGER NULL,
+  last_retain_height INTEGER NULL
+);
+
+-- A joined view of events and their attributes. Events that do not have any
+-- attributes are represented as a single row with empty key and value fields.
+CREATE VIEW IF NOT EXISTS event_attributes AS
+  SELECT height, tx_id, type, key, composite_key, value
+  FROM events LEFT JOIN attributes ON (events.rowid = attributes.event_id);
+
+-- A joined view of all block events (those having tx_id NULL).
+CREATE VIEW IF NOT EXISTS block_events AS
+  SELECT height, type, key, composite_key, value
+  FROM event_attributes
+  WHERE tx_id IS NULL;
+
+-- A joined view of all transaction events.
+CREATE VIEW IF NOT EXISTS tx_events AS
+  SELECT tx_results.height, "index", type, key, composite_key, value, tx_results.created_at
+  FROM tx_results JOIN event_attributes ON (tx_results.rowid = event_attributes.tx_id);
@@ -0,0 +1,444 @@
+// Package sqlite implements an event sink backed by an embedded SQLite
+// database.
+//
+// The sink stores events in the same relational model as the psql sink, so
+// the events can be queried with SQL, but it does not need a database server.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	_ "modernc.org/sqlite" // provide the sqlite db driver.
+
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	cmtos "github.com/cometbft/cometbft/v2/internal/os"
+	"github.com/cometbft/cometbft/v2/libs/pubsub/query"
+	"github.com/cometbft/cometbft/v2/libs/pubsub/query/syntax"
+	"github.com/cometbft/cometbft/v2/state"
+	"github.com/cometbft/cometbft/v2/state/indexer/sink/internal/sqlsink"
+	"github.com/cometbft/cometbft/v2/state/txindex"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+const (
+	// FileName is the name of the database file of the sink in the database
+	// directory of the node.
+	FileName = "tx_index.sqlite"
+
+	driverName = "sqlite"
+
+	// busyTimeout is how long a connection waits for the database to be
+	// unlocked by another connection, in milliseconds.
+	busyTimeout = 5000
+
+	// Names of the indexers in the retain_heights table.
+	retainHeightTx    = "tx"
+	retainHeightBlock = "block"
+)
+
+//go:embed schema.sql
+var schema string
+
+// numberPattern matches the attribute values that are stored as numbers.
+var numberPattern = regexp.MustCompile(`^-?[0-9]+([.][0-9]+)?$`)
+
+// EventSink is an indexer backend providing the tx/block index services. This
+// implementation stores records in a SQLite database using the schema defined
+// in state/indexer/sink/sqlite/schema.sql.
+type EventSink struct {
+	store *sql.DB
+}
+
+// NewEventSink opens the SQLite database at path, creating it if it does not
+// exist, and installs the schema of the sink.
+func NewEventSink(path string) (*EventSink, error) {
+	if err := cmtos.EnsureDir(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	dsn := fmt.Sprintf("file:%s?_pragma=busy_timeout(%d)&_pragma=journal_mode(WAL)", path, busyTimeout)
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(schema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("creating schema: %w", err)
+	}
+	return &EventSink{store: db}, nil
+}
+
+// DB returns the underlying SQLite connection used by the sink.
+// This is exported to support testing.
+func (es *EventSink) DB() *sql.DB { return es.store }
+
+// insertEvents inserts events and their indexed attributes. If txID is not
+// nil, the events are attributed to that transaction.
+func insertEvents(dbtx *sql.Tx, height int64, txID any, events []abci.Event) error {
+	eventStmt, err := dbtx.Prepare(`INSERT INTO events (height, tx_id, type) VALUES (?, ?, ?);`)
+	if err != nil {
+		return fmt.Errorf("preparing event insert statement: %w", err)
+	}
+	defer eventStmt.Close()
+	attrStmt, err := dbtx.Prepare(`
+INSERT INTO attributes (event_id, key, composite_key, value, number_value, date_value, time_value)
+  VALUES (?, ?, ?, ?, ?, ?, ?);`)
+	if err != nil {
+		return fmt.Errorf("preparing attribute insert statement: %w", err)
+	}
+	defer attrStmt.Close()
+
+	for _, event := range events {
+		// Skip events with an empty type.
+		if event.Type == "" {
+			continue
+		}
+		res, err := eventStmt.Exec(height, txID, event.Type)
+		if err != nil {
+			return fmt.Errorf("inserting event: %w", err)
+		}
+		eventID, err := res.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("getting event id: %w", err)
+		}
+		for _, attr := range event.Attributes {
+			if !attr.Index {
+				continue
+			}
+			compositeKey := event.Type + "." + attr.Key
+			if _, err := attrStmt.Exec(eventID, attr.Key, compositeKey, attr.Value,
+				numberValue(attr.Value), timestampValue(attr.Value, syntax.ParseDate),
+				timestampValue(attr.Value, syntax.ParseTime)); err != nil {
+				return fmt.Errorf("inserting attribute: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// numberValue returns value as a float, or nil if it is not a number.
+func numberValue(value string) any {
+	if !numberPattern.MatchString(value) {
+		return nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil
+	}
+	return f
+}
+
+// timestampValue returns value parsed by parse as Unix nanoseconds, or nil if
+// it cannot be parsed.
+func timestampValue(value string, parse func(string) (time.Time, error)) any {
+	ts, err := parse(value)
+	if err != nil {
+		return nil
+	}
+	return ts.UnixNano()
+}
+
+// IndexBlockEvents indexes the specified block header, part of the
+// indexer.EventSink interface.
+func (es *EventSink) IndexBlockEvents(h types.EventDataNewBlockEvents) error {
+	ts := time.Now().UTC().Format(time.RFC3339Nano)
+
+	return sqlsink.RunInTransaction(es.store, func(dbtx *sql.Tx) error {
+		res, err := dbtx.Exec(`INSERT OR IGNORE INTO blocks (height, created_at) VALUES (?, ?);`, h.Height, ts)
+		if err != nil {
+			return fmt.Errorf("indexing block header: %w", err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return fmt.Errorf("indexing block header: %w", err)
+		} else if n == 0 {
+			return nil // we already saw this block; quietly succeed
+		}
+
+		// Insert the special block meta-event for height.
+		events := append([]abci.Event{sqlsink.MakeIndexedEvent(types.BlockHeightKey, strconv.FormatInt(h.Height, 10))}, h.Events...)
+		if err := insertEvents(dbtx, h.Height, nil, events); err != nil {
+			return fmt.Errorf("indexing block events: %w", err)
+		}
+		return nil
+	})
+}
+
+// IndexTxEvents indexes the specified transaction results, part of the
+// indexer.EventSink interface. Results that were already indexed are skipped.
+func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
+	ts := time.Now().UTC().Format(time.RFC3339Nano)
+
+	return sqlsink.RunInTransaction(es.store, func(dbtx *sql.Tx) error {
+		for _, txr := range txrs {
+			// Encode the result message in protobuf wire format for indexing.
+			resultData, err := proto.Marshal(txr)
+			if err != nil {
+				return fmt.Errorf("marshaling tx_result: %w", err)
+			}
+			// Index the hash of the underlying transaction as a hex string.
+			txHash := fmt.Sprintf("%X", types.Tx(txr.Tx).Hash())
+
+			res, err := dbtx.Exec(`
+INSERT OR IGNORE INTO tx_results (height, "index", created_at, tx_hash, tx_result)
+  VALUES (?, ?, ?, ?, ?);`, txr.Height, txr.Index, ts, txHash, resultData)
+			if err != nil {
+				return fmt.Errorf("indexing tx_result: %w", err)
+			}
+			if n, err := res.RowsAffected(); err != nil {
+				return fmt.Errorf("indexing tx_result: %w", err)
+			} else if n == 0 {
+				continue // already indexed
+			}
+			txID, err := res.LastInsertId()
+			if err != nil {
+				return fmt.Errorf("getting tx_result id: %w", err)
+			}
+
+			// Insert the special transaction meta-events for hash and height.
+			events := append([]abci.Event{
+				sqlsink.MakeIndexedEvent(types.TxHashKey, txHash),
+				sqlsink.MakeIndexedEvent(types.TxHeightKey, strconv.FormatInt(txr.Height, 10)),
+			},
+				txr.Result.Events...,
+			)
+			if err := insertEvents(dbtx, txr.Height, txID, events); err != nil {
+				return fmt.Errorf("indexing tx events: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// SearchBlockEvents returns the heights of the blocks whose events match q,
+// in ascending order. It is part of the indexer.EventSink interface.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	args := sqlsink.NewQueryArgs("?")
+	filter, err := blockFilter(q, args)
+	if err != nil {
+		return nil, fmt.Errorf("translating query: %w", err)
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT b.height FROM blocks b
+WHERE `+filter+`
+ORDER BY b.height ASC;
+`, args.Values...)
+	if err != nil {
+		return nil, fmt.Errorf("searching blocks: %w", err)
+	}
+	defer rows.Close()
+
+	heights := make([]int64, 0)
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, fmt.Errorf("scanning block height: %w", err)
+		}
+		heights = append(heights, height)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("searching blocks: %w", err)
+	}
+	return heights, nil
+}
+
+// SearchTxEvents returns the results of the transactions whose events match
+// q, ordered by height and index. It is part of the indexer.EventSink
+// interface.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	results, _, err := es.searchTxEvents(ctx, q, txindex.Pagination{})
+	return results, err
+}
+
+// searchTxEvents returns the page of results of the transactions whose events
+// match q selected by pagSettings, together with the total number of matching
+// transactions.
+func (es *EventSink) searchTxEvents(
+	ctx context.Context,
+	q *query.Query,
+	pagSettings txindex.Pagination,
+) ([]*abci.TxResult, int, error) {
+	args := sqlsink.NewQueryArgs("?")
+	filter, err := txFilter(q, args)
+	if err != nil {
+		return nil, 0, fmt.Errorf("translating query: %w", err)
+	}
+	from := `
+FROM tx_results txr
+WHERE ` + filter
+
+	var totalCount int
+	if err := es.store.QueryRowContext(ctx, `SELECT COUNT(*)`+from+`;`, args.Values...).Scan(&totalCount); err != nil {
+		return nil, 0, fmt.Errorf("counting txs: %w", err)
+	}
+
+	order := "ASC"
+	if pagSettings.OrderDesc {
+		order = "DESC"
+	}
+	stmt := `SELECT txr.tx_result` + from + `
+ORDER BY txr.height ` + order + `, txr."index" ` + order
+	if pagSettings.IsPaginated {
+		page, err := sqlsink.ValidatePage(pagSettings.Page, pagSettings.PerPage, totalCount)
+		if err != nil {
+			return nil, 0, err
+		}
+		stmt += "\nLIMIT " + args.Add(pagSettings.PerPage) + " OFFSET " + args.Add((page-1)*pagSettings.PerPage)
+	}
+
+	rows, err := es.store.QueryContext(ctx, stmt+";", args.Values...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("searching txs: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]*abci.TxResult, 0)
+	for rows.Next() {
+		var resultData []byte
+		if err := rows.Scan(&resultData); err != nil {
+			return nil, 0, fmt.Errorf("scanning tx_result: %w", err)
+		}
+		txr := new(abci.TxResult)
+		if err := proto.Unmarshal(resultData, txr); err != nil {
+			return nil, 0, fmt.Errorf("unmarshaling tx_result: %w", err)
+		}
+		results = append(results, txr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("searching txs: %w", err)
+	}
+	return results, totalCount, nil
+}
+
+// GetTxByHash returns the result of the transaction with the given hash, or
+// nil if it has not been indexed. It is part of the indexer.EventSink
+// interface.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, txindex.ErrorEmptyHash
+	}
+
+	var resultData []byte
+	err := es.store.QueryRow(`
+SELECT tx_result FROM tx_results
+WHERE tx_hash = ?
+ORDER BY height DESC
+LIMIT 1;
+`, fmt.Sprintf("%X", hash)).Scan(&resultData)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("getting tx by hash: %w", err)
+	}
+
+	txr := new(abci.TxResult)
+	if err := proto.Unmarshal(resultData, txr); err != nil {
+		return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+	}
+	return txr, nil
+}
+
+// HasBlock reports whether the block at the given height has been indexed. It
+// is part of the indexer.EventSink interface.
+func (es *EventSink) HasBlock(height int64) (bool, error) {
+	var has bool
+	if err := es.store.QueryRow(`
+SELECT EXISTS(SELECT 1 FROM blocks WHERE height = ?);
+`, height).Scan(&has); err != nil {
+		return false, fmt.Errorf("checking block existence: %w", err)
+	}
+	return has, nil
+}
+
+// getRetainHeight returns the retain height of the named indexer, or
+// state.ErrKeyNotFound if it has not been set.
+func (es *EventSink) getRetainHeight(name string) (int64, error) {
+	var height sql.NullInt64
+	err := es.store.QueryRow(`SELECT retain_height FROM retain_heights WHERE name = ?;`, name).Scan(&height)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !height.Valid) {
+		return 0, state.ErrKeyNotFound
+	} else if err != nil {
+		return 0, fmt.Errorf("getting %s indexer retain height: %w", name, err)
+	}
+	return height.Int64, nil
+}
+
+// setRetainHeight sets the retain height of the named indexer.
+func (es *EventSink) setRetainHeight(name string, height int64) error {
+	if _, err := es.store.Exec(`
+INSERT INTO retain_heights (name, retain_height) VALUES (?, ?)
+  ON CONFLICT (name) DO UPDATE SET retain_height = excluded.retain_height;
+`, name, height); err != nil {
+		return fmt.Errorf("setting %s indexer retain height: %w", name, err)
+	}
+	return nil
+}
+
+// getLastRetainHeight returns the height the named indexer was last pruned
+// to, or 0 if it has never been pruned.
+func (es *EventSink) getLastRetainHeight(name string) (int64, error) {
+	var height sql.NullInt64
+	err := es.store.QueryRow(`SELECT last_retain_height FROM retain_heights WHERE name = ?;`, name).Scan(&height)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("getting last %s indexer retain height: %w", name, err)
+	}
+	return height.Int64, nil
+}
+
+// prune deletes the rows of the named indexer below retainHeight. The
+// records to delete are the rows of table below retainHeight, and the events
+// (with their attributes) selected by eventFilter below retainHeight. It
+// returns the number of heights pruned and the new retain height.
+func (es *EventSink) prune(name, table, eventFilter string, retainHeight int64) (numPruned, newRetainHeight int64, err error) {
+	lastRetainHeight, err := es.getLastRetainHeight(name)
+	if err != nil {
+		return 0, 0, err
+	}
+	if lastRetainHeight == 0 {
+		lastRetainHeight = 1
+	}
+	if retainHeight <= lastRetainHeight {
+		return 0, lastRetainHeight, nil
+	}
+
+	err = sqlsink.RunInTransaction(es.store, func(dbtx *sql.Tx) error {
+		if err := dbtx.QueryRow(`SELECT COUNT(DISTINCT height) FROM `+table+` WHERE height < ?;`,
+			retainHeight).Scan(&numPruned); err != nil {
+			return fmt.Errorf("counting heights: %w", err)
+		}
+		if _, err := dbtx.Exec(`
+DELETE FROM attributes WHERE event_id IN (
+  SELECT rowid FROM events WHERE height < ? AND `+eventFilter+`);
+`, retainHeight); err != nil {
+			return fmt.Errorf("deleting attributes: %w", err)
+		}
+		if _, err := dbtx.Exec(`DELETE FROM events WHERE height < ? AND `+eventFilter+`;`, retainHeight); err != nil {
+			return fmt.Errorf("deleting events: %w", err)
+		}
+		if _, err := dbtx.Exec(`DELETE FROM `+table+` WHERE height < ?;`, retainHeight); err != nil {
+			return fmt.Errorf("deleting %s: %w", table, err)
+		}
+		if _, err := dbtx.Exec(`
+INSERT INTO retain_heights (name, last_retain_height) VALUES (?, ?)
+  ON CONFLICT (name) DO UPDATE SET last_retain_height = excluded.last_retain_height;
+`, name, retainHeight); err != nil {
+			return fmt.Errorf("setting last retain height: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, lastRetainHeight, fmt.Errorf("pruning %s indexer: %w", name, err)
+	}
+	return numPruned, retainHeight, nil
+}
+
+// Stop closes the underlying SQLite database.
+func (es *EventSink) Stop() error { return es.store.Close() }
@@ -0,0 +1,274 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/libs/pubsub/query"
+	"github.com/cometbft/cometbft/v2/state"
+	"github.com/cometbft/cometbft/v2/state/indexer/sink/internal/sqlsink"
+	"github.com/cometbft/cometbft/v2/state/txindex"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+func newTestSink(t *testing.T) *EventSink {
+	t.Helper()
+
+	es, err := NewEventSink(filepath.Join(t.TempDir(), FileName))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = es.Stop() })
+	return es
+}
+
+func newTxResult(height int64, index uint32, events ...abci.Event) *abci.TxResult {
+	return &abci.TxResult{
+		Height: height,
+		Index:  index,
+		Tx:     types.Tx(fmt.Sprintf("tx-%d-%d", height, index)),
+		Result: abci.ExecTxResult{Code: abci.CodeTypeOK, Events: events},
+	}
+}
+
+func TestIndexing(t *testing.T) {
+	es := newTestSink(t)
+
+	block := types.EventDataNewBlockEvents{
+		Height: 1,
+		Events: []abci.Event{
+			sqlsink.MakeIndexedEvent("begin_event.proposer", "FCAA001"),
+			sqlsink.MakeIndexedEvent("end_event.foo", "100"),
+			{Type: "", Attributes: []abci.EventAttribute{{Key: "not_allowed", Value: "Vlad", Index: true}}},
+		},
+	}
+	require.NoError(t, es.IndexBlockEvents(block))
+	// Indexing a block twice is not an error.
+	require.NoError(t, es.IndexBlockEvents(block))
+
+	has, err := es.HasBlock(1)
+	require.NoError(t, err)
+	assert.True(t, has)
+	has, err = es.HasBlock(2)
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	txr := newTxResult(1, 0,
+		sqlsink.MakeIndexedEvent("account.owner", "Ivan"),
+		abci.Event{Type: "account", Attributes: []abci.EventAttribute{{Key: "secret", Value: "xyz", Index: false}}},
+	)
+	require.NoError(t, es.IndexTxEvents([]*abci.TxResult{txr}))
+	// Indexing a transaction twice is not an error, and does not duplicate it.
+	require.NoError(t, es.IndexTxEvents([]*abci.TxResult{txr}))
+
+	got, err := es.GetTxByHash(types.Tx(txr.Tx).Hash())
+	require.NoError(t, err)
+	assert.Equal(t, txr, got)
+
+	got, err = es.GetTxByHash(types.Tx("unknown").Hash())
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = es.GetTxByHash(nil)
+	require.ErrorIs(t, err, txindex.ErrorEmptyHash)
+
+	txrs, err := es.SearchTxEvents(context.Background(), query.MustCompile("account.owner EXISTS"))
+	require.NoError(t, err)
+	assert.Equal(t, []*abci.TxResult{txr}, txrs)
+
+	// Attributes that are not indexed, and events without a type, cannot be
+	// searched.
+	txrs, err = es.SearchTxEvents(context.Background(), query.MustCompile("account.secret EXISTS"))
+	require.NoError(t, err)
+	assert.Empty(t, txrs)
+	heights, err := es.SearchBlockEvents(context.Background(), query.MustCompile("not_allowed EXISTS"))
+	require.NoError(t, err)
+	assert.Empty(t, heights)
+}
+
+func TestSearch(t *testing.T) {
+	es := newTestSink(t)
+
+	ts := func(s string) string { return s + "T12:00:00Z" }
+	for h, day := range map[int64]string{1: "2024-01-01", 2: "2024-06-01", 3: "2025-01-01"} {
+		require.NoError(t, es.IndexBlockEvents(types.EventDataNewBlockEvents{
+			Height: h,
+			Events: []abci.Event{
+				sqlsink.MakeIndexedEvent("reward.amount", fmt.Sprint(h*10)),
+				sqlsink.MakeIndexedEvent("reward.time", ts(day)),
+				sqlsink.MakeIndexedEvent("reward.day", day),
+			},
+		}))
+	}
+
+	txrs := []*abci.TxResult{
+		newTxResult(1, 0, sqlsink.MakeIndexedEvent("transfer.sender", "Ivan"), sqlsink.MakeIndexedEvent("transfer.amount", "5")),
+		newTxResult(1, 1, sqlsink.MakeIndexedEvent("transfer.sender", "Yulieta"), sqlsink.MakeIndexedEvent("transfer.amount", "15.5")),
+		newTxResult(2, 0, sqlsink.MakeIndexedEvent("transfer.sender", "Ivanka"), sqlsink.MakeIndexedEvent("transfer.amount", "not a number")),
+		newTxResult(3, 0, sqlsink.MakeIndexedEvent("message.action", "vote")),
+	}
+	require.NoError(t, es.TxIndexer().AddBatch(&txindex.Batch{Ops: txrs}))
+
+	t.Run("SearchTxEvents", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			want  []*abci.TxResult
+		}{
+			{"tx.height = 1", txrs[0:2]},
+			{"tx.height > 1", txrs[2:4]},
+			{"tx.height >= 1 AND tx.height <= 2", txrs[0:3]},
+			{fmt.Sprintf("tx.hash = '%x'", types.Tx(txrs[2].Tx).Hash()), txrs[2:3]},
+			{"transfer.sender = 'Ivan'", txrs[0:1]},
+			{"transfer.sender CONTAINS 'Ivan'", []*abci.TxResult{txrs[0], txrs[2]}},
+			{"transfer.sender EXISTS", txrs[0:3]},
+			{"transfer.amount > 5", txrs[1:2]},
+			{"transfer.amount <= 15.5", txrs[0:2]},
+			{"transfer.sender CONTAINS 'Ivan' AND tx.height = 2", txrs[2:3]},
+			{"transfer.sender = 'Ivan' AND message.action = 'vote'", []*abci.TxResult{}},
+			{"message.action EXISTS", txrs[3:4]},
+			{"nothing.here EXISTS", []*abci.TxResult{}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.query, func(t *testing.T) {
+				got, err := es.SearchTxEvents(context.Background(), query.MustCompile(tc.query))
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		txIndexer := es.TxIndexer()
+		q := query.MustCompile("tx.height >= 1")
+
+		got, total, err := txIndexer.Search(context.Background(), q, txindex.Pagination{
+			IsPaginated: true, Page: 1, PerPage: 3,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+		assert.Equal(t, txrs[0:3], got)
+
+		got, total, err = txIndexer.Search(context.Background(), q, txindex.Pagination{
+			IsPaginated: true, Page: 2, PerPage: 3,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+		assert.Equal(t, txrs[3:4], got)
+
+		got, total, err = txIndexer.Search(context.Background(), q, txindex.Pagination{
+			OrderDesc: true, IsPaginated: true, Page: 1, PerPage: 2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+		assert.Equal(t, []*abci.TxResult{txrs[3], txrs[2]}, got)
+
+		_, _, err = txIndexer.Search(context.Background(), q, txindex.Pagination{
+			IsPaginated: true, Page: 3, PerPage: 3,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("SearchBlockEvents", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			want  []int64
+		}{
+			{"block.height = 2", []int64{2}},
+			{"block.height < 3", []int64{1, 2}},
+			{"reward.amount >= 20", []int64{2, 3}},
+			{"reward.amount = 10 AND block.height = 1", []int64{1}},
+			{"reward.amount = 10 AND block.height = 2", []int64{}},
+			{"reward.time > TIME 2024-03-01T00:00:00Z", []int64{2, 3}},
+			{"reward.day < DATE 2024-06-01", []int64{1}},
+			{"reward.day CONTAINS '2024'", []int64{1, 2}},
+			{"transfer.sender EXISTS", []int64{}}, // tx events are not block events
+		}
+		for _, tc := range testCases {
+			t.Run(tc.query, func(t *testing.T) {
+				got, err := es.BlockIndexer().Search(context.Background(), query.MustCompile(tc.query))
+				require.NoError(t, err)
+				assert.Equal(t, tc.want, got)
+			})
+		}
+	})
+}
+
+func TestPrune(t *testing.T) {
+	es := newTestSink(t)
+	txIndexer, blockIndexer := es.TxIndexer(), es.BlockIndexer()
+
+	for h := int64(1); h <= 5; h++ {
+		require.NoError(t, blockIndexer.Index(types.EventDataNewBlockEvents{
+			Height: h,
+			Events: []abci.Event{sqlsink.MakeIndexedEvent("reward.amount", "10")},
+		}))
+		require.NoError(t, txIndexer.Index(newTxResult(h, 0, sqlsink.MakeIndexedEvent("transfer.sender", "Ivan"))))
+	}
+
+	_, err := txIndexer.GetRetainHeight()
+	require.ErrorIs(t, err, state.ErrKeyNotFound)
+	_, err = blockIndexer.GetRetainHeight()
+	require.ErrorIs(t, err, state.ErrKeyNotFound)
+
+	require.NoError(t, txIndexer.SetRetainHeight(3))
+	require.NoError(t, blockIndexer.SetRetainHeight(4))
+	retainHeight, err := txIndexer.GetRetainHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, retainHeight)
+	retainHeight, err = blockIndexer.GetRetainHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, retainHeight)
+
+	numPruned, newRetainHeight, err := txIndexer.Prune(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, numPruned)
+	assert.EqualValues(t, 3, newRetainHeight)
+
+	txrs, err := es.SearchTxEvents(context.Background(), query.MustCompile("transfer.sender = 'Ivan'"))
+	require.NoError(t, err)
+	require.Len(t, txrs, 3)
+	assert.EqualValues(t, 3, txrs[0].Height)
+	txr, err := txIndexer.Get(types.Tx("tx-1-0").Hash())
+	require.NoError(t, err)
+	assert.Nil(t, txr)
+
+	// Pruning transactions does not prune blocks.
+	heights, err := es.SearchBlockEvents(context.Background(), query.MustCompile("reward.amount = 10"))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, heights)
+
+	numPruned, newRetainHeight, err = blockIndexer.Prune(4)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, numPruned)
+	assert.EqualValues(t, 4, newRetainHeight)
+
+	heights, err = es.SearchBlockEvents(context.Background(), query.MustCompile("reward.amount = 10"))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4, 5}, heights)
+	has, err := blockIndexer.Has(3)
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	// Pruning blocks does not prune transactions.
+	txrs, err = es.SearchTxEvents(context.Background(), query.MustCompile("tx.height >= 3"))
+	require.NoError(t, err)
+	assert.Len(t, txrs, 3)
+
+	// Pruning again to the same height is a no-op.
+	numPruned, newRetainHeight, err = txIndexer.Prune(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, numPruned)
+	assert.EqualValues(t, 3, newRetainHeight)
+
+	// Only the rows of the pruned heights are left.
+	var numEvents, numAttrs int
+	require.NoError(t, es.DB().QueryRow(`SELECT COUNT(*) FROM events WHERE height < 3;`).Scan(&numEvents))
+	require.NoError(t, es.DB().QueryRow(`
+SELECT COUNT(*) FROM attributes a JOIN events e ON e.rowid = a.event_id WHERE e.height < 4;`).Scan(&numAttrs))
+	assert.Zero(t, numEvents)
+	assert.Equal(t, 3, numAttrs) // tx.hash, tx.height and transfer.sender of the tx at height 3
+}
@@ -66,4 +66,9 @@ type Metrics struct {
 
 	// The duration of event firing related to a new block
 	FireBlockEventsDelaySeconds metrics.Gauge
+
+	// Number of optimistic executions of proposed blocks, labeled by whether
+	// their result was used, discarded because another block was decided, or
+	// failed.
+	OptimisticExecutions metrics.Counter `metrics_labels:"result"`
 }
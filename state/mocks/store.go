@@ -519,6 +519,24 @@ func (_m *Store) SaveFinalizeBlockResponse(height int64, res *v2.FinalizeBlockRe
 	return r0
 }
 
+// SaveValidatorsAndConsensusParams provides a mock function with given fields: height, vals, valsChanged, params, paramsChanged
+func (_m *Store) SaveValidatorsAndConsensusParams(height int64, vals *types.ValidatorSet, valsChanged int64, params types.ConsensusParams, paramsChanged int64) error {
+	ret := _m.Called(height, vals, valsChanged, params, paramsChanged)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveValidatorsAndConsensusParams")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, *types.ValidatorSet, int64, types.ConsensusParams, int64) error); ok {
+		r0 = rf(height, vals, valsChanged, params, paramsChanged)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetOfflineStateSyncHeight provides a mock function with given fields: height
 func (_m *Store) SetOfflineStateSyncHeight(height int64) error {
 	ret := _m.Called(height)
@@ -0,0 +1,102 @@
+package state
+
+import (
+	"bytes"
+	"context"
+
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/v2/libs/bytes"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// optimisticExecution is a FinalizeBlock call made on a proposed block before
+// it is decided.
+type optimisticExecution struct {
+	height    int64
+	blockHash cmtbytes.HexBytes
+	done      chan struct{} // closed once the call returned
+
+	resp *abci.FinalizeBlockResponse
+	err  error
+}
+
+// ExecuteBlockOptimistically starts executing block, proposed for the next
+// height and accepted by ProcessProposal, without waiting for it to be
+// decided, so that executing it overlaps the voting rounds. If block is
+// decided, ApplyBlock uses the result of this execution; otherwise, it waits
+// for the execution to end and discards its result.
+//
+// The application is sent FinalizeBlock for each block executed this way, and
+// then for the decided block if it is another one: it must discard the state
+// resulting from the previous FinalizeBlock calls made at the same height.
+func (blockExec *BlockExecutor) ExecuteBlockOptimistically(state State, block *types.Block) {
+	blockExec.optimisticMtx.Lock()
+	defer blockExec.optimisticMtx.Unlock()
+
+	prev := blockExec.optimistic
+	if prev != nil && bytes.Equal(prev.blockHash, block.Hash()) {
+		return
+	}
+
+	req := blockExec.finalizeBlockRequest(state, block, block.Height)
+	oe := &optimisticExecution{
+		height:    block.Height,
+		blockHash: block.Hash(),
+		done:      make(chan struct{}),
+	}
+	blockExec.optimistic = oe
+	blockExec.logger.Debug("Executing block optimistically", "height", block.Height, "hash", block.Hash())
+
+	go func() {
+		defer close(oe.done)
+		if prev != nil {
+			// The blocks are executed one at a time.
+			<-prev.done
+		}
+		oe.resp, oe.err = blockExec.proxyApp.FinalizeBlock(context.TODO(), req)
+	}()
+}
+
+// finalizeBlock executes block on the application, unless it was executed
+// optimistically.
+func (blockExec *BlockExecutor) finalizeBlock(state State, block *types.Block, syncingToHeight int64) (*abci.FinalizeBlockResponse, error) {
+	blockExec.optimisticMtx.Lock()
+	oe := blockExec.optimistic
+	blockExec.optimistic = nil
+	blockExec.optimisticMtx.Unlock()
+
+	if oe != nil {
+		// Also waits for the previous optimistic executions, which the
+		// application must not run concurrently with this one.
+		<-oe.done
+		switch {
+		case !bytes.Equal(oe.blockHash, block.Hash()):
+			blockExec.logger.Info("Discarding the optimistic execution of a block which was not decided",
+				"height", oe.height, "hash", oe.blockHash)
+			blockExec.metrics.OptimisticExecutions.With("result", "discarded").Add(1)
+		case oe.err != nil:
+			blockExec.logger.Error("Optimistic execution of the block failed, executing it again",
+				"height", oe.height, "err", oe.err)
+			blockExec.metrics.OptimisticExecutions.With("result", "failed").Add(1)
+		default:
+			blockExec.metrics.OptimisticExecutions.With("result", "used").Add(1)
+			return oe.resp, nil
+		}
+	}
+
+	return blockExec.proxyApp.FinalizeBlock(context.TODO(), blockExec.finalizeBlockRequest(state, block, syncingToHeight))
+}
+
+func (blockExec *BlockExecutor) finalizeBlockRequest(state State, block *types.Block, syncingToHeight int64) *abci.FinalizeBlockRequest {
+	return &abci.FinalizeBlockRequest{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  buildLastCommitInfoFromStore(block, blockExec.store, state.InitialHeight),
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+		SyncingToHeight:    syncingToHeight,
+	}
+}
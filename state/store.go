@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -14,6 +15,7 @@ import (
 	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v2"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/internal/keylayout"
 	cmtos "github.com/cometbft/cometbft/v2/internal/os"
 	"github.com/cometbft/cometbft/v2/libs/log"
 	cmtmath "github.com/cometbft/cometbft/v2/libs/math"
@@ -148,6 +150,72 @@ func (v2l v2Layout) CalcValidatorsKey(height int64) []byte {
 
 var _ KeyLayout = (*v2Layout)(nil)
 
+// KeyLayoutConverter returns the function rewriting the keys of a state store
+// database from the fromVersion key layout to the toVersion one, for
+// keylayout.Migrate.
+func KeyLayoutConverter(fromVersion, toVersion string) (keylayout.ConvertFunc, error) {
+	var (
+		parse func(key []byte) (func(KeyLayout, int64) []byte, int64, bool)
+		to    KeyLayout
+	)
+	switch {
+	case fromVersion == "v1" && toVersion == "v2":
+		parse, to = parseV1Key, v2Layout{}
+	case fromVersion == "v2" && toVersion == "v1":
+		parse, to = parseV2Key, v1LegacyLayout{}
+	default:
+		return nil, fmt.Errorf("cannot convert the state store keys from %q to %q", fromVersion, toVersion)
+	}
+	return func(key []byte) ([]byte, bool, error) {
+		calc, height, ok := parse(key)
+		if !ok {
+			return nil, false, nil
+		}
+		return calc(to, height), true, nil
+	}, nil
+}
+
+// parseV1Key returns the method computing key, and its height, if key is a
+// key of the v1 layout.
+func parseV1Key(key []byte) (func(KeyLayout, int64) []byte, int64, bool) {
+	prefix, heightStr, ok := strings.Cut(string(key), ":")
+	if !ok {
+		return nil, 0, false
+	}
+	height, err := strconv.ParseInt(heightStr, 10, 64)
+	if err != nil {
+		return nil, 0, false
+	}
+	switch prefix {
+	case "validatorsKey":
+		return KeyLayout.CalcValidatorsKey, height, true
+	case "consensusParamsKey":
+		return KeyLayout.CalcConsensusParamsKey, height, true
+	case "abciResponsesKey":
+		return KeyLayout.CalcABCIResponsesKey, height, true
+	}
+	return nil, 0, false
+}
+
+// parseV2Key returns the method computing key, and its height, if key is a
+// key of the v2 layout.
+func parseV2Key(key []byte) (func(KeyLayout, int64) []byte, int64, bool) {
+	var prefix, height int64
+	rest, err := orderedcode.Parse(string(key), &prefix, &height)
+	if err != nil || rest != "" {
+		return nil, 0, false
+	}
+	switch prefix {
+	case prefixValidators:
+		return KeyLayout.CalcValidatorsKey, height, true
+	case prefixConsensusParams:
+		return KeyLayout.CalcConsensusParamsKey, height, true
+	case prefixABCIResponses:
+		return KeyLayout.CalcABCIResponsesKey, height, true
+	}
+	return nil, 0, false
+}
+
 //go:generate ../scripts/mockery_generate.sh Store
 
 // Store defines the state store interface
@@ -175,6 +243,10 @@ type Store interface {
 	Save(state State) error
 	// SaveFinalizeBlockResponse saves ABCIResponses for a given height
 	SaveFinalizeBlockResponse(height int64, res *abci.FinalizeBlockResponse) error
+	// SaveValidatorsAndConsensusParams saves the validator set and consensus params of a given height,
+	// which last changed at the given heights, e.g. to import the history of another node
+	SaveValidatorsAndConsensusParams(height int64, vals *types.ValidatorSet, valsChanged int64,
+		params types.ConsensusParams, paramsChanged int64) error
 	// Bootstrap is used for bootstrapping state when not starting from a initial height.
 	Bootstrap(state State) error
 	// PruneStates takes the height from which to start pruning and which height stop at
@@ -1031,6 +1103,31 @@ func (store dbStore) saveValidatorsInfo(height, lastHeightChanged int64, valSet
 
 // -----------------------------------------------------------------------------
 
+// SaveValidatorsAndConsensusParams saves the validator set and consensus
+// params of height, which last changed at valsChanged and paramsChanged, as
+// saved when the block at height is applied. The validator set and consensus
+// params at the change heights must be saved too, unless they are height.
+func (store dbStore) SaveValidatorsAndConsensusParams(
+	height int64,
+	vals *types.ValidatorSet,
+	valsChanged int64,
+	params types.ConsensusParams,
+	paramsChanged int64,
+) error {
+	if paramsChanged > height {
+		return errors.New("paramsChanged cannot be greater than ConsensusParamsInfo height")
+	}
+	batch := store.db.NewBatch()
+	defer batch.Close()
+	if err := store.saveValidatorsInfo(height, valsChanged, vals, batch); err != nil {
+		return err
+	}
+	if err := store.saveConsensusParamsInfo(height, paramsChanged, params, batch); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
 // ConsensusParamsInfo represents the latest consensus params, or the last height it changed
 
 // LoadConsensusParams loads the ConsensusParams for a given height.
@@ -14,6 +14,7 @@ import (
 	abci "github.com/cometbft/cometbft/v2/abci/types"
 	cfg "github.com/cometbft/cometbft/v2/config"
 	"github.com/cometbft/cometbft/v2/crypto/ed25519"
+	"github.com/cometbft/cometbft/v2/internal/keylayout"
 	"github.com/cometbft/cometbft/v2/internal/test"
 	"github.com/cometbft/cometbft/v2/libs/log"
 	sm "github.com/cometbft/cometbft/v2/state"
@@ -647,3 +648,46 @@ func TestIntConversion(t *testing.T) {
 	b := sm.Int64ToBytes(x)
 	require.Equal(t, x, sm.Int64FromBytes(b))
 }
+
+func TestKeyLayoutMigration(t *testing.T) {
+	state, stateDB, _ := makeState(3, 5, chainID)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	for h := int64(1); h <= state.LastBlockHeight; h++ {
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(h, &abci.FinalizeBlockResponse{AppHash: []byte{byte(h)}}))
+	}
+
+	type entries struct {
+		vals   *types.ValidatorSet
+		params types.ConsensusParams
+		resp   *abci.FinalizeBlockResponse
+	}
+	load := func(stateStore sm.Store, h int64) entries {
+		vals, err := stateStore.LoadValidators(h)
+		require.NoError(t, err)
+		params, err := stateStore.LoadConsensusParams(h)
+		require.NoError(t, err)
+		resp, err := stateStore.LoadFinalizeBlockResponse(h)
+		require.NoError(t, err)
+		return entries{vals: vals, params: params, resp: resp}
+	}
+	expected := make(map[int64]entries)
+	for h := int64(1); h <= state.LastBlockHeight; h++ {
+		expected[h] = load(stateStore, h)
+	}
+
+	for _, versions := range [][2]string{{"v1", "v2"}, {"v2", "v1"}} {
+		convert, err := sm.KeyLayoutConverter(versions[0], versions[1])
+		require.NoError(t, err)
+		_, err = keylayout.Migrate(stateDB, versions[1], convert, nil)
+		require.NoError(t, err)
+
+		// The key layout recorded in the database takes precedence.
+		migrated := sm.NewStore(stateDB, sm.StoreOptions{DBKeyLayout: versions[0]})
+		loaded, err := migrated.Load()
+		require.NoError(t, err)
+		require.Equal(t, state.LastBlockHeight, loaded.LastBlockHeight)
+		for h := int64(1); h <= state.LastBlockHeight; h++ {
+			assert.Equal(t, expected[h], load(migrated, h))
+		}
+	}
+}
@@ -90,6 +90,10 @@ func validateBlock(state State, block *types.Block) error {
 			return errors.New("initial block can't have LastCommit signatures")
 		}
 	} else {
+		if block.LastCommit.HasAggregatedSignature() &&
+			!state.ConsensusParams.Feature.BlsAggregatedCommitsEnabled(block.Height) {
+			return errors.New("block.LastCommit has an aggregated signature, but BLS aggregated commits are disabled")
+		}
 		// LastCommit.Signatures length is checked in VerifyCommit.
 		if err := state.LastValidators.VerifyCommit(
 			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit); err != nil {
@@ -10,6 +10,7 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/crypto/bls12381"
 	"github.com/cometbft/cometbft/v2/crypto/ed25519"
 	"github.com/cometbft/cometbft/v2/crypto/tmhash"
 	"github.com/cometbft/cometbft/v2/internal/test"
@@ -279,6 +280,56 @@ func TestValidateBlockCommit(t *testing.T) {
 	}
 }
 
+func TestValidateBlockAggregatedCommit(t *testing.T) {
+	proxyApp := newTestApp()
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, privVals := makeState(1, 1, chainID)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	mp := &mpmocks.Mempool{}
+	mp.On("Lock").Return()
+	mp.On("Unlock").Return()
+	mp.On("PreUpdate").Return()
+	mp.On("FlushAppConn", mock.Anything).Return(nil)
+	mp.On("Update",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything).Return(nil)
+
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		mp,
+		sm.EmptyEvidencePool{},
+		store.NewBlockStore(dbm.NewMemDB()),
+	)
+
+	proposerAddr := state.Validators.GetProposer().Address
+	state, _, lastExtCommit, err := makeAndCommitGoodBlock(
+		state, 1, &types.Commit{}, proposerAddr, blockExec, privVals, nil)
+	require.NoError(t, err)
+
+	// Blocks with an aggregated last commit are invalid unless BLS aggregated
+	// commits are enabled.
+	commit := lastExtCommit.ToCommit()
+	commit.Signatures[0].Signature = nil
+	commit.AggregatedSignature = make([]byte, bls12381.SignatureLength)
+	block := makeBlock(state, 2, commit)
+	err = blockExec.ValidateBlock(state, block)
+	require.ErrorContains(t, err, "BLS aggregated commits are disabled")
+
+	state.ConsensusParams.Feature.BlsAggregatedCommitsEnableHeight = 2
+	err = blockExec.ValidateBlock(state, block)
+	require.ErrorContains(t, err, "wrong aggregated signature")
+}
+
 func TestValidateBlockEvidence(t *testing.T) {
 	proxyApp := newTestApp()
 	require.NoError(t, proxyApp.Start())
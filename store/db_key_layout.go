@@ -1,10 +1,15 @@
 package store
 
 import (
+	"bytes"
 	"encoding/hex"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/google/orderedcode"
+
+	"github.com/cometbft/cometbft/v2/internal/keylayout"
 )
 
 type BlockKeyLayout interface {
@@ -211,3 +216,122 @@ func (*v2Layout) CalcSeenCommitKey(height int64) []byte {
 }
 
 var _ BlockKeyLayout = (*v2Layout)(nil)
+
+// KeyLayoutConverter returns the function rewriting the keys of a block store
+// database from the fromVersion key layout to the toVersion one, for
+// keylayout.Migrate.
+func KeyLayoutConverter(fromVersion, toVersion string) (keylayout.ConvertFunc, error) {
+	var (
+		parse func(key []byte) keyFunc
+		to    BlockKeyLayout
+	)
+	switch {
+	case fromVersion == "v1" && toVersion == "v2":
+		parse, to = parseV1Key, &v2Layout{}
+	case fromVersion == "v2" && toVersion == "v1":
+		parse, to = parseV2Key, &v1LegacyLayout{}
+	default:
+		return nil, fmt.Errorf("cannot convert the block store keys from %q to %q", fromVersion, toVersion)
+	}
+	return func(key []byte) ([]byte, bool, error) {
+		calc := parse(key)
+		if calc == nil {
+			return nil, false, nil
+		}
+		return calc(to), true, nil
+	}, nil
+}
+
+// keyFunc computes a key of the block store in the given layout.
+type keyFunc func(layout BlockKeyLayout) []byte
+
+func heightKeyFunc(calc func(BlockKeyLayout, int64) []byte, height int64) keyFunc {
+	return func(layout BlockKeyLayout) []byte { return calc(layout, height) }
+}
+
+// parseV1Key returns the function computing key in any layout, or nil if key
+// is not a key of the v1 layout.
+func parseV1Key(key []byte) keyFunc {
+	prefix, rest, ok := bytes.Cut(key, []byte{':'})
+	if !ok {
+		return nil
+	}
+	switch string(prefix) {
+	case "BH":
+		hash, err := hex.DecodeString(string(rest))
+		if err != nil {
+			return nil
+		}
+		return func(layout BlockKeyLayout) []byte { return layout.CalcBlockHashKey(hash) }
+	case "P":
+		heightStr, indexStr, ok := strings.Cut(string(rest), ":")
+		if !ok {
+			return nil
+		}
+		height, err := strconv.ParseInt(heightStr, 10, 64)
+		if err != nil {
+			return nil
+		}
+		index, err := strconv.Atoi(indexStr)
+		if err != nil {
+			return nil
+		}
+		return func(layout BlockKeyLayout) []byte { return layout.CalcBlockPartKey(height, index) }
+	}
+
+	height, err := strconv.ParseInt(string(rest), 10, 64)
+	if err != nil {
+		return nil
+	}
+	switch string(prefix) {
+	case "H":
+		return heightKeyFunc(BlockKeyLayout.CalcBlockMetaKey, height)
+	case "C":
+		return heightKeyFunc(BlockKeyLayout.CalcBlockCommitKey, height)
+	case "SC":
+		return heightKeyFunc(BlockKeyLayout.CalcSeenCommitKey, height)
+	case "EC":
+		return heightKeyFunc(BlockKeyLayout.CalcExtCommitKey, height)
+	}
+	return nil
+}
+
+// parseV2Key returns the function computing key in any layout, or nil if key
+// is not a key of the v2 layout.
+func parseV2Key(key []byte) keyFunc {
+	var prefix int64
+	rest, err := orderedcode.Parse(string(key), &prefix)
+	if err != nil {
+		return nil
+	}
+	switch prefix {
+	case prefixBlockHash:
+		var hash string
+		if rest, err = orderedcode.Parse(rest, &hash); err != nil || rest != "" {
+			return nil
+		}
+		return func(layout BlockKeyLayout) []byte { return layout.CalcBlockHashKey([]byte(hash)) }
+	case prefixBlockPart:
+		var height, index int64
+		if rest, err = orderedcode.Parse(rest, &height, &index); err != nil || rest != "" {
+			return nil
+		}
+		return func(layout BlockKeyLayout) []byte { return layout.CalcBlockPartKey(height, int(index)) }
+	}
+
+	var height int64
+	if rest, err = orderedcode.Parse(rest, &height); err != nil || rest != "" {
+		return nil
+	}
+	switch prefix {
+	case prefixBlockMeta:
+		return heightKeyFunc(BlockKeyLayout.CalcBlockMetaKey, height)
+	case prefixBlockCommit:
+		return heightKeyFunc(BlockKeyLayout.CalcBlockCommitKey, height)
+	case prefixSeenCommit:
+		return heightKeyFunc(BlockKeyLayout.CalcSeenCommitKey, height)
+	case prefixExtCommit:
+		return heightKeyFunc(BlockKeyLayout.CalcExtCommitKey, height)
+	}
+	return nil
+}
@@ -6,6 +6,12 @@ import (
 	"encoding/hex"
 	"strconv"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/v2/internal/keylayout"
+	"github.com/cometbft/cometbft/v2/types"
 )
 
 // run: go test -fuzz=FuzzCalcBlockPartKey -fuzztime 30s
@@ -87,3 +93,52 @@ func FuzzCalcBlockHashKey(f *testing.F) {
 		}
 	})
 }
+
+func TestKeyLayoutMigration(t *testing.T) {
+	genDoc, privVals := makeExportTestGenesis(1)
+	bs, _ := makeExportTestStores(t, genDoc, privVals, 10, 6)
+	require.Equal(t, "v1", bs.GetVersion())
+
+	type entries struct {
+		block          *types.Block
+		meta           *types.BlockMeta
+		seenCommit     *types.Commit
+		blockCommit    *types.Commit
+		extendedCommit *types.ExtendedCommit
+	}
+	load := func(bs *BlockStore, h int64) entries {
+		block, meta := bs.LoadBlock(h)
+		require.NotNil(t, block, "height %d", h)
+		blockByHash, _ := bs.LoadBlockByHash(meta.BlockID.Hash)
+		require.NotNil(t, blockByHash, "height %d", h)
+		return entries{
+			block:          block,
+			meta:           meta,
+			seenCommit:     bs.LoadSeenCommit(h),
+			blockCommit:    bs.LoadBlockCommit(h),
+			extendedCommit: bs.LoadBlockExtendedCommit(h),
+		}
+	}
+	expected := make(map[int64]entries)
+	for h := bs.Base(); h <= bs.Height(); h++ {
+		expected[h] = load(bs, h)
+	}
+
+	for _, versions := range [][2]string{{"v1", "v2"}, {"v2", "v1"}} {
+		convert, err := KeyLayoutConverter(versions[0], versions[1])
+		require.NoError(t, err)
+		_, err = keylayout.Migrate(bs.db, versions[1], convert, nil)
+		require.NoError(t, err)
+
+		migrated := NewBlockStore(bs.db)
+		require.Equal(t, versions[1], migrated.GetVersion())
+		require.Equal(t, bs.Base(), migrated.Base())
+		require.Equal(t, bs.Height(), migrated.Height())
+		for h := bs.Base(); h <= bs.Height(); h++ {
+			assert.Equal(t, expected[h], load(migrated, h))
+		}
+	}
+
+	_, err := KeyLayoutConverter("v2", "v2")
+	require.Error(t, err)
+}
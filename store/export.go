@@ -0,0 +1,503 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v2"
+	cmtstore "github.com/cometbft/cometbft/api/cometbft/store/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/cometbft/cometbft/v2/internal/framing"
+	sm "github.com/cometbft/cometbft/v2/state"
+	"github.com/cometbft/cometbft/v2/types"
+)
+
+// ExportVersion is the version of the format of the exports written by
+// Export.
+const ExportVersion = 1
+
+// Entries hold a block and its commits, this only guards against allocating
+// huge buffers for corrupted lengths.
+const maxExportEntrySize = 1 << 30
+
+// ErrInvalidExport is returned by Import and ExportReader when the export is
+// malformed or corrupted.
+var ErrInvalidExport = errors.New("invalid block store export")
+
+// Export writes the heights fromHeight to toHeight (inclusive) of bs to w:
+// for each height, the block, its seen commit, its extended commit if vote
+// extensions were enabled, and from stateStore, the validators and consensus
+// params of the height, and its FinalizeBlock response if it was kept. If
+// toHeight is the latest height of the node, the state of the node is exported
+// too. Unlike a copy of the databases, the export does not depend on the
+// database backend or key layout; Import saves it to the stores of a node.
+//
+// An export is a stream of ExportEntry messages, each preceded by its CRC-32C
+// checksum and its length like the messages of the consensus WAL: an
+// ExportHeader, an ExportedHeight per height, and an ExportFooter.
+func Export(w io.Writer, bs *BlockStore, stateStore sm.Store, chainID string, fromHeight, toHeight int64) error {
+	if fromHeight < bs.Base() || toHeight > bs.Height() || fromHeight > toHeight {
+		return fmt.Errorf("cannot export heights %d to %d, the block store has heights %d to %d",
+			fromHeight, toHeight, bs.Base(), bs.Height())
+	}
+	bw := bufio.NewWriter(w)
+
+	err := writeExportEntry(bw, &cmtstore.ExportEntry{Sum: &cmtstore.ExportEntry_Header{Header: &cmtstore.ExportHeader{
+		Version:    ExportVersion,
+		ChainId:    chainID,
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	}}})
+	if err != nil {
+		return err
+	}
+	for height := fromHeight; height <= toHeight; height++ {
+		exported, err := exportHeight(bs, stateStore, height)
+		if err != nil {
+			return err
+		}
+		if err := writeExportEntry(bw, &cmtstore.ExportEntry{Sum: &cmtstore.ExportEntry_Height{Height: exported}}); err != nil {
+			return err
+		}
+	}
+	footer := &cmtstore.ExportFooter{NumHeights: toHeight - fromHeight + 1}
+	state, err := stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if state.LastBlockHeight == toHeight {
+		if footer.State, err = state.ToProto(); err != nil {
+			return err
+		}
+	}
+	if err := writeExportEntry(bw, &cmtstore.ExportEntry{Sum: &cmtstore.ExportEntry_Footer{Footer: footer}}); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func exportHeight(bs *BlockStore, stateStore sm.Store, height int64) (*cmtstore.ExportedHeight, error) {
+	blockMeta := bs.LoadBlockMeta(height)
+	block, _ := bs.LoadBlock(height)
+	if blockMeta == nil || block == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	pbBlock, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	seenCommit := bs.LoadSeenCommit(height)
+	if seenCommit == nil {
+		// The commit of the next block commits this one just as well.
+		if seenCommit = bs.LoadBlockCommit(height); seenCommit == nil {
+			return nil, fmt.Errorf("commit at height %d not found", height)
+		}
+	}
+	vals, err := stateStore.LoadValidators(height)
+	if err != nil {
+		return nil, err
+	}
+	pbVals, err := vals.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	params, err := stateStore.LoadConsensusParams(height)
+	if err != nil {
+		return nil, err
+	}
+	pbParams := params.ToProto()
+	pbBlockID := blockMeta.BlockID.ToProto()
+	exported := &cmtstore.ExportedHeight{
+		BlockId:         &pbBlockID,
+		Block:           pbBlock,
+		SeenCommit:      seenCommit.ToProto(),
+		ExtendedCommit:  bs.LoadBlockExtendedCommit(height).ToProto(),
+		Validators:      pbVals,
+		ConsensusParams: &pbParams,
+	}
+	resp, err := stateStore.LoadFinalizeBlockResponse(height)
+	var errNoResp sm.ErrNoABCIResponsesForHeight
+	switch {
+	case err == nil:
+		exported.FinalizeBlockResponse = resp
+	case errors.Is(err, sm.ErrFinalizeBlockResponsesNotPersisted), errors.As(err, &errNoResp):
+		// The results were discarded or pruned.
+	default:
+		return nil, err
+	}
+	return exported, nil
+}
+
+// Import saves the heights of the export read from r, as written by Export,
+// to bs, and their validators, consensus params and FinalizeBlock responses
+// to stateStore. If the export holds the state of the node it was exported
+// from, and stateStore has no state yet or an older one, the state is saved
+// too, for the node to start from the last height of the export. The export
+// must be of the chain of genDoc, and its first height must follow the height
+// of bs, unless bs is empty. It returns the header of the export.
+//
+// Before being saved, every block is checked to be committed by its
+// validators, and to follow the previous block. The first block must follow
+// the last block of bs, or be the initial block committed by the genesis
+// validators, or else have the given trusted hash, as the export may not come
+// from a trusted source. If trustHash is set, it must be the hash of the first
+// block in any case. The FinalizeBlock responses and the next validators
+// and app hash of the state, which are committed by heights that are not
+// exported, cannot be checked.
+//
+// The heights saved before an invalid entry is found are not removed.
+func Import(
+	r io.Reader,
+	bs *BlockStore,
+	stateStore sm.Store,
+	genDoc *types.GenesisDoc,
+	trustHash []byte,
+) (*cmtstore.ExportHeader, error) {
+	er, err := NewExportReader(r)
+	if err != nil {
+		return nil, err
+	}
+	header := er.Header()
+	switch {
+	case header.ChainId != genDoc.ChainID:
+		return nil, fmt.Errorf("the export is of chain %q, expected %q", header.ChainId, genDoc.ChainID)
+	case bs.Base() > 0 && header.FromHeight != bs.Height()+1:
+		return nil, fmt.Errorf("cannot import heights %d to %d, the block store has heights %d to %d",
+			header.FromHeight, header.ToHeight, bs.Base(), bs.Height())
+	}
+
+	// The first height follows the last height of bs, if any.
+	var prev *importedHeight
+	if meta := bs.LoadBlockMeta(bs.Height()); meta != nil {
+		prev = &importedHeight{blockID: meta.BlockID, header: meta.Header}
+		if vals, err := stateStore.LoadValidators(meta.Header.Height); err == nil {
+			prev.vals = vals
+		}
+	}
+	for height := header.FromHeight; ; height++ {
+		exported, err := er.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return header, err
+		}
+		var trust trustedRoot
+		if height == header.FromHeight {
+			trust = trustedRoot{genDoc: genDoc, hash: trustHash}
+		}
+		if prev, err = importHeight(bs, stateStore, genDoc.ChainID, height, exported, prev, trust); err != nil {
+			return header, err
+		}
+	}
+	if state := er.Footer().State; state != nil {
+		if err := importState(stateStore, genDoc.ChainID, state, prev); err != nil {
+			return header, err
+		}
+	}
+	return header, nil
+}
+
+// ExportReader reads the heights of an export, as written by Export, in
+// order.
+type ExportReader struct {
+	r      *bufio.Reader
+	header *cmtstore.ExportHeader
+	footer *cmtstore.ExportFooter
+	height int64 // of the next entry
+}
+
+// NewExportReader reads the header of the export read from r. The error
+// wraps ErrInvalidExport if r is not an export.
+func NewExportReader(r io.Reader) (*ExportReader, error) {
+	br := bufio.NewReader(r)
+	entry, err := readExportEntry(br)
+	if err != nil {
+		return nil, err
+	}
+	header := entry.GetHeader()
+	switch {
+	case header == nil:
+		return nil, fmt.Errorf("%w: expected a header, got %T", ErrInvalidExport, entry.Sum)
+	case header.Version != ExportVersion:
+		return nil, fmt.Errorf("unsupported export version %d, expected %d", header.Version, ExportVersion)
+	case header.FromHeight <= 0 || header.FromHeight > header.ToHeight:
+		return nil, fmt.Errorf("%w: invalid heights %d to %d", ErrInvalidExport, header.FromHeight, header.ToHeight)
+	}
+	return &ExportReader{r: br, header: header, height: header.FromHeight}, nil
+}
+
+// Header returns the header of the export.
+func (er *ExportReader) Header() *cmtstore.ExportHeader {
+	return er.header
+}
+
+// Footer returns the footer of the export, once Next has returned io.EOF.
+func (er *ExportReader) Footer() *cmtstore.ExportFooter {
+	return er.footer
+}
+
+// Next returns the next height of the export, or io.EOF once all the heights
+// have been read and the footer was found to end the export.
+func (er *ExportReader) Next() (*cmtstore.ExportedHeight, error) {
+	if er.footer != nil {
+		return nil, io.EOF
+	}
+	entry, err := readExportEntry(er.r)
+	if err != nil {
+		return nil, err
+	}
+	numHeights := er.header.ToHeight - er.header.FromHeight + 1
+	if er.height > er.header.ToHeight {
+		footer := entry.GetFooter()
+		if footer == nil || footer.NumHeights != numHeights {
+			return nil, fmt.Errorf("%w: expected a footer for %d heights, got %v", ErrInvalidExport, numHeights, entry.Sum)
+		}
+		if _, err := er.r.ReadByte(); !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: unexpected data after the footer", ErrInvalidExport)
+		}
+		er.footer = footer
+		return nil, io.EOF
+	}
+	exported := entry.GetHeight()
+	if exported == nil {
+		return nil, fmt.Errorf("%w: expected height %d, got %T", ErrInvalidExport, er.height, entry.Sum)
+	}
+	er.height++
+	return exported, nil
+}
+
+// importedHeight is what is needed from the previous height to import a
+// height. Before the first imported height, it is loaded from the stores,
+// and the heights at which the validators and consensus params changed are
+// unknown.
+type importedHeight struct {
+	blockID       types.BlockID
+	header        types.Header
+	vals          *types.ValidatorSet
+	valsChanged   int64
+	params        *cmtproto.ConsensusParams
+	paramsChanged int64
+}
+
+// trustedRoot is what the first imported block is trusted from, if it does
+// not follow the last block of the block store.
+type trustedRoot struct {
+	genDoc *types.GenesisDoc
+	hash   []byte
+}
+
+func importHeight(
+	bs *BlockStore,
+	stateStore sm.Store,
+	chainID string,
+	height int64,
+	exported *cmtstore.ExportedHeight,
+	prev *importedHeight,
+	trust trustedRoot,
+) (*importedHeight, error) {
+	block, err := types.BlockFromProto(exported.Block)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid block at height %d: %v", ErrInvalidExport, height, err)
+	}
+	if block.Height != height {
+		return nil, fmt.Errorf("%w: expected the block at height %d, got height %d", ErrInvalidExport, height, block.Height)
+	}
+	blockID, err := types.BlockIDFromProto(exported.BlockId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid block ID at height %d: %v", ErrInvalidExport, height, err)
+	}
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	if err != nil {
+		return nil, err
+	}
+	if !blockID.Equals(types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}) {
+		return nil, fmt.Errorf("%w: the block at height %d does not match its ID %v", ErrInvalidExport, height, blockID)
+	}
+	if exported.Validators == nil || exported.ConsensusParams == nil {
+		return nil, fmt.Errorf("%w: missing validators or consensus params at height %d", ErrInvalidExport, height)
+	}
+	vals, err := types.ValidatorSetFromProto(exported.Validators)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid validators at height %d: %v", ErrInvalidExport, height, err)
+	}
+	params := types.ConsensusParamsFromProto(*exported.ConsensusParams)
+	if err := params.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("%w: invalid consensus params at height %d: %v", ErrInvalidExport, height, err)
+	}
+
+	var (
+		extCommit  *types.ExtendedCommit
+		seenCommit *types.Commit
+	)
+	if exported.ExtendedCommit != nil {
+		extCommit, err = types.ExtendedCommitFromProto(exported.ExtendedCommit)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid extended commit at height %d: %v", ErrInvalidExport, height, err)
+		}
+		if err := extCommit.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("%w: invalid extended commit at height %d: %v", ErrInvalidExport, height, err)
+		}
+		seenCommit = extCommit.ToCommit()
+	} else {
+		seenCommit, err = types.CommitFromProto(exported.SeenCommit)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid seen commit at height %d: %v", ErrInvalidExport, height, err)
+		}
+	}
+	if err := verifyImportedBlock(chainID, block, *blockID, seenCommit, vals, params, prev, trust); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExport, err)
+	}
+	if extCommit != nil {
+		bs.SaveBlockWithExtendedCommit(block, parts, extCommit)
+	} else {
+		bs.SaveBlock(block, parts, seenCommit)
+	}
+
+	// The validators and consensus params are saved in full at the first
+	// height, and from then on only when they change.
+	imported := &importedHeight{
+		blockID:       *blockID,
+		header:        block.Header,
+		vals:          vals,
+		valsChanged:   height,
+		params:        exported.ConsensusParams,
+		paramsChanged: height,
+	}
+	if prev != nil && prev.valsChanged > 0 && bytes.Equal(vals.Hash(), prev.vals.Hash()) {
+		imported.valsChanged = prev.valsChanged
+	}
+	if prev != nil && prev.paramsChanged > 0 && proto.Equal(exported.ConsensusParams, prev.params) {
+		imported.paramsChanged = prev.paramsChanged
+	}
+	err = stateStore.SaveValidatorsAndConsensusParams(height,
+		vals, imported.valsChanged, params, imported.paramsChanged)
+	if err != nil {
+		return nil, err
+	}
+	if exported.FinalizeBlockResponse != nil {
+		if err := stateStore.SaveFinalizeBlockResponse(height, exported.FinalizeBlockResponse); err != nil {
+			return nil, err
+		}
+	}
+	return imported, nil
+}
+
+// verifyImportedBlock checks that block is committed by seenCommit, signed by
+// its validators vals, and that it follows the previous block, if known, or
+// is trusted from trust otherwise.
+func verifyImportedBlock(
+	chainID string,
+	block *types.Block,
+	blockID types.BlockID,
+	seenCommit *types.Commit,
+	vals *types.ValidatorSet,
+	params types.ConsensusParams,
+	prev *importedHeight,
+	trust trustedRoot,
+) error {
+	height := block.Height
+	switch {
+	case block.ChainID != chainID:
+		return fmt.Errorf("the block at height %d is of chain %q", height, block.ChainID)
+	case !bytes.Equal(vals.Hash(), block.ValidatorsHash):
+		return fmt.Errorf("the validators at height %d do not match the block", height)
+	case !bytes.Equal(params.Hash(), block.ConsensusHash):
+		return fmt.Errorf("the consensus params at height %d do not match the block", height)
+	case len(trust.hash) > 0 && !bytes.Equal(trust.hash, blockID.Hash):
+		return fmt.Errorf("the block at height %d has hash %X, expected the trusted hash %X",
+			height, blockID.Hash, trust.hash)
+	}
+
+	switch {
+	case prev != nil:
+		if !block.LastBlockID.Equals(prev.blockID) {
+			return fmt.Errorf("the block at height %d does not follow the block %v", height, prev.blockID)
+		}
+		if !bytes.Equal(vals.Hash(), prev.header.NextValidatorsHash) {
+			return fmt.Errorf("the validators at height %d are not the next validators of height %d", height, height-1)
+		}
+		if prev.vals != nil {
+			if err := prev.vals.VerifyCommitLight(chainID, prev.blockID, height-1, block.LastCommit); err != nil {
+				return fmt.Errorf("invalid last commit at height %d: %w", height, err)
+			}
+		}
+	case len(trust.hash) > 0:
+	case trust.genDoc != nil && height == trust.genDoc.InitialHeight && len(trust.genDoc.Validators) > 0:
+		if !bytes.Equal(vals.Hash(), trust.genDoc.ValidatorHash()) {
+			return fmt.Errorf("the validators at height %d are not the genesis validators", height)
+		}
+	default:
+		return fmt.Errorf("the block at height %d cannot be trusted, its hash is needed", height)
+	}
+
+	if seenCommit.Height != height || !seenCommit.BlockID.Equals(blockID) {
+		return fmt.Errorf("the seen commit at height %d does not commit the block", height)
+	}
+	if err := vals.VerifyCommitLight(chainID, blockID, height, seenCommit); err != nil {
+		return fmt.Errorf("invalid seen commit at height %d: %w", height, err)
+	}
+	return nil
+}
+
+// importState saves the state exported along with the heights, at the last
+// imported height, unless stateStore already has a newer state.
+func importState(stateStore sm.Store, chainID string, pbState *cmtstate.State, last *importedHeight) error {
+	state, err := sm.FromProto(pbState)
+	if err != nil {
+		return fmt.Errorf("%w: invalid state: %v", ErrInvalidExport, err)
+	}
+	switch {
+	case state.ChainID != chainID:
+		return fmt.Errorf("%w: the state is of chain %q", ErrInvalidExport, state.ChainID)
+	case state.LastBlockHeight != last.header.Height || !state.LastBlockID.Equals(last.blockID):
+		return fmt.Errorf("%w: the state at height %d does not follow the last height %d",
+			ErrInvalidExport, state.LastBlockHeight, last.header.Height)
+	case !bytes.Equal(state.LastValidators.Hash(), last.header.ValidatorsHash),
+		!bytes.Equal(state.Validators.Hash(), last.header.NextValidatorsHash):
+		return fmt.Errorf("%w: the validators of the state do not match the last height %d",
+			ErrInvalidExport, last.header.Height)
+	}
+	current, err := stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if current.LastBlockHeight >= state.LastBlockHeight {
+		return nil
+	}
+
+	// As when bootstrapping after state sync, the validators and consensus
+	// params of the following heights are saved in full, since the heights at
+	// which they last changed may not have been imported.
+	if state.LastHeightValidatorsChanged < state.LastBlockHeight {
+		state.LastHeightValidatorsChanged = state.LastBlockHeight + 2
+	}
+	if state.LastHeightConsensusParamsChanged <= state.LastBlockHeight {
+		state.LastHeightConsensusParamsChanged = state.LastBlockHeight + 1
+	}
+	return stateStore.Bootstrap(*state)
+}
+
+func writeExportEntry(w io.Writer, entry *cmtstore.ExportEntry) error {
+	bz, err := entry.Marshal()
+	if err != nil {
+		return err
+	}
+	return framing.Write(w, bz, maxExportEntrySize)
+}
+
+func readExportEntry(r io.Reader) (*cmtstore.ExportEntry, error) {
+	bz, err := framing.Read(r, maxExportEntrySize)
+	if err != nil {
+		return nil, fmt.Errorf("%w: reading an entry: %v", ErrInvalidExport, err)
+	}
+	entry := new(cmtstore.ExportEntry)
+	if err := entry.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("%w: decoding an entry: %v", ErrInvalidExport, err)
+	}
+	return entry, nil
+}
@@ -0,0 +1,299 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/cometbft/cometbft/v2/internal/test"
+	sm "github.com/cometbft/cometbft/v2/state"
+	"github.com/cometbft/cometbft/v2/types"
+	cmttime "github.com/cometbft/cometbft/v2/types/time"
+)
+
+// makeExportTestGenesis returns the genesis of a chain with numVals
+// validators, and their private validators.
+func makeExportTestGenesis(numVals int) (*types.GenesisDoc, []types.PrivValidator) {
+	valSet, privVals := types.RandValidatorSet(numVals, 10)
+	return test.GenesisDoc(cmttime.Now(), valSet.Validators, test.ConsensusParams(), test.DefaultTestChainID), privVals
+}
+
+// makeExportTestStores returns stores with numHeights heights of the chain of
+// genDoc, committed by privVals, the validators and consensus params of which
+// are saved as by the block executor. Vote extensions are enabled from
+// extensionsHeight, and the ABCI results are kept for even heights.
+func makeExportTestStores(
+	t *testing.T,
+	genDoc *types.GenesisDoc,
+	privVals []types.PrivValidator,
+	numHeights, extensionsHeight int64,
+) (*BlockStore, sm.Store) {
+	t.Helper()
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+	require.NoError(t, stateStore.Save(state))
+	bs := NewBlockStore(dbm.NewMemDB())
+
+	lastCommit := new(types.Commit)
+	for h := int64(1); h <= numHeights; h++ {
+		block := state.MakeBlock(h, test.MakeNTxs(h, 2), lastCommit, nil, state.Validators.GetProposer().Address)
+		partSet, err := block.MakePartSet(types.BlockPartSizeBytes)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+		extEnabled := h >= extensionsHeight
+		var voteSet *types.VoteSet
+		if extEnabled {
+			voteSet = types.NewExtendedVoteSet(genDoc.ChainID, h, 0, types.PrecommitType, state.Validators)
+		} else {
+			voteSet = types.NewVoteSet(genDoc.ChainID, h, 0, types.PrecommitType, state.Validators)
+		}
+		seenCommit, err := types.MakeExtCommit(blockID, h, 0, voteSet, privVals, cmttime.Now(), extEnabled)
+		require.NoError(t, err)
+		if extEnabled {
+			bs.SaveBlockWithExtendedCommit(block, partSet, seenCommit)
+		} else {
+			bs.SaveBlock(block, partSet, seenCommit.ToCommit())
+		}
+		if h%2 == 0 {
+			require.NoError(t, stateStore.SaveFinalizeBlockResponse(h, &abci.FinalizeBlockResponse{
+				TxResults: []*abci.ExecTxResult{{Code: uint32(h)}},
+				AppHash:   []byte{byte(h)},
+			}))
+		}
+		lastCommit = seenCommit.ToCommit()
+		state.LastBlockHeight = h
+		state.LastBlockID = blockID
+		state.LastValidators = state.Validators.Copy()
+		require.NoError(t, stateStore.Save(state))
+	}
+	return bs, stateStore
+}
+
+func TestExportImport(t *testing.T) {
+	genDoc, privVals := makeExportTestGenesis(3)
+	bs, stateStore := makeExportTestStores(t, genDoc, privVals, 10, 6)
+
+	var buf bytes.Buffer
+	require.NoError(t, Export(&buf, bs, stateStore, test.DefaultTestChainID, 2, 10))
+	require.Error(t, Export(&bytes.Buffer{}, bs, stateStore, test.DefaultTestChainID, 2, 11))
+
+	// Import to a block store with another key layout.
+	importedBS := NewBlockStore(dbm.NewMemDB(), WithDBKeyLayout("v2"))
+	importedStateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{DBKeyLayout: "v2"})
+	block, _ := bs.LoadBlock(2)
+	header, err := Import(bytes.NewReader(buf.Bytes()), importedBS, importedStateStore, genDoc, block.Hash())
+	require.NoError(t, err)
+	assert.EqualValues(t, ExportVersion, header.Version)
+	assert.EqualValues(t, 2, importedBS.Base())
+	assert.EqualValues(t, 10, importedBS.Height())
+
+	for h := int64(2); h <= 10; h++ {
+		block, _ := bs.LoadBlock(h)
+		importedBlock, _ := importedBS.LoadBlock(h)
+		assert.Equal(t, block.Hash(), importedBlock.Hash())
+		assert.Equal(t, bs.LoadBlockMeta(h), importedBS.LoadBlockMeta(h))
+		assert.Equal(t, bs.LoadSeenCommit(h), importedBS.LoadSeenCommit(h))
+		assert.Equal(t, bs.LoadBlockExtendedCommit(h), importedBS.LoadBlockExtendedCommit(h))
+		if h < 10 {
+			assert.Equal(t, bs.LoadBlockCommit(h), importedBS.LoadBlockCommit(h))
+		}
+
+		vals, err := stateStore.LoadValidators(h)
+		require.NoError(t, err)
+		importedVals, err := importedStateStore.LoadValidators(h)
+		require.NoError(t, err)
+		assert.Equal(t, vals.Hash(), importedVals.Hash())
+		params, err := stateStore.LoadConsensusParams(h)
+		require.NoError(t, err)
+		importedParams, err := importedStateStore.LoadConsensusParams(h)
+		require.NoError(t, err)
+		assert.Equal(t, params, importedParams)
+
+		resp, err := stateStore.LoadFinalizeBlockResponse(h)
+		importedResp, importedErr := importedStateStore.LoadFinalizeBlockResponse(h)
+		if h%2 == 0 {
+			require.NoError(t, err)
+			require.NoError(t, importedErr)
+			assert.Equal(t, resp, importedResp)
+		} else {
+			require.Error(t, importedErr)
+		}
+	}
+
+	// The state is imported, and the validators and params of the next heights
+	// can be loaded from it.
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	importedState, err := importedStateStore.Load()
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, importedState.LastBlockHeight)
+	assert.Equal(t, state.LastBlockID, importedState.LastBlockID)
+	assert.Equal(t, state.AppHash, importedState.AppHash)
+	for h := int64(11); h <= 12; h++ {
+		_, err := importedStateStore.LoadValidators(h)
+		require.NoError(t, err)
+	}
+	_, err = importedStateStore.LoadConsensusParams(11)
+	require.NoError(t, err)
+
+	// The next heights can be imported later, without a trusted hash.
+	moreBS, moreStateStore := makeExportTestStores(t, genDoc, privVals, 12, 6)
+	buf.Reset()
+	require.NoError(t, Export(&buf, moreBS, moreStateStore, test.DefaultTestChainID, 12, 12))
+	_, err = Import(bytes.NewReader(buf.Bytes()), importedBS, importedStateStore, genDoc, nil)
+	require.Error(t, err, "height 11 is missing")
+
+	buf.Reset()
+	require.NoError(t, Export(&buf, bs, stateStore, test.DefaultTestChainID, 1, 1))
+	otherBS := NewBlockStore(dbm.NewMemDB())
+	otherStateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	_, err = Import(bytes.NewReader(buf.Bytes()), otherBS, otherStateStore, genDoc, nil)
+	require.NoError(t, err, "the initial height is committed by the genesis validators")
+	buf.Reset()
+	require.NoError(t, Export(&buf, bs, stateStore, test.DefaultTestChainID, 2, 10))
+	_, err = Import(bytes.NewReader(buf.Bytes()), otherBS, otherStateStore, genDoc, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, otherBS.Height())
+}
+
+// exportTestStores exports the heights fromHeight to toHeight of the stores.
+func exportTestStores(t *testing.T, bs *BlockStore, stateStore sm.Store, fromHeight, toHeight int64) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, Export(&buf, bs, stateStore, test.DefaultTestChainID, fromHeight, toHeight))
+	return buf.Bytes()
+}
+
+func TestImportInvalid(t *testing.T) {
+	genDoc, privVals := makeExportTestGenesis(1)
+	bs, stateStore := makeExportTestStores(t, genDoc, privVals, 5, 3)
+	export := exportTestStores(t, bs, stateStore, 1, 5)
+
+	testCases := map[string]struct {
+		export  func() []byte
+		chainID string
+		invalid bool
+	}{
+		"corrupted": {
+			export: func() []byte {
+				corrupted := bytes.Clone(export)
+				corrupted[len(corrupted)/2]++
+				return corrupted
+			},
+			chainID: test.DefaultTestChainID,
+			invalid: true,
+		},
+		"truncated": {
+			export:  func() []byte { return export[:len(export)-1] },
+			chainID: test.DefaultTestChainID,
+			invalid: true,
+		},
+		"trailing data": {
+			export:  func() []byte { return append(bytes.Clone(export), 0) },
+			chainID: test.DefaultTestChainID,
+			invalid: true,
+		},
+		"other chain": {
+			export:  func() []byte { return export },
+			chainID: "other-chain",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			importedBS := NewBlockStore(dbm.NewMemDB())
+			importedStateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+			importGenDoc := *genDoc
+			importGenDoc.ChainID = tc.chainID
+			_, err := Import(bytes.NewReader(tc.export()), importedBS, importedStateStore, &importGenDoc, nil)
+			require.Error(t, err)
+			if tc.invalid {
+				require.ErrorIs(t, err, ErrInvalidExport)
+			}
+		})
+	}
+}
+
+func TestImportUnverified(t *testing.T) {
+	genDoc, privVals := makeExportTestGenesis(3)
+	bs, stateStore := makeExportTestStores(t, genDoc, privVals, 5, 3)
+	block3, _ := bs.LoadBlock(3)
+
+	// The blocks of another chain with the same validators, which does not
+	// follow the blocks 1 and 2 of bs.
+	forkBS, forkStateStore := makeExportTestStores(t, genDoc, privVals, 5, 3)
+	// The blocks of a chain committed by validators other than the genesis
+	// validators.
+	otherGenDoc, otherPrivVals := makeExportTestGenesis(3)
+	otherBS, otherStateStore := makeExportTestStores(t, otherGenDoc, otherPrivVals, 2, 3)
+
+	testCases := map[string]struct {
+		export     []byte
+		trustHash  []byte
+		baseHeight int64 // the heights of bs imported first
+		height     int64 // the height of the block store after the import
+	}{
+		"untrusted first height": {
+			export: exportTestStores(t, bs, stateStore, 3, 5),
+		},
+		"wrong trusted hash": {
+			export:    exportTestStores(t, bs, stateStore, 3, 5),
+			trustHash: forkBS.LoadBlockMeta(3).BlockID.Hash,
+		},
+		"fork": {
+			export:     exportTestStores(t, forkBS, forkStateStore, 3, 5),
+			baseHeight: 2,
+			height:     2,
+		},
+		"fork with trusted hash": {
+			export:     exportTestStores(t, forkBS, forkStateStore, 3, 5),
+			trustHash:  block3.Hash(),
+			baseHeight: 2,
+			height:     2,
+		},
+		"other validators": {
+			export: exportTestStores(t, otherBS, otherStateStore, 1, 2),
+		},
+		"forged signatures": {
+			export: func() []byte {
+				// Rewrite the export with the signatures of the seen commit of
+				// height 2 altered.
+				r := bufio.NewReader(bytes.NewReader(exportTestStores(t, bs, stateStore, 1, 5)))
+				var buf bytes.Buffer
+				for {
+					entry, err := readExportEntry(r)
+					require.NoError(t, err)
+					if exported := entry.GetHeight(); exported != nil && exported.Block.Header.Height == 2 {
+						for _, sig := range exported.SeenCommit.Signatures {
+							sig.Signature[0]++
+						}
+					}
+					require.NoError(t, writeExportEntry(&buf, entry))
+					if entry.GetFooter() != nil {
+						return buf.Bytes()
+					}
+				}
+			}(),
+			height: 1,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			importedBS := NewBlockStore(dbm.NewMemDB())
+			importedStateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+			if tc.baseHeight > 0 {
+				_, err := Import(bytes.NewReader(exportTestStores(t, bs, stateStore, 1, tc.baseHeight)),
+					importedBS, importedStateStore, genDoc, nil)
+				require.NoError(t, err)
+			}
+			_, err := Import(bytes.NewReader(tc.export), importedBS, importedStateStore, genDoc, tc.trustHash)
+			require.ErrorIs(t, err, ErrInvalidExport)
+			assert.Equal(t, tc.height, importedBS.Height())
+		})
+	}
+}
@@ -3,12 +3,16 @@ package e2e_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	cmtrand "github.com/cometbft/cometbft/v2/internal/rand"
+	grpcclient "github.com/cometbft/cometbft/v2/rpc/grpc/client"
 	e2e "github.com/cometbft/cometbft/v2/test/e2e/pkg"
+	"github.com/cometbft/cometbft/v2/types"
 	"github.com/cometbft/cometbft/v2/version"
 )
 
@@ -65,6 +69,54 @@ func TestGRPC_Block_GetByHeight(t *testing.T) {
 	})
 }
 
+// Test the GRPC Block Service. Invoke the GetLightBlock and GetLightBlocks
+// methods to return the light blocks up to the latest height returned by the
+// Block Service's GetLatestHeight method.
+func TestGRPC_Block_GetLightBlock(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		t.Helper()
+
+		// Get the latest height
+		latestHeight, err := getLatestHeight(node)
+		require.NoError(t, err)
+
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+
+		gRPCClient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gRPCClient.Close()
+
+		lightBlock, err := gRPCClient.GetLightBlock(ctx, latestHeight)
+		require.NoError(t, err)
+		require.Equal(t, latestHeight, lightBlock.Height)
+		require.NoError(t, lightBlock.ValidateBasic(node.Testnet.Name))
+
+		// Stream the light blocks preceding the latest one
+		fromHeight := max(latestHeight-5, node.Testnet.InitialHeight)
+		resultCh, err := gRPCClient.GetLightBlocks(ctx, fromHeight, latestHeight)
+		require.NoError(t, err)
+		height := fromHeight
+		for res := range resultCh {
+			require.NoError(t, res.Error)
+			require.Equal(t, height, res.LightBlock.Height)
+			height++
+		}
+		require.Equal(t, latestHeight+1, height)
+
+		// The stream ends at the latest height if later heights are requested
+		resultCh, err = gRPCClient.GetLightBlocks(ctx, latestHeight, latestHeight+1000)
+		require.NoError(t, err)
+		height = latestHeight
+		for res := range resultCh {
+			require.NoError(t, res.Error)
+			require.Equal(t, height, res.LightBlock.Height)
+			height++
+		}
+		require.Greater(t, height, latestHeight)
+	})
+}
+
 // Test the GRPC Block Results service. Invoke the GetBlockResults method to retrieve the block results
 // at the latest height returned by the Block Service's GetLatestHeight method.
 func TestGRPC_GetBlockResults(t *testing.T) {
@@ -94,6 +146,90 @@ func TestGRPC_GetBlockResults(t *testing.T) {
 	})
 }
 
+// Test the GRPC Mempool service. Broadcast a transaction in the commit mode
+// while subscribed to its result, and check that both report the same result.
+func TestGRPC_Mempool_BroadcastTx(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		t.Helper()
+
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+
+		gRPCClient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gRPCClient.Close()
+
+		// Generate a random value, to prevent duplicate tx errors when
+		// manually running the test multiple times for a testnet.
+		tx := types.Tx(fmt.Sprintf("grpc-tx-%v=%v", node.Name, cmtrand.Str(32)))
+
+		checkTxRes, err := gRPCClient.CheckTx(ctx, tx)
+		require.NoError(t, err)
+		require.Zero(t, checkTxRes.Code)
+
+		resultCh, err := gRPCClient.SubscribeTxResult(ctx, tx.Hash(), grpcclient.SubscribeTxResultChannelSize(1))
+		require.NoError(t, err)
+
+		res, err := gRPCClient.BroadcastTxCommit(ctx, tx)
+		require.NoError(t, err)
+		require.Equal(t, tx.Hash(), res.Hash)
+		require.Zero(t, res.CheckTx.Code)
+		require.NotNil(t, res.TxResult)
+		require.Zero(t, res.TxResult.Code)
+		require.Positive(t, res.Height)
+
+		event, ok := <-resultCh
+		require.True(t, ok)
+		require.NoError(t, event.Error)
+		require.Equal(t, tx.Hash(), event.Hash)
+		require.Equal(t, res.Height, event.TxResult.Height)
+		require.Equal(t, []byte(tx), event.TxResult.Tx)
+
+		// The stream is closed once the result of the transaction is sent.
+		_, ok = <-resultCh
+		require.False(t, ok)
+
+		unconfirmed, err := gRPCClient.UnconfirmedTxs(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, unconfirmed.Txs, unconfirmed.Count)
+		require.NotContains(t, unconfirmed.Txs, tx)
+	})
+}
+
+// Test the GRPC Event service. Subscribe to the new block headers from a past
+// height, and check that they are replayed in order before live ones are
+// streamed.
+func TestGRPC_Event_Subscribe(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		t.Helper()
+
+		latestHeight, err := getLatestHeight(node)
+		require.NoError(t, err)
+		fromHeight := max(latestHeight-2, node.Testnet.InitialHeight)
+
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+
+		gRPCClient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gRPCClient.Close()
+
+		events, err := gRPCClient.Subscribe(ctx, "tm.event = 'NewBlockHeader'", grpcclient.SubscribeFromHeight(fromHeight))
+		require.NoError(t, err)
+
+		replayed := false
+		for height := fromHeight; height <= latestHeight+1; height++ {
+			event, ok := <-events
+			require.True(t, ok)
+			require.NoError(t, event.Error)
+			require.Equal(t, height, event.Height)
+			require.Equal(t, height, event.Data.(types.EventDataNewBlockHeader).Header.Height)
+			replayed = replayed || event.Replayed
+		}
+		require.True(t, replayed)
+	})
+}
+
 // Test the GRPC Privileged Pruning Service methods to set and get the block retain height.
 func TestGRPC_BlockRetainHeight(t *testing.T) {
 	t.Helper()
@@ -13,6 +13,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/v2/crypto"
+	"github.com/cometbft/cometbft/v2/crypto/bls12381"
 	"github.com/cometbft/cometbft/v2/crypto/merkle"
 	"github.com/cometbft/cometbft/v2/crypto/tmhash"
 	"github.com/cometbft/cometbft/v2/internal/bits"
@@ -656,8 +657,21 @@ func (cs CommitSig) BlockID(commitBlockID BlockID) BlockID {
 	return blockID
 }
 
+// IsAggregated returns true if the signature of the precommit is part of the
+// aggregated signature of the commit, i.e. if cs is for the block but has no
+// signature. This is only valid in a commit with an aggregated signature.
+func (cs CommitSig) IsAggregated() bool {
+	return cs.BlockIDFlag == BlockIDFlagCommit && len(cs.Signature) == 0
+}
+
 // ValidateBasic performs basic validation.
 func (cs CommitSig) ValidateBasic() error {
+	return cs.validateBasic(false)
+}
+
+// validateBasic performs basic validation. If aggregated is true, cs may be
+// part of the aggregated signature of its commit.
+func (cs CommitSig) validateBasic(aggregated bool) error {
 	switch cs.BlockIDFlag {
 	case BlockIDFlagAbsent:
 	case BlockIDFlagCommit:
@@ -685,7 +699,7 @@ func (cs CommitSig) ValidateBasic() error {
 			)
 		}
 		// NOTE: Timestamp validation is subtle and handled elsewhere.
-		if len(cs.Signature) == 0 {
+		if len(cs.Signature) == 0 && !(aggregated && cs.IsAggregated()) {
 			return errors.New("signature is missing")
 		}
 		if len(cs.Signature) > MaxSignatureSize {
@@ -713,12 +727,15 @@ func (cs *CommitSig) ToProto() *cmtproto.CommitSig {
 // FromProto sets a protobuf CommitSig to the given pointer.
 // It returns an error if the CommitSig is invalid.
 func (cs *CommitSig) FromProto(csp cmtproto.CommitSig) error {
+	cs.fromProto(csp)
+	return cs.ValidateBasic()
+}
+
+func (cs *CommitSig) fromProto(csp cmtproto.CommitSig) {
 	cs.BlockIDFlag = BlockIDFlag(csp.BlockIdFlag)
 	cs.ValidatorAddress = csp.ValidatorAddress
 	cs.Timestamp = csp.Timestamp
 	cs.Signature = csp.Signature
-
-	return cs.ValidateBasic()
 }
 
 // -------------------------------------
@@ -874,6 +891,9 @@ type Commit struct {
 	Round      int32       `json:"round"`
 	BlockID    BlockID     `json:"block_id"`
 	Signatures []CommitSig `json:"signatures"`
+	// BLS12-381 signature aggregating the signatures of the precommits for the
+	// block without a signature in Signatures (see AggregateSignatures).
+	AggregatedSignature []byte `json:"aggregated_signature,omitempty"`
 
 	// Memoized in first call to corresponding method.
 	// NOTE: can't memoize in constructor because constructor isn't used for
@@ -892,7 +912,8 @@ func (commit *Commit) Clone() *Commit {
 
 // GetVote converts the CommitSig for the given valIdx to a Vote. Commits do
 // not contain vote extensions, so the vote extension and vote extension
-// signature will not be present in the returned vote.
+// signature will not be present in the returned vote. Neither will the
+// signature of a precommit that is part of the aggregated signature.
 // Returns nil if the precommit at valIdx is nil.
 // Panics if valIdx >= commit.Size().
 func (commit *Commit) GetVote(valIdx int32) *Vote {
@@ -949,15 +970,77 @@ func (commit *Commit) ValidateBasic() error {
 		if len(commit.Signatures) == 0 {
 			return errors.New("no signatures in commit")
 		}
+		aggregated := commit.HasAggregatedSignature()
+		numAggregated := 0
 		for i, commitSig := range commit.Signatures {
-			if err := commitSig.ValidateBasic(); err != nil {
+			if err := commitSig.validateBasic(aggregated); err != nil {
 				return fmt.Errorf("wrong CommitSig #%d: %w", i, err)
 			}
+			if commitSig.IsAggregated() {
+				numAggregated++
+			}
+		}
+		if aggregated {
+			if len(commit.AggregatedSignature) != bls12381.SignatureLength {
+				return fmt.Errorf("expected AggregatedSignature size to be %d bytes, got %d bytes",
+					bls12381.SignatureLength,
+					len(commit.AggregatedSignature),
+				)
+			}
+			if numAggregated == 0 {
+				return errors.New("aggregated signature without aggregated precommits")
+			}
 		}
+	} else if commit.HasAggregatedSignature() {
+		return errors.New("aggregated signature is present")
 	}
 	return nil
 }
 
+// HasAggregatedSignature returns true if the signatures of (some of) the
+// precommits for the block are aggregated into a single signature.
+func (commit *Commit) HasAggregatedSignature() bool {
+	return len(commit.AggregatedSignature) != 0
+}
+
+// AggregateSignatures returns a copy of the commit in which the signatures of
+// the precommits for the block are aggregated into a single BLS12-381
+// signature, which makes the commit much smaller. All the validators that
+// signed the block must have BLS12-381 keys.
+//
+// The aggregated signature can only be verified if the precommits sign
+// distinct bytes, i.e. have distinct timestamps. Precommits with the same
+// timestamp as an aggregated one keep their signature.
+func (commit *Commit) AggregateSignatures() (*Commit, error) {
+	if commit.HasAggregatedSignature() {
+		return nil, errors.New("commit signatures are already aggregated")
+	}
+
+	aggregated := commit.Clone()
+	aggregated.hash = nil
+	sigs := make([][]byte, 0, len(commit.Signatures))
+	timestamps := make(map[int64]struct{}, len(commit.Signatures))
+	for i, commitSig := range commit.Signatures {
+		if commitSig.BlockIDFlag != BlockIDFlagCommit {
+			continue
+		}
+		ts := commitSig.Timestamp.UnixNano()
+		if _, ok := timestamps[ts]; ok {
+			continue
+		}
+		timestamps[ts] = struct{}{}
+		sigs = append(sigs, commitSig.Signature)
+		aggregated.Signatures[i].Signature = nil
+	}
+
+	aggSig, err := bls12381.AggregateSignatures(sigs)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating commit signatures: %w", err)
+	}
+	aggregated.AggregatedSignature = aggSig
+	return aggregated, nil
+}
+
 // MedianTime computes the median time for a Commit based on the associated validator set.
 // The median time is the weighted median of the Timestamp fields of the commit votes,
 // with heights defined by the validator's voting powers.
@@ -1000,6 +1083,9 @@ func (commit *Commit) Hash() cmtbytes.HexBytes {
 
 			bs[i] = bz
 		}
+		if commit.HasAggregatedSignature() {
+			bs = append(bs, commit.AggregatedSignature)
+		}
 		commit.hash = merkle.HashFromByteSlices(bs)
 	}
 	return commit.hash
@@ -1065,6 +1151,7 @@ func (commit *Commit) ToProto() *cmtproto.Commit {
 	c.Height = commit.Height
 	c.Round = commit.Round
 	c.BlockID = commit.BlockID.ToProto()
+	c.AggregatedSignature = commit.AggregatedSignature
 
 	return c
 }
@@ -1083,9 +1170,11 @@ func CommitFromProto(cp *cmtproto.Commit) (*Commit, error) {
 		return nil, err
 	}
 
+	aggregated := len(cp.AggregatedSignature) != 0
 	sigs := make([]CommitSig, len(cp.Signatures))
 	for i := range cp.Signatures {
-		if err := sigs[i].FromProto(cp.Signatures[i]); err != nil {
+		sigs[i].fromProto(cp.Signatures[i])
+		if err := sigs[i].validateBasic(aggregated); err != nil {
 			return nil, err
 		}
 	}
@@ -1094,6 +1183,7 @@ func CommitFromProto(cp *cmtproto.Commit) (*Commit, error) {
 	commit.Height = cp.Height
 	commit.Round = cp.Round
 	commit.BlockID = *bi
+	commit.AggregatedSignature = cp.AggregatedSignature
 
 	return commit, commit.ValidateBasic()
 }
@@ -1150,12 +1240,19 @@ func (ec *ExtendedCommit) addSigsToVoteSet(voteSet *VoteSet) {
 // ToVoteSet constructs a VoteSet from the Commit and validator set.
 // Panics if signatures from the commit can't be added to the voteset.
 // Inverse of VoteSet.MakeCommit().
+//
+// The precommits whose signature is aggregated cannot be turned back into
+// votes, and are thus missing from the vote set.
 func (commit *Commit) ToVoteSet(chainID string, vals *ValidatorSet) *VoteSet {
 	voteSet := NewVoteSet(chainID, commit.Height, commit.Round, PrecommitType, vals)
+	aggregated := commit.HasAggregatedSignature()
 	for idx, cs := range commit.Signatures {
 		if cs.BlockIDFlag == BlockIDFlagAbsent {
 			continue // OK, some precommits can be missing.
 		}
+		if aggregated && cs.IsAggregated() {
+			continue
+		}
 		vote := commit.GetVote(int32(idx))
 		if err := vote.ValidateBasic(); err != nil {
 			panic(fmt.Errorf("failed to validate vote reconstructed from commit: %w", err))
@@ -15,6 +15,7 @@ import (
 
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/v2/crypto"
+	"github.com/cometbft/cometbft/v2/crypto/bls12381"
 	"github.com/cometbft/cometbft/v2/crypto/merkle"
 	"github.com/cometbft/cometbft/v2/crypto/tmhash"
 	"github.com/cometbft/cometbft/v2/internal/bits"
@@ -255,6 +256,23 @@ func TestCommitValidateBasic(t *testing.T) {
 		{"Incorrect signature", func(com *Commit) { com.Signatures[0].Signature = []byte{0} }, false},
 		{"Incorrect height", func(com *Commit) { com.Height = int64(-100) }, true},
 		{"Incorrect round", func(com *Commit) { com.Round = -100 }, true},
+		{"Missing signature", func(com *Commit) { com.Signatures[0].Signature = nil }, true},
+		{"Aggregated signature", func(com *Commit) {
+			com.Signatures[0].Signature = nil
+			com.AggregatedSignature = crypto.CRandBytes(bls12381.SignatureLength)
+		}, false},
+		{"Aggregated signature with incorrect size", func(com *Commit) {
+			com.Signatures[0].Signature = nil
+			com.AggregatedSignature = crypto.CRandBytes(bls12381.SignatureLength - 1)
+		}, true},
+		{"Aggregated signature without aggregated precommit", func(com *Commit) {
+			com.AggregatedSignature = crypto.CRandBytes(bls12381.SignatureLength)
+		}, true},
+		{"Aggregated signature of nil precommit", func(com *Commit) {
+			com.Signatures[0].BlockIDFlag = BlockIDFlagNil
+			com.Signatures[0].Signature = nil
+			com.AggregatedSignature = crypto.CRandBytes(bls12381.SignatureLength)
+		}, true},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.testName, func(t *testing.T) {
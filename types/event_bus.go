@@ -110,7 +110,7 @@ func (b *EventBus) Publish(eventType string, eventData TMEventData) error {
 // map of stringified events where each key is composed of the event
 // type and each of the event's attributes keys in the form of
 // "{event.Type}.{attribute.Key}" and the value is each attribute's value.
-func (*EventBus) validateAndStringifyEvents(events []types.Event) map[string][]string {
+func validateAndStringifyEvents(events []types.Event) map[string][]string {
 	result := make(map[string][]string)
 	for _, event := range events {
 		if len(event.Type) == 0 {
@@ -130,27 +130,46 @@ func (*EventBus) validateAndStringifyEvents(events []types.Event) map[string][]s
 	return result
 }
 
+// EventKeys returns the composite keys, along with their values, with which
+// an event of the given type and data is published by the EventBus. These are
+// the keys matched by the queries of the subscriptions.
+func EventKeys(eventType string, data TMEventData) map[string][]string {
+	var events map[string][]string
+	switch data := data.(type) {
+	case EventDataNewBlock:
+		events = validateAndStringifyEvents(data.ResultFinalizeBlock.Events)
+	case EventDataNewBlockEvents:
+		events = validateAndStringifyEvents(data.Events)
+	case EventDataTx:
+		events = validateAndStringifyEvents(data.Result.Events)
+	default:
+		events = make(map[string][]string)
+	}
+
+	// add predefined compositeKeys
+	events[EventTypeKey] = append(events[EventTypeKey], eventType)
+	switch data := data.(type) {
+	case EventDataTx:
+		events[TxHashKey] = append(events[TxHashKey], fmt.Sprintf("%X", Tx(data.Tx).Hash()))
+		events[TxHeightKey] = append(events[TxHeightKey], strconv.FormatInt(data.Height, 10))
+	case EventDataPendingTx:
+		events[TxHashKey] = append(events[TxHashKey], fmt.Sprintf("%X", Tx(data.Tx).Hash()))
+	case EventDataMempoolTx:
+		events[TxHashKey] = append(events[TxHashKey], data.Hash.String())
+	}
+	return events
+}
+
 func (b *EventBus) PublishEventNewBlock(data EventDataNewBlock) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
-	events := b.validateAndStringifyEvents(data.ResultFinalizeBlock.Events)
-
-	// add predefined new block event
-	events[EventTypeKey] = append(events[EventTypeKey], EventNewBlock)
-
-	return b.pubsub.PublishWithEvents(ctx, data, events)
+	return b.pubsub.PublishWithEvents(ctx, data, EventKeys(EventNewBlock, data))
 }
 
 func (b *EventBus) PublishEventNewBlockEvents(data EventDataNewBlockEvents) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
-
-	events := b.validateAndStringifyEvents(data.Events)
-
-	// add predefined new block event
-	events[EventTypeKey] = append(events[EventTypeKey], EventNewBlockEvents)
-
-	return b.pubsub.PublishWithEvents(ctx, data, events)
+	return b.pubsub.PublishWithEvents(ctx, data, EventKeys(EventNewBlockEvents, data))
 }
 
 func (b *EventBus) PublishEventNewBlockHeader(data EventDataNewBlockHeader) error {
@@ -172,27 +191,24 @@ func (b *EventBus) PublishEventValidBlock(data EventDataRoundState) error {
 func (b *EventBus) PublishEventPendingTx(data EventDataPendingTx) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
-	return b.pubsub.PublishWithEvents(ctx, data, map[string][]string{
-		EventTypeKey: {EventPendingTx},
-		TxHashKey:    {fmt.Sprintf("%X", Tx(data.Tx).Hash())},
-	})
+	return b.pubsub.PublishWithEvents(ctx, data, EventKeys(EventPendingTx, data))
+}
+
+// PublishEventMempoolTx publishes an event of the lifecycle of a transaction
+// in the mempool, along with the hash of the transaction (TxHashKey).
+func (b *EventBus) PublishEventMempoolTx(data EventDataMempoolTx) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+	return b.pubsub.PublishWithEvents(ctx, data, EventKeys(EventMempoolTx, data))
 }
 
 // PublishEventTx publishes tx event with events from Result. Note it will add
-// predefined keys (EventTypeKey, TxHashKey). Existing events with the same keys
-// will be overwritten.
+// predefined keys (EventTypeKey, TxHashKey, TxHeightKey). Existing events with
+// the same keys will be overwritten.
 func (b *EventBus) PublishEventTx(data EventDataTx) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
-
-	events := b.validateAndStringifyEvents(data.Result.Events)
-
-	// add predefined compositeKeys
-	events[EventTypeKey] = append(events[EventTypeKey], EventTx)
-	events[TxHashKey] = append(events[TxHashKey], fmt.Sprintf("%X", Tx(data.Tx).Hash()))
-	events[TxHeightKey] = append(events[TxHeightKey], strconv.FormatInt(data.Height, 10))
-
-	return b.pubsub.PublishWithEvents(ctx, data, events)
+	return b.pubsub.PublishWithEvents(ctx, data, EventKeys(EventTx, data))
 }
 
 func (b *EventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
@@ -275,6 +291,10 @@ func (NopEventBus) PublishEventPendingTx(EventDataPendingTx) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventMempoolTx(EventDataMempoolTx) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventTx(EventDataTx) error {
 	return nil
 }
@@ -52,6 +52,45 @@ func TestEventBusPublishEventPendingTx(t *testing.T) {
 	}
 }
 
+func TestEventBusPublishEventMempoolTx(t *testing.T) {
+	eventBus := NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	tx := Tx("foo")
+	// PublishEventMempoolTx adds 1 composite key, so the query below should work
+	query := fmt.Sprintf("tm.event='MempoolTx' AND tx.hash='%X'", tx.Hash())
+	txsSub, err := eventBus.Subscribe(context.Background(), "test", cmtquery.MustCompile(query))
+	require.NoError(t, err)
+
+	event := MempoolTxEvent{Type: MempoolTxAdded, Height: 1, Lane: "default"}
+	done := make(chan struct{})
+	go func() {
+		msg := <-txsSub.Out()
+		edt := msg.Data().(EventDataMempoolTx)
+		assert.EqualValues(t, tx.Hash(), edt.Hash)
+		assert.Equal(t, event, edt.Event)
+		close(done)
+	}()
+
+	err = eventBus.PublishEventMempoolTx(EventDataMempoolTx{
+		Hash:  tx.Hash(),
+		Event: event,
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(1 * time.Second):
+		t.Fatal("did not receive a mempool transaction event after 1 sec.")
+	}
+}
+
 func TestEventBusPublishEventTx(t *testing.T) {
 	eventBus := NewEventBus()
 	err := eventBus.Start()
@@ -378,6 +417,39 @@ func TestEventBusPublishEventNewEvidence(t *testing.T) {
 	}
 }
 
+func TestEventKeys(t *testing.T) {
+	tx := Tx("foo")
+	events := []abci.Event{
+		{Type: "transfer", Attributes: []abci.EventAttribute{
+			{Key: "sender", Value: "foo"},
+			{Key: "sender", Value: "bar"},
+			{Key: "", Value: "ignored"},
+		}},
+		{Type: "", Attributes: []abci.EventAttribute{{Key: "ignored", Value: "baz"}}},
+	}
+
+	keys := EventKeys(EventTx, EventDataTx{TxResult: abci.TxResult{
+		Height: 3,
+		Tx:     tx,
+		Result: abci.ExecTxResult{Events: events},
+	}})
+	assert.Equal(t, map[string][]string{
+		EventTypeKey:      {EventTx},
+		TxHashKey:         {fmt.Sprintf("%X", tx.Hash())},
+		TxHeightKey:       {"3"},
+		"transfer.sender": {"foo", "bar"},
+	}, keys)
+
+	keys = EventKeys(EventNewBlockEvents, EventDataNewBlockEvents{Height: 3, Events: events})
+	assert.Equal(t, map[string][]string{
+		EventTypeKey:      {EventNewBlockEvents},
+		"transfer.sender": {"foo", "bar"},
+	}, keys)
+
+	keys = EventKeys(EventNewRound, EventDataNewRound{Height: 3})
+	assert.Equal(t, map[string][]string{EventTypeKey: {EventNewRound}}, keys)
+}
+
 func TestEventBusPublish(t *testing.T) {
 	eventBus := NewEventBus()
 	err := eventBus.Start()
@@ -2,8 +2,10 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	abci "github.com/cometbft/cometbft/v2/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/v2/libs/bytes"
 	cmtjson "github.com/cometbft/cometbft/v2/libs/json"
 	cmtpubsub "github.com/cometbft/cometbft/v2/libs/pubsub"
 	cmtquery "github.com/cometbft/cometbft/v2/libs/pubsub/query"
@@ -16,6 +18,7 @@ const (
 	// after a block has been committed.
 	// These are also used by the tx indexer for async indexing.
 	// All of this data can be fetched through the rpc.
+	EventMempoolTx           = "MempoolTx"
 	EventNewBlock            = "NewBlock"
 	EventNewBlockHeader      = "NewBlockHeader"
 	EventNewBlockEvents      = "NewBlockEvents"
@@ -53,6 +56,7 @@ func init() {
 	cmtjson.RegisterType(EventDataNewBlockEvents{}, "tendermint/event/NewBlockEvents")
 	cmtjson.RegisterType(EventDataNewEvidence{}, "tendermint/event/NewEvidence")
 	cmtjson.RegisterType(EventDataTx{}, "tendermint/event/Tx")
+	cmtjson.RegisterType(EventDataMempoolTx{}, "tendermint/event/MempoolTx")
 	cmtjson.RegisterType(EventDataRoundState{}, "tendermint/event/RoundState")
 	cmtjson.RegisterType(EventDataNewRound{}, "tendermint/event/NewRound")
 	cmtjson.RegisterType(EventDataCompleteProposal{}, "tendermint/event/CompleteProposal")
@@ -95,6 +99,47 @@ type EventDataTx struct {
 	abci.TxResult
 }
 
+// Types of the events of the lifecycle of a transaction in the mempool.
+const (
+	// The transaction was received from a peer or an RPC client.
+	MempoolTxReceived = "received"
+	// CheckTx returned a response for the transaction.
+	MempoolTxChecked = "checked"
+	// The transaction was not added to the mempool.
+	MempoolTxRejected = "rejected"
+	// The transaction was added to a lane of the mempool.
+	MempoolTxAdded = "added"
+	// The transaction was checked again after a block was committed.
+	MempoolTxRechecked = "rechecked"
+	// The transaction left the mempool.
+	MempoolTxRemoved = "removed"
+	// The transaction was included in a block.
+	MempoolTxIncluded = "included"
+)
+
+// MempoolTxEvent is an event of the lifecycle of a transaction in the mempool.
+type MempoolTxEvent struct {
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+	// Height of the last block committed when the event happened, or height of
+	// the block including the transaction.
+	Height int64 `json:"height"`
+	// ID of the peer that sent the transaction, if any.
+	Sender string `json:"sender,omitempty"`
+	Lane   string `json:"lane,omitempty"`
+	// Code returned by CheckTx, or by FinalizeBlock for included transactions.
+	Code uint32 `json:"code,omitempty"`
+	// Why the transaction was rejected or removed.
+	Reason string `json:"reason,omitempty"`
+}
+
+// EventDataMempoolTx is fired for each event of the lifecycle of a transaction
+// in the mempool, if enabled.
+type EventDataMempoolTx struct {
+	Hash  cmtbytes.HexBytes `json:"hash"`
+	Event MempoolTxEvent    `json:"event"`
+}
+
 // NOTE: This goes into the replay WAL.
 type EventDataRoundState struct {
 	Height int64  `json:"height"`
@@ -154,6 +199,7 @@ const (
 var (
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposal)
 	EventQueryLock                = QueryForEvent(EventLock)
+	EventQueryMempoolTx           = QueryForEvent(EventMempoolTx)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
 	EventQueryNewBlockEvents      = QueryForEvent(EventNewBlockEvents)
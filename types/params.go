@@ -98,8 +98,9 @@ type VersionParams struct {
 // A value of 0 means the feature is disabled. A value > 0 denotes
 // the height at which the feature will be (or has been) enabled.
 type FeatureParams struct {
-	VoteExtensionsEnableHeight int64 `json:"vote_extensions_enable_height"`
-	PbtsEnableHeight           int64 `json:"pbts_enable_height"`
+	VoteExtensionsEnableHeight       int64 `json:"vote_extensions_enable_height"`
+	PbtsEnableHeight                 int64 `json:"pbts_enable_height"`
+	BlsAggregatedCommitsEnableHeight int64 `json:"bls_aggregated_commits_enable_height"`
 }
 
 // VoteExtensionsEnabled returns true if vote extensions are enabled at height h
@@ -117,6 +118,14 @@ func (p FeatureParams) PbtsEnabled(h int64) bool {
 	return featureEnabled(enabledHeight, h, "PBTS")
 }
 
+// BlsAggregatedCommitsEnabled returns true if the last commit of the block at
+// height h may have its signatures aggregated, and false otherwise.
+func (p FeatureParams) BlsAggregatedCommitsEnabled(h int64) bool {
+	enabledHeight := p.BlsAggregatedCommitsEnableHeight
+
+	return featureEnabled(enabledHeight, h, "BLS Aggregated Commits")
+}
+
 // featureEnabled returns true if `enabledHeight` points to a height that is smaller than `currentHeight“.
 func featureEnabled(enableHeight int64, currentHeight int64, f string) bool {
 	if currentHeight < 1 {
@@ -217,8 +226,9 @@ func DefaultVersionParams() VersionParams {
 // Disabled by default.
 func DefaultFeatureParams() FeatureParams {
 	return FeatureParams{
-		VoteExtensionsEnableHeight: 0,
-		PbtsEnableHeight:           0,
+		VoteExtensionsEnableHeight:       0,
+		PbtsEnableHeight:                 0,
+		BlsAggregatedCommitsEnableHeight: 0,
 	}
 }
 
@@ -296,6 +306,10 @@ func (params ConsensusParams) ValidateBasic() error {
 		return fmt.Errorf("Feature.PbtsEnableHeight cannot be negative. Got: %d", params.Feature.PbtsEnableHeight)
 	}
 
+	if params.Feature.BlsAggregatedCommitsEnableHeight < 0 {
+		return fmt.Errorf("Feature.BlsAggregatedCommitsEnableHeight cannot be negative. Got: %d", params.Feature.BlsAggregatedCommitsEnableHeight)
+	}
+
 	// Synchrony params are only relevant when PBTS is enabled
 	if params.Feature.PbtsEnableHeight > 0 {
 		if params.Synchrony.MessageDelay <= 0 {
@@ -371,6 +385,13 @@ func validateUpdateFeatures(params FeatureParams, updated cmtproto.FeatureParams
 			return err
 		}
 	}
+
+	if updated.BlsAggregatedCommitsEnableHeight != nil {
+		err := validateUpdateFeatureEnableHeight(params.BlsAggregatedCommitsEnableHeight, updated.BlsAggregatedCommitsEnableHeight.Value, h, "BLS Aggregated Commits")
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -477,6 +498,10 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 		if params2.Feature.PbtsEnableHeight != nil {
 			res.Feature.PbtsEnableHeight = params2.Feature.GetPbtsEnableHeight().Value
 		}
+
+		if params2.Feature.BlsAggregatedCommitsEnableHeight != nil {
+			res.Feature.BlsAggregatedCommitsEnableHeight = params2.Feature.GetBlsAggregatedCommitsEnableHeight().Value
+		}
 	}
 	if params2.Synchrony != nil {
 		if params2.Synchrony.MessageDelay != nil {
@@ -508,8 +533,9 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 			App: params.Version.App,
 		},
 		Feature: &cmtproto.FeatureParams{
-			PbtsEnableHeight:           &gogo.Int64Value{Value: params.Feature.PbtsEnableHeight},
-			VoteExtensionsEnableHeight: &gogo.Int64Value{Value: params.Feature.VoteExtensionsEnableHeight},
+			PbtsEnableHeight:                 &gogo.Int64Value{Value: params.Feature.PbtsEnableHeight},
+			VoteExtensionsEnableHeight:       &gogo.Int64Value{Value: params.Feature.VoteExtensionsEnableHeight},
+			BlsAggregatedCommitsEnableHeight: &gogo.Int64Value{Value: params.Feature.BlsAggregatedCommitsEnableHeight},
 		},
 		Synchrony: &cmtproto.SynchronyParams{
 			MessageDelay: &params.Synchrony.MessageDelay,
@@ -536,8 +562,9 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 			App: pbParams.Version.App,
 		},
 		Feature: FeatureParams{
-			VoteExtensionsEnableHeight: pbParams.GetFeature().GetVoteExtensionsEnableHeight().GetValue(),
-			PbtsEnableHeight:           pbParams.GetFeature().GetPbtsEnableHeight().GetValue(),
+			VoteExtensionsEnableHeight:       pbParams.GetFeature().GetVoteExtensionsEnableHeight().GetValue(),
+			PbtsEnableHeight:                 pbParams.GetFeature().GetPbtsEnableHeight().GetValue(),
+			BlsAggregatedCommitsEnableHeight: pbParams.GetFeature().GetBlsAggregatedCommitsEnableHeight().GetValue(),
 		},
 	}
 	if pbParams.GetSynchrony().GetMessageDelay() != nil {
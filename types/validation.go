@@ -7,6 +7,8 @@ import (
 
 	"github.com/cometbft/cometbft/v2/crypto"
 	"github.com/cometbft/cometbft/v2/crypto/batch"
+	"github.com/cometbft/cometbft/v2/crypto/bls12381"
+	"github.com/cometbft/cometbft/v2/crypto/merkle"
 	"github.com/cometbft/cometbft/v2/crypto/tmhash"
 	cmtmath "github.com/cometbft/cometbft/v2/libs/math"
 	cmterrors "github.com/cometbft/cometbft/v2/types/errors"
@@ -45,6 +47,11 @@ func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 	// only count the signatures that are for the block
 	count := func(c CommitSig) bool { return c.BlockIDFlag == BlockIDFlagCommit }
 
+	if commit.HasAggregatedSignature() {
+		return verifyCommitAggregated(chainID, vals, commit, votingPowerNeeded,
+			ignore, count, true, true, nil)
+	}
+
 	// attempt to batch verify
 	if shouldBatchVerify(vals, commit) {
 		return verifyCommitBatch(chainID, vals, commit,
@@ -126,6 +133,11 @@ func verifyCommitLightInternal(
 	// count all the remaining signatures
 	count := func(_ CommitSig) bool { return true }
 
+	if commit.HasAggregatedSignature() {
+		return verifyCommitAggregated(chainID, vals, commit, votingPowerNeeded,
+			ignore, count, countAllSignatures, true, verifiedSignatureCache)
+	}
+
 	// attempt to batch verify
 	if shouldBatchVerify(vals, commit) {
 		return verifyCommitBatch(chainID, vals, commit,
@@ -229,6 +241,14 @@ func verifyCommitLightTrustingInternal(
 	// count all the remaining signatures
 	count := func(_ CommitSig) bool { return true }
 
+	// The aggregated signature can only be verified with the keys of all the
+	// signers. Unless it was already verified with the validator set of the
+	// commit, and is in the cache, all the signers must thus be in the set.
+	if commit.HasAggregatedSignature() {
+		return verifyCommitAggregated(chainID, vals, commit, votingPowerNeeded,
+			ignore, count, countAllSignatures, false, verifiedSignatureCache)
+	}
+
 	// attempt to batch verify commit. As the validator set doesn't necessarily
 	// correspond with the validator set that signed the block we need to look
 	// up by address rather than index.
@@ -503,6 +523,180 @@ func verifyCommitSingle(
 	return nil
 }
 
+// Aggregated Verification
+
+// verifyCommitAggregated verifies commits with an aggregated signature. The
+// aggregated signature is verified first, then the remaining signatures are
+// verified one by one as in verifyCommitSingle.
+//
+// When the validators are looked up by address, the aggregated signature can
+// only be verified if all its signers are in the validator set, or if it is
+// in verifiedSignatureCache.
+//
+// CONTRACT: both commit and validator set should have passed validate basic.
+func verifyCommitAggregated(
+	chainID string,
+	vals *ValidatorSet,
+	commit *Commit,
+	votingPowerNeeded int64,
+	ignoreSig func(CommitSig) bool,
+	countSig func(CommitSig) bool,
+	countAllSignatures bool,
+	lookUpByIndex bool,
+	verifiedSignatureCache SignatureCache,
+) error {
+	var (
+		seenVals           = make(map[int32]int, len(commit.Signatures))
+		talliedVotingPower int64
+		pubKeys            = make([]crypto.PubKey, 0, len(commit.Signatures))
+		addrs              = make([][]byte, 0, len(commit.Signatures))
+		msgs               = make([][]byte, 0, len(commit.Signatures))
+		unknownSigners     int
+	)
+
+	// lookUp returns the validator of the signature at idx, or nil if it is
+	// not in the validator set.
+	lookUp := func(idx int, commitSig CommitSig) (*Validator, error) {
+		// If the vals and commit have a 1-to-1 correspondence we can retrieve
+		// them by index else we need to retrieve them by address
+		if lookUpByIndex {
+			val := vals.Validators[idx]
+			// The address is what the aggregated signature is cached by.
+			if !bytes.Equal(commitSig.ValidatorAddress, val.Address) {
+				return nil, fmt.Errorf("wrong validator address (#%d): want %v, got %v",
+					idx, val.Address, commitSig.ValidatorAddress)
+			}
+			return val, nil
+		}
+		valIdx, val := vals.GetByAddress(commitSig.ValidatorAddress)
+		if val == nil {
+			return nil, nil
+		}
+		// because we are getting validators by address we need to make sure
+		// that the same validator doesn't commit twice
+		if firstIndex, ok := seenVals[valIdx]; ok {
+			return nil, fmt.Errorf("double vote from %v (%d and %d)", val, firstIndex, idx)
+		}
+		seenVals[valIdx] = idx
+		return val, nil
+	}
+
+	for idx, commitSig := range commit.Signatures {
+		if !commitSig.IsAggregated() {
+			continue
+		}
+		addrs = append(addrs, commitSig.ValidatorAddress)
+		msgs = append(msgs, commit.VoteSignBytes(chainID, int32(idx)))
+
+		val, err := lookUp(idx, commitSig)
+		if err != nil {
+			return err
+		}
+		if val == nil {
+			unknownSigners++
+			continue
+		}
+		if val.PubKey == nil {
+			return fmt.Errorf("validator %v has a nil PubKey at index %d", val, idx)
+		}
+		pubKeys = append(pubKeys, val.PubKey)
+
+		if countSig(commitSig) {
+			talliedVotingPower += val.VotingPower
+		}
+	}
+
+	// The aggregated signature is cached along with the hashes of the
+	// addresses of its signers and of the bytes they signed.
+	cacheKey := string(commit.AggregatedSignature)
+	cacheValue := SignatureCacheValue{
+		ValidatorAddress: merkle.HashFromByteSlices(addrs),
+		VoteSignBytes:    merkle.HashFromByteSlices(msgs),
+	}
+	cacheHit := false
+	if verifiedSignatureCache != nil {
+		cacheVal, sigIsInCache := verifiedSignatureCache.Get(cacheKey)
+		cacheHit = sigIsInCache && bytes.Equal(cacheVal.ValidatorAddress, cacheValue.ValidatorAddress) && bytes.Equal(cacheVal.VoteSignBytes, cacheValue.VoteSignBytes)
+	}
+	if !cacheHit {
+		if unknownSigners > 0 {
+			return fmt.Errorf("cannot verify the aggregated signature: %d of its signers are not in the validator set", unknownSigners)
+		}
+		if !bls12381.VerifyAggregateSignature(pubKeys, msgs, commit.AggregatedSignature) {
+			return fmt.Errorf("wrong aggregated signature: %X", commit.AggregatedSignature)
+		}
+		if verifiedSignatureCache != nil {
+			verifiedSignatureCache.Add(cacheKey, cacheValue)
+		}
+	}
+
+	// check if we have enough signatures and can thus exit early
+	if !countAllSignatures && talliedVotingPower > votingPowerNeeded {
+		return nil
+	}
+
+	for idx, commitSig := range commit.Signatures {
+		if commitSig.IsAggregated() || ignoreSig(commitSig) {
+			continue
+		}
+
+		if commitSig.ValidateBasic() != nil {
+			return fmt.Errorf("invalid signatures at index %d", idx)
+		}
+
+		val, err := lookUp(idx, commitSig)
+		if err != nil {
+			return err
+		}
+		// if the signature doesn't belong to anyone in the validator set
+		// then we just skip over it
+		if val == nil {
+			continue
+		}
+		if val.PubKey == nil {
+			return fmt.Errorf("validator %v has a nil PubKey at index %d", val, idx)
+		}
+
+		voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
+
+		cacheKey, cacheHit := "", false
+		if verifiedSignatureCache != nil {
+			cacheKey = string(commitSig.Signature)
+			cacheVal, sigIsInCache := verifiedSignatureCache.Get(cacheKey)
+			cacheHit = sigIsInCache && bytes.Equal(cacheVal.ValidatorAddress, val.PubKey.Address()) && bytes.Equal(cacheVal.VoteSignBytes, voteSignBytes)
+		}
+
+		if !cacheHit {
+			if !val.PubKey.VerifySignature(voteSignBytes, commitSig.Signature) {
+				return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
+			}
+			if verifiedSignatureCache != nil {
+				verifiedSignatureCache.Add(cacheKey, SignatureCacheValue{
+					ValidatorAddress: val.PubKey.Address(),
+					VoteSignBytes:    voteSignBytes,
+				})
+			}
+		}
+
+		// If this signature counts then add the voting power of the validator
+		// to the tally
+		if countSig(commitSig) {
+			talliedVotingPower += val.VotingPower
+		}
+
+		// check if we have enough signatures and can thus exit early
+		if !countAllSignatures && talliedVotingPower > votingPowerNeeded {
+			return nil
+		}
+	}
+
+	if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
+		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+	}
+
+	return nil
+}
+
 func verifyBasicValsAndCommit(vals *ValidatorSet, commit *Commit, height int64, blockID BlockID) error {
 	if vals == nil {
 		return errors.New("nil validator set")
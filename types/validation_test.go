@@ -3,10 +3,12 @@ package types
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cometbft/cometbft/v2/crypto/bls12381"
 	cryptomocks "github.com/cometbft/cometbft/v2/crypto/mocks"
 	cmtmath "github.com/cometbft/cometbft/v2/libs/math"
 	cmttime "github.com/cometbft/cometbft/v2/types/time"
@@ -565,3 +567,145 @@ func TestValidation_verifyCommitSingle_UsesCache(t *testing.T) {
 	mockValPubkeys[3].AssertNotCalled(t, "VerifySignature")
 	mockValPubkeys[4].AssertNotCalled(t, "VerifySignature")
 }
+
+// randBls12381Commit returns a commit of a random set of n validators with
+// BLS12-381 keys, whose precommits have distinct timestamps unless sameTime.
+func randBls12381Commit(t *testing.T, chainID string, n int, sameTime bool) (*Commit, *ValidatorSet) {
+	t.Helper()
+	vals := make([]*Validator, n)
+	privVals := make([]PrivValidator, n)
+	for i := range privVals {
+		privKey, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		privVals[i] = NewMockPVWithParams(privKey, false, false)
+		vals[i] = NewValidator(privKey.PubKey(), 10)
+	}
+	valSet := NewValidatorSet(vals)
+
+	blockID := makeBlockIDRandom()
+	voteSet := NewVoteSet(chainID, 10, 0, PrecommitType, valSet)
+	now := cmttime.Now()
+	for i, privVal := range privVals {
+		pubKey, err := privVal.GetPubKey()
+		require.NoError(t, err)
+		idx, _ := valSet.GetByAddress(pubKey.Address())
+		vote := &Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   idx,
+			Height:           10,
+			Round:            0,
+			Type:             PrecommitType,
+			BlockID:          blockID,
+			Timestamp:        now,
+		}
+		if !sameTime {
+			vote.Timestamp = now.Add(time.Duration(i) * time.Millisecond)
+		}
+		_, err = signAddVote(privVal, vote, voteSet)
+		require.NoError(t, err)
+	}
+	return voteSet.MakeExtendedCommit(DefaultFeatureParams()).ToCommit(), valSet
+}
+
+func TestValidatorSet_VerifyCommit_AggregatedSignature(t *testing.T) {
+	if !bls12381.Enabled {
+		t.Skip("bls12381 is disabled")
+	}
+	chainID := "test_chain_id"
+	trustLevel := cmtmath.Fraction{Numerator: 1, Denominator: 3}
+	commit, valSet := randBls12381Commit(t, chainID, 4, false)
+
+	aggregated, err := commit.AggregateSignatures()
+	require.NoError(t, err)
+	require.NoError(t, aggregated.ValidateBasic())
+	assert.True(t, aggregated.HasAggregatedSignature())
+	for _, commitSig := range aggregated.Signatures {
+		assert.True(t, commitSig.IsAggregated())
+	}
+	assert.Less(t, aggregated.ToProto().Size(), commit.ToProto().Size())
+	assert.NotEqual(t, commit.Hash(), aggregated.Hash())
+	// The original commit is left untouched.
+	require.NoError(t, valSet.VerifyCommit(chainID, commit.BlockID, commit.Height, commit))
+
+	pc, err := CommitFromProto(aggregated.ToProto())
+	require.NoError(t, err)
+	assert.Equal(t, aggregated.Hash(), pc.Hash())
+
+	require.NoError(t, valSet.VerifyCommit(chainID, commit.BlockID, commit.Height, pc))
+	require.NoError(t, valSet.VerifyCommitLight(chainID, commit.BlockID, commit.Height, pc))
+	require.NoError(t, valSet.VerifyCommitLightAllSignatures(chainID, commit.BlockID, commit.Height, pc))
+	require.NoError(t, valSet.VerifyCommitLightTrusting(chainID, pc, trustLevel))
+
+	// A precommit which was not signed.
+	tampered := aggregated.Clone()
+	tampered.Signatures[0].Timestamp = tampered.Signatures[0].Timestamp.Add(time.Second)
+	require.Error(t, valSet.VerifyCommit(chainID, commit.BlockID, commit.Height, tampered))
+	require.Error(t, valSet.VerifyCommitLight(chainID, commit.BlockID, commit.Height, tampered))
+
+	// An aggregated signature missing a precommit.
+	tampered = aggregated.Clone()
+	tampered.Signatures[0] = NewCommitSigAbsent()
+	require.Error(t, valSet.VerifyCommit(chainID, commit.BlockID, commit.Height, tampered))
+
+	// A validator address which does not match the validator set.
+	tampered = aggregated.Clone()
+	tampered.Signatures[0].ValidatorAddress, tampered.Signatures[1].ValidatorAddress =
+		tampered.Signatures[1].ValidatorAddress, tampered.Signatures[0].ValidatorAddress
+	require.Error(t, valSet.VerifyCommitLight(chainID, commit.BlockID, commit.Height, tampered))
+
+	_, err = aggregated.AggregateSignatures()
+	require.Error(t, err)
+}
+
+func TestValidatorSet_VerifyCommit_AggregatedSignatureSameTimestamps(t *testing.T) {
+	if !bls12381.Enabled {
+		t.Skip("bls12381 is disabled")
+	}
+	chainID := "test_chain_id"
+	commit, valSet := randBls12381Commit(t, chainID, 4, true)
+
+	// Only one of the precommits with the same timestamp can be aggregated.
+	aggregated, err := commit.AggregateSignatures()
+	require.NoError(t, err)
+	numAggregated := 0
+	for _, commitSig := range aggregated.Signatures {
+		if commitSig.IsAggregated() {
+			numAggregated++
+		}
+	}
+	assert.Equal(t, 1, numAggregated)
+
+	require.NoError(t, valSet.VerifyCommit(chainID, commit.BlockID, commit.Height, aggregated))
+	require.NoError(t, valSet.VerifyCommitLight(chainID, commit.BlockID, commit.Height, aggregated))
+}
+
+func TestValidatorSet_VerifyCommitLightTrustingWithCache_AggregatedSignature(t *testing.T) {
+	if !bls12381.Enabled {
+		t.Skip("bls12381 is disabled")
+	}
+	chainID := "test_chain_id"
+	trustLevel := cmtmath.Fraction{Numerator: 1, Denominator: 3}
+	commit, valSet := randBls12381Commit(t, chainID, 4, false)
+	aggregated, err := commit.AggregateSignatures()
+	require.NoError(t, err)
+
+	// The trusted validators only include some of the signers.
+	trustedVals := NewValidatorSet(valSet.Copy().Validators[:2])
+
+	// The aggregated signature cannot be verified without the keys of all the
+	// signers...
+	err = trustedVals.VerifyCommitLightTrusting(chainID, aggregated, trustLevel)
+	require.ErrorContains(t, err, "cannot verify the aggregated signature")
+
+	// ...unless it was verified against the validator set of the commit.
+	cache := NewSignatureCache()
+	require.NoError(t, valSet.VerifyCommitLightWithCache(chainID, commit.BlockID, commit.Height, aggregated, cache))
+	assert.Equal(t, 1, cache.Len())
+	require.NoError(t, trustedVals.VerifyCommitLightTrustingWithCache(chainID, aggregated, trustLevel, cache))
+
+	// The cache entry is bound to the signers and the signed bytes.
+	tampered := aggregated.Clone()
+	tampered.Signatures[3].Timestamp = tampered.Signatures[3].Timestamp.Add(time.Second)
+	err = trustedVals.VerifyCommitLightTrustingWithCache(chainID, tampered, trustLevel, cache)
+	require.ErrorContains(t, err, "cannot verify the aggregated signature")
+}